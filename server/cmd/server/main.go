@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"os"
+	"time"
 
 	"offgrocery-assessment/internal/app"
 	"offgrocery-assessment/internal/config"
@@ -27,9 +28,117 @@ func main() {
 			{
 				Name:  "import",
 				Usage: "import the database with grocery data",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "file",
+						Usage: "path to the grocer feed file",
+						Value: "internal/seed/data/store_a.json",
+					},
+					&cli.StringFlag{
+						Name:  "format",
+						Usage: "feed format (store_a, store_b, store_c), detected from the file when omitted",
+					},
+					&cli.StringFlag{
+						Name:  "encoding",
+						Usage: "feed file encoding (json, ndjson, csv), guessed from the file extension when omitted",
+					},
+					&cli.StringFlag{
+						Name:  "mapping",
+						Usage: "path to a JSON feed mapping file describing a custom feed layout",
+					},
+					&cli.BoolFlag{
+						Name:  "force",
+						Usage: "import the file even if identical contents were already imported",
+					},
+					&cli.IntFlag{
+						Name:  "max-errors",
+						Usage: "number of invalid rows to skip before abandoning the import, negative for no limit",
+						Value: 10,
+					},
+					&cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "report what the import would change without writing anything, migrations included",
+					},
+					&cli.StringFlag{
+						Name:  "output",
+						Usage: "dry run report format (table, json)",
+						Value: "table",
+					},
+					&cli.StringFlag{
+						Name:  "rejections",
+						Usage: "format of the report of rejected rows written next to the feed file (json, csv)",
+						Value: "json",
+					},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					cfg := config.Load()
+					return app.NewImporter(cfg, app.ImportOptions{
+						FilePath:         cmd.String("file"),
+						Format:           cmd.String("format"),
+						Encoding:         cmd.String("encoding"),
+						MappingPath:      cmd.String("mapping"),
+						Force:            cmd.Bool("force"),
+						MaxErrors:        cmd.Int("max-errors"),
+						DryRun:           cmd.Bool("dry-run"),
+						Output:           cmd.String("output"),
+						RejectionsFormat: cmd.String("rejections"),
+					})
+				},
+				Commands: []*cli.Command{
+					{
+						Name:  "watch",
+						Usage: "import feed files dropped into a directory until interrupted",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:     "dir",
+								Usage:    "directory to watch for feed files, processed files are moved into its done and failed subfolders",
+								Required: true,
+							},
+							&cli.DurationFlag{
+								Name:  "interval",
+								Usage: "how often to look for new files",
+								Value: 10 * time.Second,
+							},
+							&cli.StringFlag{
+								Name:  "mapping",
+								Usage: "path to a JSON feed mapping file describing a custom feed layout",
+							},
+							&cli.BoolFlag{
+								Name:  "force",
+								Usage: "import files even if identical contents were already imported",
+							},
+							&cli.IntFlag{
+								Name:  "max-errors",
+								Usage: "number of invalid rows to skip before abandoning an import, negative for no limit",
+								Value: 10,
+							},
+						},
+						Action: func(ctx context.Context, cmd *cli.Command) error {
+							cfg := config.Load()
+							return app.NewImportWatcher(cfg, app.WatchOptions{
+								Dir:         cmd.String("dir"),
+								Interval:    cmd.Duration("interval"),
+								MappingPath: cmd.String("mapping"),
+								Force:       cmd.Bool("force"),
+								MaxErrors:   cmd.Int("max-errors"),
+							})
+						},
+					},
+				},
+			},
+			{
+				Name:  "rates",
+				Usage: "load the exchange rate table from a file, replacing the current one",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "file",
+						Usage: "path to the JSON exchange rate file",
+						Value: "internal/seed/rates/exchange_rates.json",
+					},
+				},
 				Action: func(ctx context.Context, cmd *cli.Command) error {
 					cfg := config.Load()
-					return app.NewImporter(cfg, "internal/seed/data/store_a.json")
+					return app.NewRatesLoader(cfg, cmd.String("file"))
 				},
 			},
 			{
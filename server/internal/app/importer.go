@@ -2,14 +2,45 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"offgrocery-assessment/internal/config"
+	"offgrocery-assessment/internal/importer/importerfeed"
 	"offgrocery-assessment/internal/importer/importerservice"
 	"offgrocery-assessment/internal/importer/importerstore"
+	"offgrocery-assessment/internal/product/productservice"
+	"offgrocery-assessment/internal/product/productstore"
 )
 
-func NewImporter(cfg config.Config, filePath string) error {
+// ImportOptions configures a run of the import command.
+type ImportOptions struct {
+	FilePath string
+	Format   string
+	// Encoding is the feed file's encoding, "json", "ndjson" or "csv". Empty
+	// guesses it from the file name extension.
+	Encoding    string
+	MappingPath string
+	// Force re-imports a file even if identical contents were already imported.
+	Force bool
+	// MaxErrors is the number of invalid rows tolerated before the import is
+	// abandoned. Negative means no limit.
+	MaxErrors int
+	// DryRun reports what the import would change instead of writing it. It
+	// skips the auto migration too.
+	DryRun bool
+	// Output is the dry run report format, "table" or "json".
+	Output string
+	// RejectionsFormat is the format of the rejection report an import
+	// writes next to the feed file, "json" or "csv".
+	RejectionsFormat string
+}
+
+func NewImporter(cfg config.Config, opts ImportOptions) error {
 	ctx := context.Background()
 
 	slog.Info("importer: connecting to database")
@@ -28,14 +59,133 @@ func NewImporter(cfg config.Config, filePath string) error {
 	slog.Info("importer: creating ent client")
 	client := NewEntClient(db)
 
-	slog.Info("importer: running auto migration")
-	if err := client.Schema.Create(ctx); err != nil {
-		slog.Error("importer: failed to run auto migration", "error", err)
+	// A dry run writes nothing, schema changes included, so it expects a
+	// database the server or a real import has already migrated.
+	if opts.DryRun {
+		slog.Info("importer: dry run, skipping auto migration")
+	} else {
+		slog.Info("importer: running auto migration")
+		if err := Migrate(ctx, db, client); err != nil {
+			slog.Error("importer: failed to run auto migration", "error", err)
+			return err
+		}
+	}
+
+	var encoding importerfeed.Encoding
+	if opts.Encoding != "" {
+		if encoding, err = importerfeed.ParseEncoding(opts.Encoding); err != nil {
+			return err
+		}
+	}
+
+	registry, mappingFormat, err := importRegistry(opts.MappingPath)
+	if err != nil {
+		return err
+	}
+	format := opts.Format
+	if format == "" {
+		format = mappingFormat
+	}
+
+	productService := productservice.New(productstore.New(client))
+
+	importStore := importerstore.New(client)
+	service := importerservice.New(importStore, registry, productService)
+
+	if !opts.DryRun {
+		return runImport(ctx, service, opts, importerservice.Options{
+			Format:    format,
+			Encoding:  encoding,
+			Force:     opts.Force,
+			MaxErrors: opts.MaxErrors,
+		})
+	}
+
+	report, err := service.DryRun(ctx, opts.FilePath, importerservice.Options{
+		Format:   format,
+		Encoding: encoding,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch opts.Output {
+	case "json":
+		return report.WriteJSON(os.Stdout)
+	case "table", "":
+		return report.WriteTable(os.Stdout)
+	default:
+		return fmt.Errorf("importer: unsupported output %q", opts.Output)
+	}
+}
+
+// runImport imports the feed, printing the run summary and the path of the
+// rejection report written next to the feed file when rows were rejected.
+func runImport(ctx context.Context, service importerservice.Service, opts ImportOptions, importOpts importerservice.Options) error {
+	format := opts.RejectionsFormat
+	if format == "" {
+		format = "json"
+	}
+	reportPath := rejectionReportPath(opts.FilePath, format)
+	f, err := os.Create(reportPath)
+	if err != nil {
+		return fmt.Errorf("creating rejection report: %w", err)
+	}
+	report, err := importerservice.NewRejectionReport(f, format)
+	if err != nil {
+		f.Close()
+		os.Remove(reportPath)
 		return err
 	}
+	importOpts.Rejected = report.Add
 
-	store := importerstore.New(client)
-	service := importerservice.New(store)
+	run, err := service.Import(ctx, opts.FilePath, importOpts)
 
-	return service.Import(ctx, filePath)
+	if cerr := report.Close(); cerr != nil {
+		slog.Error("importer: failed to write rejection report", "file", reportPath, "error", cerr)
+	}
+	if cerr := f.Close(); cerr != nil {
+		slog.Error("importer: failed to write rejection report", "file", reportPath, "error", cerr)
+	}
+	if report.Count() == 0 {
+		os.Remove(reportPath)
+	}
+
+	if errors.Is(err, importerservice.ErrAlreadyImported) {
+		slog.Info("importer: skipped, file was already imported, pass --force to import it again", "run", run.ID)
+		return nil
+	}
+	if run != nil {
+		fmt.Printf("run %d %s: %d created, %d updated, %d delisted, %d rejected\n",
+			run.ID, run.Status, run.CreatedCount, run.UpdatedCount, run.DelistedCount, run.FailedCount)
+	}
+	if report.Count() > 0 {
+		fmt.Printf("rejected rows written to %s\n", reportPath)
+	}
+	return err
+}
+
+// rejectionReportPath returns where the rejection report of the feed at
+// feedPath is written: beside it, named after it.
+func rejectionReportPath(feedPath, format string) string {
+	return strings.TrimSuffix(feedPath, filepath.Ext(feedPath)) + ".rejections." + format
+}
+
+// importRegistry returns the built-in feed adapters plus the custom mapping
+// at mappingPath, if given, and the mapping's format name.
+func importRegistry(mappingPath string) (*importerfeed.Registry, string, error) {
+	registry := importerfeed.DefaultRegistry()
+	if mappingPath == "" {
+		return registry, "", nil
+	}
+
+	adapter, err := importerfeed.LoadMapping(mappingPath)
+	if err != nil {
+		slog.Error("importer: failed to load feed mapping", "file", mappingPath, "error", err)
+		return nil, "", err
+	}
+	if err := registry.Register(adapter); err != nil {
+		return nil, "", err
+	}
+	return registry, adapter.Name(), nil
 }
@@ -0,0 +1,103 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/money"
+)
+
+// Migrate brings the database schema up to date. Auto migration only adds
+// tables and columns, so the legacy float price column of items is converted
+// first, copying its data before dropping it explicitly.
+func Migrate(ctx context.Context, db *sql.DB, client *ent.Client) error {
+	if err := convertFloatPrices(ctx, db); err != nil {
+		return fmt.Errorf("converting item prices to minor units: %w", err)
+	}
+	return client.Schema.Create(ctx)
+}
+
+// convertFloatPrices copies the legacy float price column of items into the
+// price_amount column, in the minor unit of each item's currency, and drops
+// it. Going through DECIMAL rounds away the binary representation error of
+// the float, so 5.29 becomes 529 rather than 528. It is a no-op once the
+// legacy column is gone and safe to rerun if interrupted.
+func convertFloatPrices(ctx context.Context, db *sql.DB) error {
+	hasPrice, err := columnExists(ctx, db, "items", "price")
+	if err != nil || !hasPrice {
+		return err
+	}
+
+	slog.Info("migrate: converting float prices to minor units")
+
+	if err := addColumn(ctx, db, "items", "price_amount", "BIGINT NOT NULL DEFAULT 0"); err != nil {
+		return err
+	}
+	if err := addColumn(ctx, db, "items", "currency", "VARCHAR(3) NOT NULL DEFAULT 'CAD'"); err != nil {
+		return err
+	}
+
+	currencies, err := distinctCurrencies(ctx, db, "items")
+	if err != nil {
+		return err
+	}
+	for _, currency := range currencies {
+		_, err := db.ExecContext(ctx,
+			"UPDATE `items` SET `price_amount` = ROUND(CAST(`price` AS DECIMAL(20, 6)) * ?) WHERE `currency` = ?",
+			int64(math.Pow10(money.Exponent(currency))), currency,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return dropColumn(ctx, db, "items", "price")
+}
+
+// addColumn adds a column to a table unless it already exists.
+func addColumn(ctx context.Context, db *sql.DB, table, column, definition string) error {
+	exists, err := columnExists(ctx, db, table, column)
+	if err != nil || exists {
+		return err
+	}
+	_, err = db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN `%s` %s", table, column, definition))
+	return err
+}
+
+// distinctCurrencies returns the currencies a table's rows are priced in.
+func distinctCurrencies(ctx context.Context, db *sql.DB, table string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT DISTINCT `currency` FROM `%s`", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var currencies []string
+	for rows.Next() {
+		var currency string
+		if err := rows.Scan(&currency); err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, currency)
+	}
+	return currencies, rows.Err()
+}
+
+// dropColumn drops a legacy column once its data has been converted.
+func dropColumn(ctx context.Context, db *sql.DB, table, column string) error {
+	slog.Info("migrate: dropping converted column", "table", table, "column", column)
+	_, err := db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE `%s` DROP COLUMN `%s`", table, column))
+	return err
+}
+
+func columnExists(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var n int
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?",
+		table, column,
+	).Scan(&n)
+	return n > 0, err
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"offgrocery-assessment/internal/config"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
+	"offgrocery-assessment/internal/exchange/exchangestore"
+)
+
+func NewRatesLoader(cfg config.Config, filePath string) error {
+	ctx := context.Background()
+
+	slog.Info("rates: connecting to database")
+	db, err := NewDB(ctx, cfg, ConfigureMySQLParseTime)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	slog.Info("rates: pinging database")
+	if err := db.PingContext(ctx); err != nil {
+		slog.Error("rates: failed to ping database", "error", err)
+		return err
+	}
+
+	slog.Info("rates: creating ent client")
+	client := NewEntClient(db)
+
+	slog.Info("rates: running auto migration")
+	if err := Migrate(ctx, db, client); err != nil {
+		slog.Error("rates: failed to run auto migration", "error", err)
+		return err
+	}
+
+	service := exchangeservice.New(exchangestore.New(client))
+	if _, err := service.LoadFile(ctx, filePath); err != nil {
+		slog.Error("rates: failed to load exchange rates", "file", filePath, "error", err)
+		return err
+	}
+
+	return nil
+}
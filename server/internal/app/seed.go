@@ -28,7 +28,7 @@ func NewSeed(cfg config.Config) error {
 	client := NewEntClient(db)
 
 	slog.Info("seed: running auto migration")
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := Migrate(ctx, db, client); err != nil {
 		slog.Error("seed: failed to run auto migration", "error", err)
 		return err
 	}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"offgrocery-assessment/internal/config"
+	"offgrocery-assessment/internal/importer/importerservice"
+	"offgrocery-assessment/internal/importer/importerstore"
+	"offgrocery-assessment/internal/product/productservice"
+	"offgrocery-assessment/internal/product/productstore"
+)
+
+// WatchOptions configures a run of the import watch command.
+type WatchOptions struct {
+	Dir         string
+	Interval    time.Duration
+	MappingPath string
+	Force       bool
+	MaxErrors   int
+}
+
+// NewImportWatcher imports feed files dropped into a directory until the
+// process is interrupted.
+func NewImportWatcher(cfg config.Config, opts WatchOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	slog.Info("importer: connecting to database")
+	db, err := NewDB(ctx, cfg, ConfigureMySQLParseTime)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	slog.Info("importer: pinging database")
+	if err := db.PingContext(ctx); err != nil {
+		slog.Error("importer: failed to ping database", "error", err)
+		return err
+	}
+
+	slog.Info("importer: creating ent client")
+	client := NewEntClient(db)
+
+	slog.Info("importer: running auto migration")
+	if err := Migrate(ctx, db, client); err != nil {
+		slog.Error("importer: failed to run auto migration", "error", err)
+		return err
+	}
+
+	registry, _, err := importRegistry(opts.MappingPath)
+	if err != nil {
+		return err
+	}
+
+	productService := productservice.New(productstore.New(client))
+	service := importerservice.New(importerstore.New(client), registry, productService)
+
+	return service.Watch(ctx, importerservice.WatchOptions{
+		Dir:       opts.Dir,
+		Interval:  opts.Interval,
+		Force:     opts.Force,
+		MaxErrors: opts.MaxErrors,
+	})
+}
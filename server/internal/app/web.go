@@ -2,27 +2,52 @@ package app
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"offgrocery-assessment/internal/auth/authhandler"
 	"offgrocery-assessment/internal/auth/authservice"
 	"offgrocery-assessment/internal/auth/authstore"
+	"offgrocery-assessment/internal/category/categoryhandler"
+	"offgrocery-assessment/internal/category/categoryservice"
+	"offgrocery-assessment/internal/category/categorystore"
 	"offgrocery-assessment/internal/config"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
+	"offgrocery-assessment/internal/exchange/exchangestore"
+	"offgrocery-assessment/internal/httputil"
+	"offgrocery-assessment/internal/importer/importerfeed"
+	"offgrocery-assessment/internal/importer/importerhandler"
+	"offgrocery-assessment/internal/importer/importerservice"
+	"offgrocery-assessment/internal/importer/importerstore"
 	"offgrocery-assessment/internal/item/itemhandler"
 	"offgrocery-assessment/internal/item/itemservice"
 	"offgrocery-assessment/internal/item/itemstore"
 	"offgrocery-assessment/internal/list/listhandler"
 	"offgrocery-assessment/internal/list/listservice"
 	"offgrocery-assessment/internal/list/liststore"
+	"offgrocery-assessment/internal/product/producthandler"
+	"offgrocery-assessment/internal/product/productservice"
+	"offgrocery-assessment/internal/product/productstore"
 	"offgrocery-assessment/internal/store/storehandler"
 	"offgrocery-assessment/internal/store/storeservice"
 	"offgrocery-assessment/internal/store/storestore"
 )
 
+// shutdownTimeout bounds how long the server waits for in-flight requests
+// when it is stopped.
+const shutdownTimeout = 30 * time.Second
+
+// NewWeb serves the API until the process is interrupted, then stops taking
+// requests and waits for in-flight ones and the running import to wind down.
 func NewWeb(cfg config.Config) error {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	slog.Info("web: connecting to database")
 	db, err := NewDB(ctx, cfg, ConfigureMySQLParseTime)
@@ -41,37 +66,85 @@ func NewWeb(cfg config.Config) error {
 	client := NewEntClient(db)
 
 	slog.Info("web: running auto migration")
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := Migrate(ctx, db, client); err != nil {
 		slog.Error("web: failed to run auto migration", "error", err)
 		return err
 	}
 
+	requireAdmin := httputil.RequireAdmin(cfg.AdminToken)
+
 	authStore := authstore.New(client)
 	authService := authservice.New(authStore)
 	authHandler := authhandler.New(authService)
 
+	exchangeStore := exchangestore.New(client)
+	exchangeService := exchangeservice.New(exchangeStore)
+
 	listStore := liststore.New(client)
 	listService := listservice.New(listStore)
-	listHandler := listhandler.New(listService)
+	listHandler := listhandler.New(listService, exchangeService)
 
 	itemStore := itemstore.New(client)
 	itemService := itemservice.New(itemStore)
-	itemHandler := itemhandler.New(itemService)
+	itemHandler := itemhandler.New(itemService, exchangeService, requireAdmin)
 
 	stStore := storestore.New(client)
 	stService := storeservice.New(stStore)
 	stHandler := storehandler.New(stService)
 
+	categoryStore := categorystore.New(client)
+	categoryService := categoryservice.New(categoryStore)
+	categoryHandler := categoryhandler.New(categoryService)
+
+	productStore := productstore.New(client)
+	productService := productservice.New(productStore)
+	productHandler := producthandler.New(productService, exchangeService, requireAdmin)
+
+	importStore := importerstore.New(client)
+	importRegistry := importerfeed.DefaultRegistry()
+	importService := importerservice.New(importStore, importRegistry, productService)
+	importQueue := importerservice.NewQueue(importStore, importService, importRegistry, cfg.ImportUploadDir)
+	importHandler := importerhandler.New(importService, importQueue, requireAdmin)
+
+	// The queue stops with ctx; an import it is running is rolled back and
+	// its job released for the next start.
+	queueDone := make(chan struct{})
+	go func() {
+		defer close(queueDone)
+		importQueue.Run(ctx)
+	}()
+	defer func() { <-queueDone }()
+
 	r := chi.NewRouter()
 	r.Mount("/auth", authHandler.Routes())
 	r.Mount("/lists", listHandler.Routes())
 	r.Mount("/items", itemHandler.Routes())
 	r.Mount("/stores", stHandler.Routes())
+	r.Mount("/imports", importHandler.Routes())
+	r.Mount("/categories", categoryHandler.Routes())
+	r.Mount("/products", productHandler.Routes())
+
+	srv := &http.Server{Addr: ":" + cfg.Port, Handler: r}
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("web: starting server", "port", cfg.Port)
+		serveErr <- srv.ListenAndServe()
+	}()
 
-	slog.Info("web: starting server", "port", cfg.Port)
-	if err := http.ListenAndServe(":"+cfg.Port, r); err != nil {
+	select {
+	case err := <-serveErr:
+		stop()
 		slog.Error("web: server failed", "error", err)
 		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("web: shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		slog.Error("web: failed to shut down server", "error", err)
+		return err
 	}
 
 	return nil
@@ -3,15 +3,20 @@ package authhandler
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"offgrocery-assessment/internal/auth/authservice"
+	"offgrocery-assessment/internal/ent"
 	"offgrocery-assessment/internal/httputil"
+	"offgrocery-assessment/internal/money"
 )
 
 type Handler interface {
 	Routes() chi.Router
 	CreateUser(w http.ResponseWriter, r *http.Request)
+	SetPreferences(w http.ResponseWriter, r *http.Request)
 }
 
 type handler struct {
@@ -25,6 +30,7 @@ func New(service authservice.Service) *handler {
 func (h *handler) Routes() chi.Router {
 	r := chi.NewRouter()
 	r.Post("/", h.CreateUser)
+	r.Put("/{id}/preferences", h.SetPreferences)
 	return r
 }
 
@@ -33,6 +39,15 @@ type createUserRequest struct {
 	Name  string `json:"name"`
 }
 
+type preferencesRequest struct {
+	DisplayCurrency string `json:"display_currency"`
+}
+
+type preferencesResponse struct {
+	ID              int     `json:"id"`
+	DisplayCurrency *string `json:"display_currency"`
+}
+
 type createUserResponse struct {
 	ID    int    `json:"id"`
 	Email string `json:"email"`
@@ -63,3 +78,41 @@ func (h *handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 		Name:  req.Name,
 	})
 }
+
+// SetPreferences updates a user's preferences. An empty display_currency
+// clears it, so prices on the user's lists are shown as the grocer lists them.
+func (h *handler) SetPreferences(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid user id"})
+		return
+	}
+
+	var req preferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	currency := strings.ToUpper(req.DisplayCurrency)
+	if currency != "" && !money.ValidCurrency(currency) {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid display_currency"})
+		return
+	}
+
+	user, err := h.service.SetDisplayCurrency(r.Context(), id, currency)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "user not found"})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to update preferences"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, preferencesResponse{
+		ID:              user.ID,
+		DisplayCurrency: user.DisplayCurrency,
+	})
+}
@@ -3,10 +3,12 @@ package authservice
 import (
 	"context"
 	"offgrocery-assessment/internal/auth/authstore"
+	"offgrocery-assessment/internal/ent"
 )
 
 type Service interface {
 	CreateUser(ctx context.Context, email, name string) (int, error)
+	SetDisplayCurrency(ctx context.Context, userID int, currency string) (*ent.User, error)
 }
 
 type service struct {
@@ -27,3 +29,7 @@ func (s *service) CreateUser(ctx context.Context, email, name string) (int, erro
 
 	return user.ID, nil
 }
+
+func (s *service) SetDisplayCurrency(ctx context.Context, userID int, currency string) (*ent.User, error) {
+	return s.store.SetDisplayCurrency(ctx, userID, currency)
+}
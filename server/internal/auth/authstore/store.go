@@ -7,6 +7,7 @@ import (
 
 type Store interface {
 	CreateUser(ctx context.Context, email, name string) (*ent.User, error)
+	SetDisplayCurrency(ctx context.Context, userID int, currency string) (*ent.User, error)
 }
 
 type store struct {
@@ -22,3 +23,15 @@ func New(client *ent.Client) *store {
 func (s *store) CreateUser(ctx context.Context, email, name string) (*ent.User, error) {
 	return s.client.User.Create().SetEmail(email).SetName(name).Save(ctx)
 }
+
+// SetDisplayCurrency sets the user's preferred display currency, clearing it
+// when currency is empty.
+func (s *store) SetDisplayCurrency(ctx context.Context, userID int, currency string) (*ent.User, error) {
+	update := s.client.User.UpdateOneID(userID)
+	if currency == "" {
+		update.ClearDisplayCurrency()
+	} else {
+		update.SetDisplayCurrency(currency)
+	}
+	return update.Save(ctx)
+}
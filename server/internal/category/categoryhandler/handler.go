@@ -0,0 +1,87 @@
+package categoryhandler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"offgrocery-assessment/internal/category/categoryservice"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/httputil"
+)
+
+type Handler interface {
+	Routes() chi.Router
+	ListCategories(w http.ResponseWriter, r *http.Request)
+	ListMappings(w http.ResponseWriter, r *http.Request)
+	MapCategory(w http.ResponseWriter, r *http.Request)
+}
+
+type handler struct {
+	service categoryservice.Service
+}
+
+func New(service categoryservice.Service) *handler {
+	return &handler{service: service}
+}
+
+func (h *handler) Routes() chi.Router {
+	r := chi.NewRouter()
+	r.Get("/", h.ListCategories)
+	r.Get("/mappings", h.ListMappings)
+	r.Put("/mappings", h.MapCategory)
+	return r
+}
+
+type mapCategoryRequest struct {
+	Grocer   string `json:"grocer"`
+	RawValue string `json:"raw_value"`
+	Category string `json:"category"`
+}
+
+func (h *handler) ListCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := h.service.ListCategories(r.Context())
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to list categories"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, categories)
+}
+
+func (h *handler) ListMappings(w http.ResponseWriter, r *http.Request) {
+	mappings, err := h.service.ListMappings(r.Context())
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to list category mappings"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, mappings)
+}
+
+func (h *handler) MapCategory(w http.ResponseWriter, r *http.Request) {
+	var req mapCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	if req.Grocer == "" || req.RawValue == "" || req.Category == "" {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "grocer, raw_value and category are required"})
+		return
+	}
+
+	grocer := categorymapping.Grocer(req.Grocer)
+	if err := categorymapping.GrocerValidator(grocer); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid grocer"})
+		return
+	}
+
+	mapping, err := h.service.MapCategory(r.Context(), grocer, req.RawValue, req.Category)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to map category"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, mapping)
+}
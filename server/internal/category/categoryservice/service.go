@@ -0,0 +1,49 @@
+package categoryservice
+
+import (
+	"context"
+
+	"offgrocery-assessment/internal/category/categorystore"
+	"offgrocery-assessment/internal/category/taxonomy"
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/categorymapping"
+)
+
+type Service interface {
+	ListCategories(ctx context.Context) ([]categorystore.CategoryWithCount, error)
+	ListMappings(ctx context.Context) ([]*ent.CategoryMapping, error)
+	MapCategory(ctx context.Context, grocer categorymapping.Grocer, rawValue string, categorySlug string) (*ent.CategoryMapping, error)
+}
+
+type service struct {
+	store categorystore.Store
+}
+
+func New(store categorystore.Store) *service {
+	return &service{store: store}
+}
+
+func (s *service) ListCategories(ctx context.Context) ([]categorystore.CategoryWithCount, error) {
+	return s.store.ListCategories(ctx)
+}
+
+func (s *service) ListMappings(ctx context.Context) ([]*ent.CategoryMapping, error) {
+	return s.store.ListMappings(ctx)
+}
+
+// MapCategory points a grocer's raw category label at a shared category,
+// creating the category if its slug is new. The mapping applies to items from
+// the next import onwards.
+func (s *service) MapCategory(ctx context.Context, grocer categorymapping.Grocer, rawValue string, categorySlug string) (*ent.CategoryMapping, error) {
+	slug := taxonomy.Slug(categorySlug)
+
+	categoryRecord, err := s.store.GetCategoryBySlug(ctx, slug)
+	if ent.IsNotFound(err) {
+		categoryRecord, err = s.store.CreateCategory(ctx, slug, taxonomy.Name(categorySlug))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.UpsertMapping(ctx, grocer, taxonomy.Normalize(rawValue), categoryRecord.ID)
+}
@@ -0,0 +1,110 @@
+package categorystore
+
+import (
+	"context"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/ent/item"
+)
+
+// CategoryWithCount is a category with the number of available items in it.
+type CategoryWithCount struct {
+	*ent.Category
+	ItemCount int `json:"item_count"`
+}
+
+type Store interface {
+	ListCategories(ctx context.Context) ([]CategoryWithCount, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*ent.Category, error)
+	CreateCategory(ctx context.Context, slug, name string) (*ent.Category, error)
+	ListMappings(ctx context.Context) ([]*ent.CategoryMapping, error)
+	UpsertMapping(ctx context.Context, grocer categorymapping.Grocer, rawValue string, categoryID int) (*ent.CategoryMapping, error)
+}
+
+type store struct {
+	client *ent.Client
+}
+
+func New(client *ent.Client) *store {
+	return &store{client: client}
+}
+
+func (s *store) ListCategories(ctx context.Context) ([]CategoryWithCount, error) {
+	categories, err := s.client.Category.Query().
+		Order(ent.Asc(category.FieldSlug)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		CategoryID int `json:"category_id"`
+		Count      int `json:"count"`
+	}
+	err = s.client.Item.Query().
+		Where(
+			item.AvailableEQ(true),
+			item.CategoryIDNotNil(),
+		).
+		GroupBy(item.FieldCategoryID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[int]int, len(counts))
+	for _, c := range counts {
+		byCategory[c.CategoryID] = c.Count
+	}
+
+	result := make([]CategoryWithCount, len(categories))
+	for i, c := range categories {
+		result[i] = CategoryWithCount{Category: c, ItemCount: byCategory[c.ID]}
+	}
+	return result, nil
+}
+
+func (s *store) GetCategoryBySlug(ctx context.Context, slug string) (*ent.Category, error) {
+	return s.client.Category.Query().
+		Where(category.SlugEQ(slug)).
+		Only(ctx)
+}
+
+func (s *store) CreateCategory(ctx context.Context, slug, name string) (*ent.Category, error) {
+	return s.client.Category.Create().
+		SetSlug(slug).
+		SetName(name).
+		Save(ctx)
+}
+
+func (s *store) ListMappings(ctx context.Context) ([]*ent.CategoryMapping, error) {
+	return s.client.CategoryMapping.Query().
+		WithCategory().
+		Order(ent.Asc(categorymapping.FieldGrocer), ent.Asc(categorymapping.FieldRawValue)).
+		All(ctx)
+}
+
+func (s *store) UpsertMapping(ctx context.Context, grocer categorymapping.Grocer, rawValue string, categoryID int) (*ent.CategoryMapping, error) {
+	existing, err := s.client.CategoryMapping.Query().
+		Where(
+			categorymapping.GrocerEQ(grocer),
+			categorymapping.RawValueEQ(rawValue),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return s.client.CategoryMapping.Create().
+			SetGrocer(grocer).
+			SetRawValue(rawValue).
+			SetCategoryID(categoryID).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.client.CategoryMapping.UpdateOne(existing).
+		SetCategoryID(categoryID).
+		Save(ctx)
+}
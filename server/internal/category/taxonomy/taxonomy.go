@@ -0,0 +1,46 @@
+// Package taxonomy normalizes grocer category labels into the shared
+// category taxonomy.
+package taxonomy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize lower-cases and trims a grocer's raw category label and collapses
+// internal whitespace, so "Dairy  Alternatives " and "dairy alternatives"
+// map the same way.
+func Normalize(raw string) string {
+	return strings.Join(strings.Fields(strings.ToLower(raw)), " ")
+}
+
+// Slug derives a category slug from a label, e.g. "Dairy Alternatives"
+// becomes "dairy-alternatives".
+func Slug(raw string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range Normalize(raw) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// Name derives a display name from a label, e.g. "dairy alternatives"
+// becomes "Dairy alternatives".
+func Name(raw string) string {
+	normalized := Normalize(raw)
+	if normalized == "" {
+		return ""
+	}
+	runes := []rune(normalized)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,36 @@
+package taxonomy
+
+import "testing"
+
+func TestLabels(t *testing.T) {
+	tests := []struct {
+		raw            string
+		wantNormalized string
+		wantSlug       string
+		wantName       string
+	}{
+		{"Dairy", "dairy", "dairy", "Dairy"},
+		{"  Dairy  Alternatives ", "dairy alternatives", "dairy-alternatives", "Dairy alternatives"},
+		{"DAIRY\tALTERNATIVES", "dairy alternatives", "dairy-alternatives", "Dairy alternatives"},
+		{"Fruits & Vegetables", "fruits & vegetables", "fruits-vegetables", "Fruits & vegetables"},
+		{"Snacks/Chips!", "snacks/chips!", "snacks-chips", "Snacks/chips!"},
+		{"--Frozen--", "--frozen--", "frozen", "--frozen--"},
+		{"Crème fraîche", "crème fraîche", "crème-fraîche", "Crème fraîche"},
+		{"Aisle 7", "aisle 7", "aisle-7", "Aisle 7"},
+		{"   ", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			if got := Normalize(tt.raw); got != tt.wantNormalized {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.raw, got, tt.wantNormalized)
+			}
+			if got := Slug(tt.raw); got != tt.wantSlug {
+				t.Errorf("Slug(%q) = %q, want %q", tt.raw, got, tt.wantSlug)
+			}
+			if got := Name(tt.raw); got != tt.wantName {
+				t.Errorf("Name(%q) = %q, want %q", tt.raw, got, tt.wantName)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package config
 
-import "os"
+import (
+	"os"
+	"path/filepath"
+)
 
 type Config struct {
 	DBHost     string
@@ -12,6 +15,13 @@ type Config struct {
 	Port       string
 	Production bool
 	LogLevel   string
+
+	// ImportUploadDir is where feeds uploaded over HTTP wait to be imported.
+	ImportUploadDir string
+
+	// AdminToken authorizes requests to admin routes, sent as a bearer
+	// token. Admin routes refuse every request when it is empty.
+	AdminToken string
 }
 
 func Load() Config {
@@ -25,6 +35,10 @@ func Load() Config {
 		Port:       getEnv("PORT", "8080"),
 		Production: getEnv("PRODUCTION", "false") == "true",
 		LogLevel:   getEnv("LOG_LEVEL", "debug"),
+
+		ImportUploadDir: getEnv("IMPORT_UPLOAD_DIR", filepath.Join(os.TempDir(), "offrgrocery-imports")),
+
+		AdminToken: getEnv("ADMIN_TOKEN", ""),
 	}
 }
 
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CategoryMapping is the model entity for the CategoryMapping schema.
+type CategoryMapping struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Grocer holds the value of the "grocer" field.
+	Grocer categorymapping.Grocer `json:"grocer,omitempty"`
+	// The grocer's label, lower-cased and trimmed.
+	RawValue string `json:"raw_value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryMappingQuery when eager-loading is set.
+	Edges             CategoryMappingEdges `json:"edges"`
+	category_mappings *int
+	selectValues      sql.SelectValues
+}
+
+// CategoryMappingEdges holds the relations/edges for other nodes in the graph.
+type CategoryMappingEdges struct {
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CategoryMappingEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CategoryMapping) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case categorymapping.FieldID:
+			values[i] = new(sql.NullInt64)
+		case categorymapping.FieldGrocer, categorymapping.FieldRawValue:
+			values[i] = new(sql.NullString)
+		case categorymapping.ForeignKeys[0]: // category_mappings
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CategoryMapping fields.
+func (_m *CategoryMapping) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case categorymapping.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case categorymapping.FieldGrocer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field grocer", values[i])
+			} else if value.Valid {
+				_m.Grocer = categorymapping.Grocer(value.String)
+			}
+		case categorymapping.FieldRawValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field raw_value", values[i])
+			} else if value.Valid {
+				_m.RawValue = value.String
+			}
+		case categorymapping.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field category_mappings", value)
+			} else if value.Valid {
+				_m.category_mappings = new(int)
+				*_m.category_mappings = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CategoryMapping.
+// This includes values selected through modifiers, order, etc.
+func (_m *CategoryMapping) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCategory queries the "category" edge of the CategoryMapping entity.
+func (_m *CategoryMapping) QueryCategory() *CategoryQuery {
+	return NewCategoryMappingClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this CategoryMapping.
+// Note that you need to call CategoryMapping.Unwrap() before calling this method if this CategoryMapping
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CategoryMapping) Update() *CategoryMappingUpdateOne {
+	return NewCategoryMappingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CategoryMapping entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CategoryMapping) Unwrap() *CategoryMapping {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CategoryMapping is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CategoryMapping) String() string {
+	var builder strings.Builder
+	builder.WriteString("CategoryMapping(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("grocer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Grocer))
+	builder.WriteString(", ")
+	builder.WriteString("raw_value=")
+	builder.WriteString(_m.RawValue)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CategoryMappings is a parsable slice of CategoryMapping.
+type CategoryMappings []*CategoryMapping
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorymapping
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the categorymapping type in the database.
+	Label = "category_mapping"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGrocer holds the string denoting the grocer field in the database.
+	FieldGrocer = "grocer"
+	// FieldRawValue holds the string denoting the raw_value field in the database.
+	FieldRawValue = "raw_value"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// Table holds the table name of the categorymapping in the database.
+	Table = "category_mappings"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "category_mappings"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_mappings"
+)
+
+// Columns holds all SQL columns for categorymapping fields.
+var Columns = []string{
+	FieldID,
+	FieldGrocer,
+	FieldRawValue,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "category_mappings"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"category_mappings",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RawValueValidator is a validator for the "raw_value" field. It is called by the builders before save.
+	RawValueValidator func(string) error
+)
+
+// Grocer defines the type for the "grocer" enum field.
+type Grocer string
+
+// Grocer values.
+const (
+	GrocerStoreA Grocer = "store_a"
+	GrocerStoreB Grocer = "store_b"
+	GrocerStoreC Grocer = "store_c"
+)
+
+func (gr Grocer) String() string {
+	return string(gr)
+}
+
+// GrocerValidator is a validator for the "grocer" field enum values. It is called by the builders before save.
+func GrocerValidator(gr Grocer) error {
+	switch gr {
+	case GrocerStoreA, GrocerStoreB, GrocerStoreC:
+		return nil
+	default:
+		return fmt.Errorf("categorymapping: invalid enum value for grocer field: %q", gr)
+	}
+}
+
+// OrderOption defines the ordering options for the CategoryMapping queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGrocer orders the results by the grocer field.
+func ByGrocer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrocer, opts...).ToFunc()
+}
+
+// ByRawValue orders the results by the raw_value field.
+func ByRawValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRawValue, opts...).ToFunc()
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
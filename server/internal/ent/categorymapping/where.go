@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package categorymapping
+
+import (
+	"offgrocery-assessment/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldLTE(FieldID, id))
+}
+
+// RawValue applies equality check predicate on the "raw_value" field. It's identical to RawValueEQ.
+func RawValue(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldEQ(FieldRawValue, v))
+}
+
+// GrocerEQ applies the EQ predicate on the "grocer" field.
+func GrocerEQ(v Grocer) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldEQ(FieldGrocer, v))
+}
+
+// GrocerNEQ applies the NEQ predicate on the "grocer" field.
+func GrocerNEQ(v Grocer) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldNEQ(FieldGrocer, v))
+}
+
+// GrocerIn applies the In predicate on the "grocer" field.
+func GrocerIn(vs ...Grocer) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldIn(FieldGrocer, vs...))
+}
+
+// GrocerNotIn applies the NotIn predicate on the "grocer" field.
+func GrocerNotIn(vs ...Grocer) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldNotIn(FieldGrocer, vs...))
+}
+
+// RawValueEQ applies the EQ predicate on the "raw_value" field.
+func RawValueEQ(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldEQ(FieldRawValue, v))
+}
+
+// RawValueNEQ applies the NEQ predicate on the "raw_value" field.
+func RawValueNEQ(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldNEQ(FieldRawValue, v))
+}
+
+// RawValueIn applies the In predicate on the "raw_value" field.
+func RawValueIn(vs ...string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldIn(FieldRawValue, vs...))
+}
+
+// RawValueNotIn applies the NotIn predicate on the "raw_value" field.
+func RawValueNotIn(vs ...string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldNotIn(FieldRawValue, vs...))
+}
+
+// RawValueGT applies the GT predicate on the "raw_value" field.
+func RawValueGT(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldGT(FieldRawValue, v))
+}
+
+// RawValueGTE applies the GTE predicate on the "raw_value" field.
+func RawValueGTE(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldGTE(FieldRawValue, v))
+}
+
+// RawValueLT applies the LT predicate on the "raw_value" field.
+func RawValueLT(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldLT(FieldRawValue, v))
+}
+
+// RawValueLTE applies the LTE predicate on the "raw_value" field.
+func RawValueLTE(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldLTE(FieldRawValue, v))
+}
+
+// RawValueContains applies the Contains predicate on the "raw_value" field.
+func RawValueContains(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldContains(FieldRawValue, v))
+}
+
+// RawValueHasPrefix applies the HasPrefix predicate on the "raw_value" field.
+func RawValueHasPrefix(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldHasPrefix(FieldRawValue, v))
+}
+
+// RawValueHasSuffix applies the HasSuffix predicate on the "raw_value" field.
+func RawValueHasSuffix(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldHasSuffix(FieldRawValue, v))
+}
+
+// RawValueEqualFold applies the EqualFold predicate on the "raw_value" field.
+func RawValueEqualFold(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldEqualFold(FieldRawValue, v))
+}
+
+// RawValueContainsFold applies the ContainsFold predicate on the "raw_value" field.
+func RawValueContainsFold(v string) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.FieldContainsFold(FieldRawValue, v))
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.CategoryMapping {
+	return predicate.CategoryMapping(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.CategoryMapping {
+	return predicate.CategoryMapping(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CategoryMapping) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CategoryMapping) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CategoryMapping) predicate.CategoryMapping {
+	return predicate.CategoryMapping(sql.NotPredicates(p))
+}
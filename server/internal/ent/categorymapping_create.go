@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategoryMappingCreate is the builder for creating a CategoryMapping entity.
+type CategoryMappingCreate struct {
+	config
+	mutation *CategoryMappingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGrocer sets the "grocer" field.
+func (_c *CategoryMappingCreate) SetGrocer(v categorymapping.Grocer) *CategoryMappingCreate {
+	_c.mutation.SetGrocer(v)
+	return _c
+}
+
+// SetRawValue sets the "raw_value" field.
+func (_c *CategoryMappingCreate) SetRawValue(v string) *CategoryMappingCreate {
+	_c.mutation.SetRawValue(v)
+	return _c
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (_c *CategoryMappingCreate) SetCategoryID(id int) *CategoryMappingCreate {
+	_c.mutation.SetCategoryID(id)
+	return _c
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *CategoryMappingCreate) SetCategory(v *Category) *CategoryMappingCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryMappingMutation object of the builder.
+func (_c *CategoryMappingCreate) Mutation() *CategoryMappingMutation {
+	return _c.mutation
+}
+
+// Save creates the CategoryMapping in the database.
+func (_c *CategoryMappingCreate) Save(ctx context.Context) (*CategoryMapping, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CategoryMappingCreate) SaveX(ctx context.Context) *CategoryMapping {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryMappingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryMappingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CategoryMappingCreate) check() error {
+	if _, ok := _c.mutation.Grocer(); !ok {
+		return &ValidationError{Name: "grocer", err: errors.New(`ent: missing required field "CategoryMapping.grocer"`)}
+	}
+	if v, ok := _c.mutation.Grocer(); ok {
+		if err := categorymapping.GrocerValidator(v); err != nil {
+			return &ValidationError{Name: "grocer", err: fmt.Errorf(`ent: validator failed for field "CategoryMapping.grocer": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RawValue(); !ok {
+		return &ValidationError{Name: "raw_value", err: errors.New(`ent: missing required field "CategoryMapping.raw_value"`)}
+	}
+	if v, ok := _c.mutation.RawValue(); ok {
+		if err := categorymapping.RawValueValidator(v); err != nil {
+			return &ValidationError{Name: "raw_value", err: fmt.Errorf(`ent: validator failed for field "CategoryMapping.raw_value": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CategoryIDs()) == 0 {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required edge "CategoryMapping.category"`)}
+	}
+	return nil
+}
+
+func (_c *CategoryMappingCreate) sqlSave(ctx context.Context) (*CategoryMapping, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CategoryMappingCreate) createSpec() (*CategoryMapping, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CategoryMapping{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(categorymapping.Table, sqlgraph.NewFieldSpec(categorymapping.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Grocer(); ok {
+		_spec.SetField(categorymapping.FieldGrocer, field.TypeEnum, value)
+		_node.Grocer = value
+	}
+	if value, ok := _c.mutation.RawValue(); ok {
+		_spec.SetField(categorymapping.FieldRawValue, field.TypeString, value)
+		_node.RawValue = value
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorymapping.CategoryTable,
+			Columns: []string{categorymapping.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.category_mappings = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryMapping.Create().
+//		SetGrocer(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryMappingUpsert) {
+//			SetGrocer(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryMappingCreate) OnConflict(opts ...sql.ConflictOption) *CategoryMappingUpsertOne {
+	_c.conflict = opts
+	return &CategoryMappingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryMappingCreate) OnConflictColumns(columns ...string) *CategoryMappingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryMappingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CategoryMappingUpsertOne is the builder for "upsert"-ing
+	//  one CategoryMapping node.
+	CategoryMappingUpsertOne struct {
+		create *CategoryMappingCreate
+	}
+
+	// CategoryMappingUpsert is the "OnConflict" setter.
+	CategoryMappingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGrocer sets the "grocer" field.
+func (u *CategoryMappingUpsert) SetGrocer(v categorymapping.Grocer) *CategoryMappingUpsert {
+	u.Set(categorymapping.FieldGrocer, v)
+	return u
+}
+
+// UpdateGrocer sets the "grocer" field to the value that was provided on create.
+func (u *CategoryMappingUpsert) UpdateGrocer() *CategoryMappingUpsert {
+	u.SetExcluded(categorymapping.FieldGrocer)
+	return u
+}
+
+// SetRawValue sets the "raw_value" field.
+func (u *CategoryMappingUpsert) SetRawValue(v string) *CategoryMappingUpsert {
+	u.Set(categorymapping.FieldRawValue, v)
+	return u
+}
+
+// UpdateRawValue sets the "raw_value" field to the value that was provided on create.
+func (u *CategoryMappingUpsert) UpdateRawValue() *CategoryMappingUpsert {
+	u.SetExcluded(categorymapping.FieldRawValue)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CategoryMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CategoryMappingUpsertOne) UpdateNewValues() *CategoryMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryMapping.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CategoryMappingUpsertOne) Ignore() *CategoryMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryMappingUpsertOne) DoNothing() *CategoryMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryMappingCreate.OnConflict
+// documentation for more info.
+func (u *CategoryMappingUpsertOne) Update(set func(*CategoryMappingUpsert)) *CategoryMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGrocer sets the "grocer" field.
+func (u *CategoryMappingUpsertOne) SetGrocer(v categorymapping.Grocer) *CategoryMappingUpsertOne {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.SetGrocer(v)
+	})
+}
+
+// UpdateGrocer sets the "grocer" field to the value that was provided on create.
+func (u *CategoryMappingUpsertOne) UpdateGrocer() *CategoryMappingUpsertOne {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.UpdateGrocer()
+	})
+}
+
+// SetRawValue sets the "raw_value" field.
+func (u *CategoryMappingUpsertOne) SetRawValue(v string) *CategoryMappingUpsertOne {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.SetRawValue(v)
+	})
+}
+
+// UpdateRawValue sets the "raw_value" field to the value that was provided on create.
+func (u *CategoryMappingUpsertOne) UpdateRawValue() *CategoryMappingUpsertOne {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.UpdateRawValue()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryMappingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryMappingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryMappingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryMappingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryMappingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CategoryMappingCreateBulk is the builder for creating many CategoryMapping entities in bulk.
+type CategoryMappingCreateBulk struct {
+	config
+	err      error
+	builders []*CategoryMappingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CategoryMapping entities in the database.
+func (_c *CategoryMappingCreateBulk) Save(ctx context.Context) ([]*CategoryMapping, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CategoryMapping, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CategoryMappingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CategoryMappingCreateBulk) SaveX(ctx context.Context) []*CategoryMapping {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CategoryMappingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CategoryMappingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CategoryMapping.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryMappingUpsert) {
+//			SetGrocer(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CategoryMappingCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryMappingUpsertBulk {
+	_c.conflict = opts
+	return &CategoryMappingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CategoryMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CategoryMappingCreateBulk) OnConflictColumns(columns ...string) *CategoryMappingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CategoryMappingUpsertBulk{
+		create: _c,
+	}
+}
+
+// CategoryMappingUpsertBulk is the builder for "upsert"-ing
+// a bulk of CategoryMapping nodes.
+type CategoryMappingUpsertBulk struct {
+	create *CategoryMappingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CategoryMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CategoryMappingUpsertBulk) UpdateNewValues() *CategoryMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CategoryMapping.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CategoryMappingUpsertBulk) Ignore() *CategoryMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryMappingUpsertBulk) DoNothing() *CategoryMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryMappingCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryMappingUpsertBulk) Update(set func(*CategoryMappingUpsert)) *CategoryMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGrocer sets the "grocer" field.
+func (u *CategoryMappingUpsertBulk) SetGrocer(v categorymapping.Grocer) *CategoryMappingUpsertBulk {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.SetGrocer(v)
+	})
+}
+
+// UpdateGrocer sets the "grocer" field to the value that was provided on create.
+func (u *CategoryMappingUpsertBulk) UpdateGrocer() *CategoryMappingUpsertBulk {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.UpdateGrocer()
+	})
+}
+
+// SetRawValue sets the "raw_value" field.
+func (u *CategoryMappingUpsertBulk) SetRawValue(v string) *CategoryMappingUpsertBulk {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.SetRawValue(v)
+	})
+}
+
+// UpdateRawValue sets the "raw_value" field to the value that was provided on create.
+func (u *CategoryMappingUpsertBulk) UpdateRawValue() *CategoryMappingUpsertBulk {
+	return u.Update(func(s *CategoryMappingUpsert) {
+		s.UpdateRawValue()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryMappingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryMappingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryMappingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryMappingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CategoryMappingUpdate is the builder for updating CategoryMapping entities.
+type CategoryMappingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryMappingMutation
+}
+
+// Where appends a list predicates to the CategoryMappingUpdate builder.
+func (_u *CategoryMappingUpdate) Where(ps ...predicate.CategoryMapping) *CategoryMappingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGrocer sets the "grocer" field.
+func (_u *CategoryMappingUpdate) SetGrocer(v categorymapping.Grocer) *CategoryMappingUpdate {
+	_u.mutation.SetGrocer(v)
+	return _u
+}
+
+// SetNillableGrocer sets the "grocer" field if the given value is not nil.
+func (_u *CategoryMappingUpdate) SetNillableGrocer(v *categorymapping.Grocer) *CategoryMappingUpdate {
+	if v != nil {
+		_u.SetGrocer(*v)
+	}
+	return _u
+}
+
+// SetRawValue sets the "raw_value" field.
+func (_u *CategoryMappingUpdate) SetRawValue(v string) *CategoryMappingUpdate {
+	_u.mutation.SetRawValue(v)
+	return _u
+}
+
+// SetNillableRawValue sets the "raw_value" field if the given value is not nil.
+func (_u *CategoryMappingUpdate) SetNillableRawValue(v *string) *CategoryMappingUpdate {
+	if v != nil {
+		_u.SetRawValue(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (_u *CategoryMappingUpdate) SetCategoryID(id int) *CategoryMappingUpdate {
+	_u.mutation.SetCategoryID(id)
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CategoryMappingUpdate) SetCategory(v *Category) *CategoryMappingUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryMappingMutation object of the builder.
+func (_u *CategoryMappingUpdate) Mutation() *CategoryMappingMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CategoryMappingUpdate) ClearCategory() *CategoryMappingUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CategoryMappingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryMappingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CategoryMappingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryMappingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryMappingUpdate) check() error {
+	if v, ok := _u.mutation.Grocer(); ok {
+		if err := categorymapping.GrocerValidator(v); err != nil {
+			return &ValidationError{Name: "grocer", err: fmt.Errorf(`ent: validator failed for field "CategoryMapping.grocer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RawValue(); ok {
+		if err := categorymapping.RawValueValidator(v); err != nil {
+			return &ValidationError{Name: "raw_value", err: fmt.Errorf(`ent: validator failed for field "CategoryMapping.raw_value": %w`, err)}
+		}
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryMapping.category"`)
+	}
+	return nil
+}
+
+func (_u *CategoryMappingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorymapping.Table, categorymapping.Columns, sqlgraph.NewFieldSpec(categorymapping.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Grocer(); ok {
+		_spec.SetField(categorymapping.FieldGrocer, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RawValue(); ok {
+		_spec.SetField(categorymapping.FieldRawValue, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorymapping.CategoryTable,
+			Columns: []string{categorymapping.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorymapping.CategoryTable,
+			Columns: []string{categorymapping.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorymapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CategoryMappingUpdateOne is the builder for updating a single CategoryMapping entity.
+type CategoryMappingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryMappingMutation
+}
+
+// SetGrocer sets the "grocer" field.
+func (_u *CategoryMappingUpdateOne) SetGrocer(v categorymapping.Grocer) *CategoryMappingUpdateOne {
+	_u.mutation.SetGrocer(v)
+	return _u
+}
+
+// SetNillableGrocer sets the "grocer" field if the given value is not nil.
+func (_u *CategoryMappingUpdateOne) SetNillableGrocer(v *categorymapping.Grocer) *CategoryMappingUpdateOne {
+	if v != nil {
+		_u.SetGrocer(*v)
+	}
+	return _u
+}
+
+// SetRawValue sets the "raw_value" field.
+func (_u *CategoryMappingUpdateOne) SetRawValue(v string) *CategoryMappingUpdateOne {
+	_u.mutation.SetRawValue(v)
+	return _u
+}
+
+// SetNillableRawValue sets the "raw_value" field if the given value is not nil.
+func (_u *CategoryMappingUpdateOne) SetNillableRawValue(v *string) *CategoryMappingUpdateOne {
+	if v != nil {
+		_u.SetRawValue(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (_u *CategoryMappingUpdateOne) SetCategoryID(id int) *CategoryMappingUpdateOne {
+	_u.mutation.SetCategoryID(id)
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CategoryMappingUpdateOne) SetCategory(v *Category) *CategoryMappingUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CategoryMappingMutation object of the builder.
+func (_u *CategoryMappingUpdateOne) Mutation() *CategoryMappingMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CategoryMappingUpdateOne) ClearCategory() *CategoryMappingUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the CategoryMappingUpdate builder.
+func (_u *CategoryMappingUpdateOne) Where(ps ...predicate.CategoryMapping) *CategoryMappingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CategoryMappingUpdateOne) Select(field string, fields ...string) *CategoryMappingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CategoryMapping entity.
+func (_u *CategoryMappingUpdateOne) Save(ctx context.Context) (*CategoryMapping, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CategoryMappingUpdateOne) SaveX(ctx context.Context) *CategoryMapping {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CategoryMappingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CategoryMappingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CategoryMappingUpdateOne) check() error {
+	if v, ok := _u.mutation.Grocer(); ok {
+		if err := categorymapping.GrocerValidator(v); err != nil {
+			return &ValidationError{Name: "grocer", err: fmt.Errorf(`ent: validator failed for field "CategoryMapping.grocer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RawValue(); ok {
+		if err := categorymapping.RawValueValidator(v); err != nil {
+			return &ValidationError{Name: "raw_value", err: fmt.Errorf(`ent: validator failed for field "CategoryMapping.raw_value": %w`, err)}
+		}
+	}
+	if _u.mutation.CategoryCleared() && len(_u.mutation.CategoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CategoryMapping.category"`)
+	}
+	return nil
+}
+
+func (_u *CategoryMappingUpdateOne) sqlSave(ctx context.Context) (_node *CategoryMapping, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(categorymapping.Table, categorymapping.Columns, sqlgraph.NewFieldSpec(categorymapping.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CategoryMapping.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, categorymapping.FieldID)
+		for _, f := range fields {
+			if !categorymapping.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != categorymapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Grocer(); ok {
+		_spec.SetField(categorymapping.FieldGrocer, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RawValue(); ok {
+		_spec.SetField(categorymapping.FieldRawValue, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorymapping.CategoryTable,
+			Columns: []string{categorymapping.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   categorymapping.CategoryTable,
+			Columns: []string{categorymapping.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CategoryMapping{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{categorymapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
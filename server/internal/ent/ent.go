@@ -6,8 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/ent/exchangerate"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/list"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/product"
 	"offgrocery-assessment/internal/ent/store"
 	"offgrocery-assessment/internal/ent/user"
 	"reflect"
@@ -76,10 +84,18 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			item.Table:  item.ValidColumn,
-			list.Table:  list.ValidColumn,
-			store.Table: store.ValidColumn,
-			user.Table:  user.ValidColumn,
+			attribute.Table:        attribute.ValidColumn,
+			category.Table:         category.ValidColumn,
+			categorymapping.Table:  categorymapping.ValidColumn,
+			exchangerate.Table:     exchangerate.ValidColumn,
+			importjob.Table:        importjob.ValidColumn,
+			importrun.Table:        importrun.ValidColumn,
+			item.Table:             item.ValidColumn,
+			list.Table:             list.ValidColumn,
+			priceobservation.Table: priceobservation.ValidColumn,
+			product.Table:          product.ValidColumn,
+			store.Table:            store.ValidColumn,
+			user.Table:             user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -11,7 +11,9 @@ import (
 
 // this is invoked from the server pkg level
 func main() {
-	if err := entc.Generate("./internal/ent/schema", &gen.Config{}); err != nil {
+	if err := entc.Generate("./internal/ent/schema", &gen.Config{
+		Features: []gen.Feature{gen.FeatureUpsert, gen.FeatureExecQuery},
+	}); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
 }
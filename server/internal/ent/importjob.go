@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ImportJob is the model entity for the ImportJob schema.
+type ImportJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// The name the feed was uploaded under.
+	FileName string `json:"file_name,omitempty"`
+	// Where the uploaded feed is spooled on the server until it is imported.
+	FilePath string `json:"-"`
+	// The format hint given with the upload, empty to detect it.
+	Format string `json:"format,omitempty"`
+	// Force holds the value of the "force" field.
+	Force bool `json:"force,omitempty"`
+	// MaxErrors holds the value of the "max_errors" field.
+	MaxErrors int `json:"max_errors,omitempty"`
+	// skipped means identical contents were already imported.
+	Status importjob.Status `json:"status,omitempty"`
+	// RowsTotal holds the value of the "rows_total" field.
+	RowsTotal int `json:"rows_total,omitempty"`
+	// RowsProcessed holds the value of the "rows_processed" field.
+	RowsProcessed int `json:"rows_processed,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// The queue running the job, set when it is claimed.
+	Owner string `json:"owner,omitempty"`
+	// When a running job may be claimed by another queue, unless its owner renews the lease first.
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportJobQuery when eager-loading is set.
+	Edges                 ImportJobEdges `json:"edges"`
+	import_job_import_run *int
+	selectValues          sql.SelectValues
+}
+
+// ImportJobEdges holds the relations/edges for other nodes in the graph.
+type ImportJobEdges struct {
+	// ImportRun holds the value of the import_run edge.
+	ImportRun *ImportRun `json:"import_run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ImportRunOrErr returns the ImportRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportJobEdges) ImportRunOrErr() (*ImportRun, error) {
+	if e.ImportRun != nil {
+		return e.ImportRun, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: importrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "import_run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldForce:
+			values[i] = new(sql.NullBool)
+		case importjob.FieldID, importjob.FieldMaxErrors, importjob.FieldRowsTotal, importjob.FieldRowsProcessed:
+			values[i] = new(sql.NullInt64)
+		case importjob.FieldFileName, importjob.FieldFilePath, importjob.FieldFormat, importjob.FieldStatus, importjob.FieldError, importjob.FieldOwner:
+			values[i] = new(sql.NullString)
+		case importjob.FieldCreateTime, importjob.FieldUpdateTime, importjob.FieldStartedAt, importjob.FieldFinishedAt, importjob.FieldLeaseExpiresAt:
+			values[i] = new(sql.NullTime)
+		case importjob.ForeignKeys[0]: // import_job_import_run
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportJob fields.
+func (_m *ImportJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case importjob.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case importjob.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case importjob.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				_m.FileName = value.String
+			}
+		case importjob.FieldFilePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_path", values[i])
+			} else if value.Valid {
+				_m.FilePath = value.String
+			}
+		case importjob.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case importjob.FieldForce:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field force", values[i])
+			} else if value.Valid {
+				_m.Force = value.Bool
+			}
+		case importjob.FieldMaxErrors:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_errors", values[i])
+			} else if value.Valid {
+				_m.MaxErrors = int(value.Int64)
+			}
+		case importjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = importjob.Status(value.String)
+			}
+		case importjob.FieldRowsTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rows_total", values[i])
+			} else if value.Valid {
+				_m.RowsTotal = int(value.Int64)
+			}
+		case importjob.FieldRowsProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rows_processed", values[i])
+			} else if value.Valid {
+				_m.RowsProcessed = int(value.Int64)
+			}
+		case importjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case importjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case importjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case importjob.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.String
+			}
+		case importjob.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				_m.LeaseExpiresAt = new(time.Time)
+				*_m.LeaseExpiresAt = value.Time
+			}
+		case importjob.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field import_job_import_run", value)
+			} else if value.Valid {
+				_m.import_job_import_run = new(int)
+				*_m.import_job_import_run = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryImportRun queries the "import_run" edge of the ImportJob entity.
+func (_m *ImportJob) QueryImportRun() *ImportRunQuery {
+	return NewImportJobClient(_m.config).QueryImportRun(_m)
+}
+
+// Update returns a builder for updating this ImportJob.
+// Note that you need to call ImportJob.Unwrap() before calling this method if this ImportJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportJob) Update() *ImportJobUpdateOne {
+	return NewImportJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportJob) Unwrap() *ImportJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("file_name=")
+	builder.WriteString(_m.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("file_path=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("force=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Force))
+	builder.WriteString(", ")
+	builder.WriteString("max_errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxErrors))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("rows_total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RowsTotal))
+	builder.WriteString(", ")
+	builder.WriteString("rows_processed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RowsProcessed))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(_m.Owner)
+	builder.WriteString(", ")
+	if v := _m.LeaseExpiresAt; v != nil {
+		builder.WriteString("lease_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportJobs is a parsable slice of ImportJob.
+type ImportJobs []*ImportJob
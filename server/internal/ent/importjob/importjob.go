@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importjob type in the database.
+	Label = "import_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldFilePath holds the string denoting the file_path field in the database.
+	FieldFilePath = "file_path"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldForce holds the string denoting the force field in the database.
+	FieldForce = "force"
+	// FieldMaxErrors holds the string denoting the max_errors field in the database.
+	FieldMaxErrors = "max_errors"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRowsTotal holds the string denoting the rows_total field in the database.
+	FieldRowsTotal = "rows_total"
+	// FieldRowsProcessed holds the string denoting the rows_processed field in the database.
+	FieldRowsProcessed = "rows_processed"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldLeaseExpiresAt holds the string denoting the lease_expires_at field in the database.
+	FieldLeaseExpiresAt = "lease_expires_at"
+	// EdgeImportRun holds the string denoting the import_run edge name in mutations.
+	EdgeImportRun = "import_run"
+	// Table holds the table name of the importjob in the database.
+	Table = "import_jobs"
+	// ImportRunTable is the table that holds the import_run relation/edge.
+	ImportRunTable = "import_jobs"
+	// ImportRunInverseTable is the table name for the ImportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "importrun" package.
+	ImportRunInverseTable = "import_runs"
+	// ImportRunColumn is the table column denoting the import_run relation/edge.
+	ImportRunColumn = "import_job_import_run"
+)
+
+// Columns holds all SQL columns for importjob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldFileName,
+	FieldFilePath,
+	FieldFormat,
+	FieldForce,
+	FieldMaxErrors,
+	FieldStatus,
+	FieldRowsTotal,
+	FieldRowsProcessed,
+	FieldError,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldOwner,
+	FieldLeaseExpiresAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "import_jobs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"import_job_import_run",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	FileNameValidator func(string) error
+	// FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
+	FilePathValidator func(string) error
+	// DefaultForce holds the default value on creation for the "force" field.
+	DefaultForce bool
+	// DefaultMaxErrors holds the default value on creation for the "max_errors" field.
+	DefaultMaxErrors int
+	// DefaultRowsTotal holds the default value on creation for the "rows_total" field.
+	DefaultRowsTotal int
+	// DefaultRowsProcessed holds the default value on creation for the "rows_processed" field.
+	DefaultRowsProcessed int
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusQueued is the default value of the Status enum.
+const DefaultStatus = StatusQueued
+
+// Status values.
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusSkipped   Status = "skipped"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusQueued, StatusRunning, StatusSucceeded, StatusSkipped, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("importjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ImportJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByFileName orders the results by the file_name field.
+func ByFileName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileName, opts...).ToFunc()
+}
+
+// ByFilePath orders the results by the file_path field.
+func ByFilePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilePath, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByForce orders the results by the force field.
+func ByForce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForce, opts...).ToFunc()
+}
+
+// ByMaxErrors orders the results by the max_errors field.
+func ByMaxErrors(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxErrors, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRowsTotal orders the results by the rows_total field.
+func ByRowsTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRowsTotal, opts...).ToFunc()
+}
+
+// ByRowsProcessed orders the results by the rows_processed field.
+func ByRowsProcessed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRowsProcessed, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByLeaseExpiresAt orders the results by the lease_expires_at field.
+func ByLeaseExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseExpiresAt, opts...).ToFunc()
+}
+
+// ByImportRunField orders the results by import_run field.
+func ByImportRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newImportRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ImportRunTable, ImportRunColumn),
+	)
+}
@@ -0,0 +1,1521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJobCreate is the builder for creating a ImportJob entity.
+type ImportJobCreate struct {
+	config
+	mutation *ImportJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ImportJobCreate) SetCreateTime(v time.Time) *ImportJobCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableCreateTime(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ImportJobCreate) SetUpdateTime(v time.Time) *ImportJobCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableUpdateTime(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *ImportJobCreate) SetFileName(v string) *ImportJobCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetFilePath sets the "file_path" field.
+func (_c *ImportJobCreate) SetFilePath(v string) *ImportJobCreate {
+	_c.mutation.SetFilePath(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *ImportJobCreate) SetFormat(v string) *ImportJobCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableFormat(v *string) *ImportJobCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetForce sets the "force" field.
+func (_c *ImportJobCreate) SetForce(v bool) *ImportJobCreate {
+	_c.mutation.SetForce(v)
+	return _c
+}
+
+// SetNillableForce sets the "force" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableForce(v *bool) *ImportJobCreate {
+	if v != nil {
+		_c.SetForce(*v)
+	}
+	return _c
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (_c *ImportJobCreate) SetMaxErrors(v int) *ImportJobCreate {
+	_c.mutation.SetMaxErrors(v)
+	return _c
+}
+
+// SetNillableMaxErrors sets the "max_errors" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableMaxErrors(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetMaxErrors(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ImportJobCreate) SetStatus(v importjob.Status) *ImportJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableStatus(v *importjob.Status) *ImportJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (_c *ImportJobCreate) SetRowsTotal(v int) *ImportJobCreate {
+	_c.mutation.SetRowsTotal(v)
+	return _c
+}
+
+// SetNillableRowsTotal sets the "rows_total" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableRowsTotal(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetRowsTotal(*v)
+	}
+	return _c
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (_c *ImportJobCreate) SetRowsProcessed(v int) *ImportJobCreate {
+	_c.mutation.SetRowsProcessed(v)
+	return _c
+}
+
+// SetNillableRowsProcessed sets the "rows_processed" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableRowsProcessed(v *int) *ImportJobCreate {
+	if v != nil {
+		_c.SetRowsProcessed(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ImportJobCreate) SetError(v string) *ImportJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableError(v *string) *ImportJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ImportJobCreate) SetStartedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableStartedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ImportJobCreate) SetFinishedAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableFinishedAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *ImportJobCreate) SetOwner(v string) *ImportJobCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableOwner(v *string) *ImportJobCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_c *ImportJobCreate) SetLeaseExpiresAt(v time.Time) *ImportJobCreate {
+	_c.mutation.SetLeaseExpiresAt(v)
+	return _c
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableLeaseExpiresAt(v *time.Time) *ImportJobCreate {
+	if v != nil {
+		_c.SetLeaseExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by ID.
+func (_c *ImportJobCreate) SetImportRunID(id int) *ImportJobCreate {
+	_c.mutation.SetImportRunID(id)
+	return _c
+}
+
+// SetNillableImportRunID sets the "import_run" edge to the ImportRun entity by ID if the given value is not nil.
+func (_c *ImportJobCreate) SetNillableImportRunID(id *int) *ImportJobCreate {
+	if id != nil {
+		_c = _c.SetImportRunID(*id)
+	}
+	return _c
+}
+
+// SetImportRun sets the "import_run" edge to the ImportRun entity.
+func (_c *ImportJobCreate) SetImportRun(v *ImportRun) *ImportJobCreate {
+	return _c.SetImportRunID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_c *ImportJobCreate) Mutation() *ImportJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportJob in the database.
+func (_c *ImportJobCreate) Save(ctx context.Context) (*ImportJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportJobCreate) SaveX(ctx context.Context) *ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportJobCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := importjob.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := importjob.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Force(); !ok {
+		v := importjob.DefaultForce
+		_c.mutation.SetForce(v)
+	}
+	if _, ok := _c.mutation.MaxErrors(); !ok {
+		v := importjob.DefaultMaxErrors
+		_c.mutation.SetMaxErrors(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := importjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RowsTotal(); !ok {
+		v := importjob.DefaultRowsTotal
+		_c.mutation.SetRowsTotal(v)
+	}
+	if _, ok := _c.mutation.RowsProcessed(); !ok {
+		v := importjob.DefaultRowsProcessed
+		_c.mutation.SetRowsProcessed(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportJobCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ImportJob.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ImportJob.update_time"`)}
+	}
+	if _, ok := _c.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "ImportJob.file_name"`)}
+	}
+	if v, ok := _c.mutation.FileName(); ok {
+		if err := importjob.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ImportJob.file_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FilePath(); !ok {
+		return &ValidationError{Name: "file_path", err: errors.New(`ent: missing required field "ImportJob.file_path"`)}
+	}
+	if v, ok := _c.mutation.FilePath(); ok {
+		if err := importjob.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "ImportJob.file_path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Force(); !ok {
+		return &ValidationError{Name: "force", err: errors.New(`ent: missing required field "ImportJob.force"`)}
+	}
+	if _, ok := _c.mutation.MaxErrors(); !ok {
+		return &ValidationError{Name: "max_errors", err: errors.New(`ent: missing required field "ImportJob.max_errors"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RowsTotal(); !ok {
+		return &ValidationError{Name: "rows_total", err: errors.New(`ent: missing required field "ImportJob.rows_total"`)}
+	}
+	if _, ok := _c.mutation.RowsProcessed(); !ok {
+		return &ValidationError{Name: "rows_processed", err: errors.New(`ent: missing required field "ImportJob.rows_processed"`)}
+	}
+	return nil
+}
+
+func (_c *ImportJobCreate) sqlSave(ctx context.Context) (*ImportJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportJobCreate) createSpec() (*ImportJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importjob.Table, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(importjob.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(importjob.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(importjob.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.FilePath(); ok {
+		_spec.SetField(importjob.FieldFilePath, field.TypeString, value)
+		_node.FilePath = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(importjob.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Force(); ok {
+		_spec.SetField(importjob.FieldForce, field.TypeBool, value)
+		_node.Force = value
+	}
+	if value, ok := _c.mutation.MaxErrors(); ok {
+		_spec.SetField(importjob.FieldMaxErrors, field.TypeInt, value)
+		_node.MaxErrors = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RowsTotal(); ok {
+		_spec.SetField(importjob.FieldRowsTotal, field.TypeInt, value)
+		_node.RowsTotal = value
+	}
+	if value, ok := _c.mutation.RowsProcessed(); ok {
+		_spec.SetField(importjob.FieldRowsProcessed, field.TypeInt, value)
+		_node.RowsProcessed = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(importjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(importjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(importjob.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(importjob.FieldLeaseExpiresAt, field.TypeTime, value)
+		_node.LeaseExpiresAt = &value
+	}
+	if nodes := _c.mutation.ImportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.ImportRunTable,
+			Columns: []string{importjob.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.import_job_import_run = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportJob.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportJobUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportJobCreate) OnConflict(opts ...sql.ConflictOption) *ImportJobUpsertOne {
+	_c.conflict = opts
+	return &ImportJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportJobCreate) OnConflictColumns(columns ...string) *ImportJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportJobUpsertOne is the builder for "upsert"-ing
+	//  one ImportJob node.
+	ImportJobUpsertOne struct {
+		create *ImportJobCreate
+	}
+
+	// ImportJobUpsert is the "OnConflict" setter.
+	ImportJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportJobUpsert) SetUpdateTime(v time.Time) *ImportJobUpsert {
+	u.Set(importjob.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateUpdateTime() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldUpdateTime)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ImportJobUpsert) SetFileName(v string) *ImportJobUpsert {
+	u.Set(importjob.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateFileName() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldFileName)
+	return u
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ImportJobUpsert) SetFilePath(v string) *ImportJobUpsert {
+	u.Set(importjob.FieldFilePath, v)
+	return u
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateFilePath() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldFilePath)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *ImportJobUpsert) SetFormat(v string) *ImportJobUpsert {
+	u.Set(importjob.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateFormat() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldFormat)
+	return u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *ImportJobUpsert) ClearFormat() *ImportJobUpsert {
+	u.SetNull(importjob.FieldFormat)
+	return u
+}
+
+// SetForce sets the "force" field.
+func (u *ImportJobUpsert) SetForce(v bool) *ImportJobUpsert {
+	u.Set(importjob.FieldForce, v)
+	return u
+}
+
+// UpdateForce sets the "force" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateForce() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldForce)
+	return u
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (u *ImportJobUpsert) SetMaxErrors(v int) *ImportJobUpsert {
+	u.Set(importjob.FieldMaxErrors, v)
+	return u
+}
+
+// UpdateMaxErrors sets the "max_errors" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateMaxErrors() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldMaxErrors)
+	return u
+}
+
+// AddMaxErrors adds v to the "max_errors" field.
+func (u *ImportJobUpsert) AddMaxErrors(v int) *ImportJobUpsert {
+	u.Add(importjob.FieldMaxErrors, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportJobUpsert) SetStatus(v importjob.Status) *ImportJobUpsert {
+	u.Set(importjob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateStatus() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldStatus)
+	return u
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (u *ImportJobUpsert) SetRowsTotal(v int) *ImportJobUpsert {
+	u.Set(importjob.FieldRowsTotal, v)
+	return u
+}
+
+// UpdateRowsTotal sets the "rows_total" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateRowsTotal() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldRowsTotal)
+	return u
+}
+
+// AddRowsTotal adds v to the "rows_total" field.
+func (u *ImportJobUpsert) AddRowsTotal(v int) *ImportJobUpsert {
+	u.Add(importjob.FieldRowsTotal, v)
+	return u
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (u *ImportJobUpsert) SetRowsProcessed(v int) *ImportJobUpsert {
+	u.Set(importjob.FieldRowsProcessed, v)
+	return u
+}
+
+// UpdateRowsProcessed sets the "rows_processed" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateRowsProcessed() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldRowsProcessed)
+	return u
+}
+
+// AddRowsProcessed adds v to the "rows_processed" field.
+func (u *ImportJobUpsert) AddRowsProcessed(v int) *ImportJobUpsert {
+	u.Add(importjob.FieldRowsProcessed, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ImportJobUpsert) SetError(v string) *ImportJobUpsert {
+	u.Set(importjob.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateError() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportJobUpsert) ClearError() *ImportJobUpsert {
+	u.SetNull(importjob.FieldError)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ImportJobUpsert) SetStartedAt(v time.Time) *ImportJobUpsert {
+	u.Set(importjob.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateStartedAt() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ImportJobUpsert) ClearStartedAt() *ImportJobUpsert {
+	u.SetNull(importjob.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportJobUpsert) SetFinishedAt(v time.Time) *ImportJobUpsert {
+	u.Set(importjob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateFinishedAt() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportJobUpsert) ClearFinishedAt() *ImportJobUpsert {
+	u.SetNull(importjob.FieldFinishedAt)
+	return u
+}
+
+// SetOwner sets the "owner" field.
+func (u *ImportJobUpsert) SetOwner(v string) *ImportJobUpsert {
+	u.Set(importjob.FieldOwner, v)
+	return u
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateOwner() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldOwner)
+	return u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *ImportJobUpsert) ClearOwner() *ImportJobUpsert {
+	u.SetNull(importjob.FieldOwner)
+	return u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ImportJobUpsert) SetLeaseExpiresAt(v time.Time) *ImportJobUpsert {
+	u.Set(importjob.FieldLeaseExpiresAt, v)
+	return u
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ImportJobUpsert) UpdateLeaseExpiresAt() *ImportJobUpsert {
+	u.SetExcluded(importjob.FieldLeaseExpiresAt)
+	return u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ImportJobUpsert) ClearLeaseExpiresAt() *ImportJobUpsert {
+	u.SetNull(importjob.FieldLeaseExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ImportJobUpsertOne) UpdateNewValues() *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(importjob.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportJobUpsertOne) Ignore() *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportJobUpsertOne) DoNothing() *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportJobCreate.OnConflict
+// documentation for more info.
+func (u *ImportJobUpsertOne) Update(set func(*ImportJobUpsert)) *ImportJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportJobUpsertOne) SetUpdateTime(v time.Time) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateUpdateTime() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ImportJobUpsertOne) SetFileName(v string) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateFileName() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ImportJobUpsertOne) SetFilePath(v string) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFilePath(v)
+	})
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateFilePath() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFilePath()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *ImportJobUpsertOne) SetFormat(v string) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateFormat() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *ImportJobUpsertOne) ClearFormat() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearFormat()
+	})
+}
+
+// SetForce sets the "force" field.
+func (u *ImportJobUpsertOne) SetForce(v bool) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetForce(v)
+	})
+}
+
+// UpdateForce sets the "force" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateForce() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateForce()
+	})
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (u *ImportJobUpsertOne) SetMaxErrors(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetMaxErrors(v)
+	})
+}
+
+// AddMaxErrors adds v to the "max_errors" field.
+func (u *ImportJobUpsertOne) AddMaxErrors(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddMaxErrors(v)
+	})
+}
+
+// UpdateMaxErrors sets the "max_errors" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateMaxErrors() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateMaxErrors()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportJobUpsertOne) SetStatus(v importjob.Status) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateStatus() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (u *ImportJobUpsertOne) SetRowsTotal(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetRowsTotal(v)
+	})
+}
+
+// AddRowsTotal adds v to the "rows_total" field.
+func (u *ImportJobUpsertOne) AddRowsTotal(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddRowsTotal(v)
+	})
+}
+
+// UpdateRowsTotal sets the "rows_total" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateRowsTotal() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateRowsTotal()
+	})
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (u *ImportJobUpsertOne) SetRowsProcessed(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetRowsProcessed(v)
+	})
+}
+
+// AddRowsProcessed adds v to the "rows_processed" field.
+func (u *ImportJobUpsertOne) AddRowsProcessed(v int) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddRowsProcessed(v)
+	})
+}
+
+// UpdateRowsProcessed sets the "rows_processed" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateRowsProcessed() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateRowsProcessed()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ImportJobUpsertOne) SetError(v string) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateError() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportJobUpsertOne) ClearError() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ImportJobUpsertOne) SetStartedAt(v time.Time) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateStartedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ImportJobUpsertOne) ClearStartedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportJobUpsertOne) SetFinishedAt(v time.Time) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateFinishedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportJobUpsertOne) ClearFinishedAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *ImportJobUpsertOne) SetOwner(v string) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateOwner() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *ImportJobUpsertOne) ClearOwner() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearOwner()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ImportJobUpsertOne) SetLeaseExpiresAt(v time.Time) *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ImportJobUpsertOne) UpdateLeaseExpiresAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ImportJobUpsertOne) ClearLeaseExpiresAt() *ImportJobUpsertOne {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportJobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportJobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportJobCreateBulk is the builder for creating many ImportJob entities in bulk.
+type ImportJobCreateBulk struct {
+	config
+	err      error
+	builders []*ImportJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportJob entities in the database.
+func (_c *ImportJobCreateBulk) Save(ctx context.Context) ([]*ImportJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) SaveX(ctx context.Context) []*ImportJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportJobUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportJobUpsertBulk {
+	_c.conflict = opts
+	return &ImportJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportJobCreateBulk) OnConflictColumns(columns ...string) *ImportJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportJob nodes.
+type ImportJobUpsertBulk struct {
+	create *ImportJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ImportJobUpsertBulk) UpdateNewValues() *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(importjob.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportJobUpsertBulk) Ignore() *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportJobUpsertBulk) DoNothing() *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportJobUpsertBulk) Update(set func(*ImportJobUpsert)) *ImportJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ImportJobUpsertBulk) SetUpdateTime(v time.Time) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateUpdateTime() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ImportJobUpsertBulk) SetFileName(v string) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateFileName() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetFilePath sets the "file_path" field.
+func (u *ImportJobUpsertBulk) SetFilePath(v string) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFilePath(v)
+	})
+}
+
+// UpdateFilePath sets the "file_path" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateFilePath() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFilePath()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *ImportJobUpsertBulk) SetFormat(v string) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateFormat() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *ImportJobUpsertBulk) ClearFormat() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearFormat()
+	})
+}
+
+// SetForce sets the "force" field.
+func (u *ImportJobUpsertBulk) SetForce(v bool) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetForce(v)
+	})
+}
+
+// UpdateForce sets the "force" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateForce() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateForce()
+	})
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (u *ImportJobUpsertBulk) SetMaxErrors(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetMaxErrors(v)
+	})
+}
+
+// AddMaxErrors adds v to the "max_errors" field.
+func (u *ImportJobUpsertBulk) AddMaxErrors(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddMaxErrors(v)
+	})
+}
+
+// UpdateMaxErrors sets the "max_errors" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateMaxErrors() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateMaxErrors()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportJobUpsertBulk) SetStatus(v importjob.Status) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateStatus() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (u *ImportJobUpsertBulk) SetRowsTotal(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetRowsTotal(v)
+	})
+}
+
+// AddRowsTotal adds v to the "rows_total" field.
+func (u *ImportJobUpsertBulk) AddRowsTotal(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddRowsTotal(v)
+	})
+}
+
+// UpdateRowsTotal sets the "rows_total" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateRowsTotal() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateRowsTotal()
+	})
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (u *ImportJobUpsertBulk) SetRowsProcessed(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetRowsProcessed(v)
+	})
+}
+
+// AddRowsProcessed adds v to the "rows_processed" field.
+func (u *ImportJobUpsertBulk) AddRowsProcessed(v int) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.AddRowsProcessed(v)
+	})
+}
+
+// UpdateRowsProcessed sets the "rows_processed" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateRowsProcessed() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateRowsProcessed()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ImportJobUpsertBulk) SetError(v string) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateError() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportJobUpsertBulk) ClearError() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *ImportJobUpsertBulk) SetStartedAt(v time.Time) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateStartedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *ImportJobUpsertBulk) ClearStartedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportJobUpsertBulk) SetFinishedAt(v time.Time) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateFinishedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportJobUpsertBulk) ClearFinishedAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetOwner sets the "owner" field.
+func (u *ImportJobUpsertBulk) SetOwner(v string) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetOwner(v)
+	})
+}
+
+// UpdateOwner sets the "owner" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateOwner() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateOwner()
+	})
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (u *ImportJobUpsertBulk) ClearOwner() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearOwner()
+	})
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (u *ImportJobUpsertBulk) SetLeaseExpiresAt(v time.Time) *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.SetLeaseExpiresAt(v)
+	})
+}
+
+// UpdateLeaseExpiresAt sets the "lease_expires_at" field to the value that was provided on create.
+func (u *ImportJobUpsertBulk) UpdateLeaseExpiresAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.UpdateLeaseExpiresAt()
+	})
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (u *ImportJobUpsertBulk) ClearLeaseExpiresAt() *ImportJobUpsertBulk {
+	return u.Update(func(s *ImportJobUpsert) {
+		s.ClearLeaseExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
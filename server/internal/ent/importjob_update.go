@@ -0,0 +1,977 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportJobUpdate is the builder for updating ImportJob entities.
+type ImportJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImportJobMutation
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdate) Where(ps ...predicate.ImportJob) *ImportJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportJobUpdate) SetUpdateTime(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *ImportJobUpdate) SetFileName(v string) *ImportJobUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFileName(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *ImportJobUpdate) SetFilePath(v string) *ImportJobUpdate {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFilePath(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ImportJobUpdate) SetFormat(v string) *ImportJobUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFormat(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *ImportJobUpdate) ClearFormat() *ImportJobUpdate {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetForce sets the "force" field.
+func (_u *ImportJobUpdate) SetForce(v bool) *ImportJobUpdate {
+	_u.mutation.SetForce(v)
+	return _u
+}
+
+// SetNillableForce sets the "force" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableForce(v *bool) *ImportJobUpdate {
+	if v != nil {
+		_u.SetForce(*v)
+	}
+	return _u
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (_u *ImportJobUpdate) SetMaxErrors(v int) *ImportJobUpdate {
+	_u.mutation.ResetMaxErrors()
+	_u.mutation.SetMaxErrors(v)
+	return _u
+}
+
+// SetNillableMaxErrors sets the "max_errors" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableMaxErrors(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetMaxErrors(*v)
+	}
+	return _u
+}
+
+// AddMaxErrors adds value to the "max_errors" field.
+func (_u *ImportJobUpdate) AddMaxErrors(v int) *ImportJobUpdate {
+	_u.mutation.AddMaxErrors(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportJobUpdate) SetStatus(v importjob.Status) *ImportJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableStatus(v *importjob.Status) *ImportJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (_u *ImportJobUpdate) SetRowsTotal(v int) *ImportJobUpdate {
+	_u.mutation.ResetRowsTotal()
+	_u.mutation.SetRowsTotal(v)
+	return _u
+}
+
+// SetNillableRowsTotal sets the "rows_total" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableRowsTotal(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetRowsTotal(*v)
+	}
+	return _u
+}
+
+// AddRowsTotal adds value to the "rows_total" field.
+func (_u *ImportJobUpdate) AddRowsTotal(v int) *ImportJobUpdate {
+	_u.mutation.AddRowsTotal(v)
+	return _u
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (_u *ImportJobUpdate) SetRowsProcessed(v int) *ImportJobUpdate {
+	_u.mutation.ResetRowsProcessed()
+	_u.mutation.SetRowsProcessed(v)
+	return _u
+}
+
+// SetNillableRowsProcessed sets the "rows_processed" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableRowsProcessed(v *int) *ImportJobUpdate {
+	if v != nil {
+		_u.SetRowsProcessed(*v)
+	}
+	return _u
+}
+
+// AddRowsProcessed adds value to the "rows_processed" field.
+func (_u *ImportJobUpdate) AddRowsProcessed(v int) *ImportJobUpdate {
+	_u.mutation.AddRowsProcessed(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ImportJobUpdate) SetError(v string) *ImportJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableError(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ImportJobUpdate) ClearError() *ImportJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImportJobUpdate) SetStartedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableStartedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ImportJobUpdate) ClearStartedAt() *ImportJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportJobUpdate) SetFinishedAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableFinishedAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportJobUpdate) ClearFinishedAt() *ImportJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *ImportJobUpdate) SetOwner(v string) *ImportJobUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableOwner(v *string) *ImportJobUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *ImportJobUpdate) ClearOwner() *ImportJobUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_u *ImportJobUpdate) SetLeaseExpiresAt(v time.Time) *ImportJobUpdate {
+	_u.mutation.SetLeaseExpiresAt(v)
+	return _u
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableLeaseExpiresAt(v *time.Time) *ImportJobUpdate {
+	if v != nil {
+		_u.SetLeaseExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (_u *ImportJobUpdate) ClearLeaseExpiresAt() *ImportJobUpdate {
+	_u.mutation.ClearLeaseExpiresAt()
+	return _u
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by ID.
+func (_u *ImportJobUpdate) SetImportRunID(id int) *ImportJobUpdate {
+	_u.mutation.SetImportRunID(id)
+	return _u
+}
+
+// SetNillableImportRunID sets the "import_run" edge to the ImportRun entity by ID if the given value is not nil.
+func (_u *ImportJobUpdate) SetNillableImportRunID(id *int) *ImportJobUpdate {
+	if id != nil {
+		_u = _u.SetImportRunID(*id)
+	}
+	return _u
+}
+
+// SetImportRun sets the "import_run" edge to the ImportRun entity.
+func (_u *ImportJobUpdate) SetImportRun(v *ImportRun) *ImportJobUpdate {
+	return _u.SetImportRunID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdate) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearImportRun clears the "import_run" edge to the ImportRun entity.
+func (_u *ImportJobUpdate) ClearImportRun() *ImportJobUpdate {
+	_u.mutation.ClearImportRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := importjob.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportJobUpdate) check() error {
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := importjob.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ImportJob.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FilePath(); ok {
+		if err := importjob.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "ImportJob.file_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImportJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(importjob.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(importjob.FieldFilePath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(importjob.FieldFormat, field.TypeString, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(importjob.FieldFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.Force(); ok {
+		_spec.SetField(importjob.FieldForce, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxErrors(); ok {
+		_spec.SetField(importjob.FieldMaxErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxErrors(); ok {
+		_spec.AddField(importjob.FieldMaxErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RowsTotal(); ok {
+		_spec.SetField(importjob.FieldRowsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRowsTotal(); ok {
+		_spec.AddField(importjob.FieldRowsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RowsProcessed(); ok {
+		_spec.SetField(importjob.FieldRowsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRowsProcessed(); ok {
+		_spec.AddField(importjob.FieldRowsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(importjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(importjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(importjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(importjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(importjob.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(importjob.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(importjob.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(importjob.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.ImportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.ImportRunTable,
+			Columns: []string{importjob.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.ImportRunTable,
+			Columns: []string{importjob.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportJobUpdateOne is the builder for updating a single ImportJob entity.
+type ImportJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImportJobMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImportJobUpdateOne) SetUpdateTime(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *ImportJobUpdateOne) SetFileName(v string) *ImportJobUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFileName(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetFilePath sets the "file_path" field.
+func (_u *ImportJobUpdateOne) SetFilePath(v string) *ImportJobUpdateOne {
+	_u.mutation.SetFilePath(v)
+	return _u
+}
+
+// SetNillableFilePath sets the "file_path" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFilePath(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFilePath(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ImportJobUpdateOne) SetFormat(v string) *ImportJobUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFormat(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *ImportJobUpdateOne) ClearFormat() *ImportJobUpdateOne {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetForce sets the "force" field.
+func (_u *ImportJobUpdateOne) SetForce(v bool) *ImportJobUpdateOne {
+	_u.mutation.SetForce(v)
+	return _u
+}
+
+// SetNillableForce sets the "force" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableForce(v *bool) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetForce(*v)
+	}
+	return _u
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (_u *ImportJobUpdateOne) SetMaxErrors(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetMaxErrors()
+	_u.mutation.SetMaxErrors(v)
+	return _u
+}
+
+// SetNillableMaxErrors sets the "max_errors" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableMaxErrors(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetMaxErrors(*v)
+	}
+	return _u
+}
+
+// AddMaxErrors adds value to the "max_errors" field.
+func (_u *ImportJobUpdateOne) AddMaxErrors(v int) *ImportJobUpdateOne {
+	_u.mutation.AddMaxErrors(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportJobUpdateOne) SetStatus(v importjob.Status) *ImportJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableStatus(v *importjob.Status) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (_u *ImportJobUpdateOne) SetRowsTotal(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetRowsTotal()
+	_u.mutation.SetRowsTotal(v)
+	return _u
+}
+
+// SetNillableRowsTotal sets the "rows_total" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableRowsTotal(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetRowsTotal(*v)
+	}
+	return _u
+}
+
+// AddRowsTotal adds value to the "rows_total" field.
+func (_u *ImportJobUpdateOne) AddRowsTotal(v int) *ImportJobUpdateOne {
+	_u.mutation.AddRowsTotal(v)
+	return _u
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (_u *ImportJobUpdateOne) SetRowsProcessed(v int) *ImportJobUpdateOne {
+	_u.mutation.ResetRowsProcessed()
+	_u.mutation.SetRowsProcessed(v)
+	return _u
+}
+
+// SetNillableRowsProcessed sets the "rows_processed" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableRowsProcessed(v *int) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetRowsProcessed(*v)
+	}
+	return _u
+}
+
+// AddRowsProcessed adds value to the "rows_processed" field.
+func (_u *ImportJobUpdateOne) AddRowsProcessed(v int) *ImportJobUpdateOne {
+	_u.mutation.AddRowsProcessed(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ImportJobUpdateOne) SetError(v string) *ImportJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableError(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ImportJobUpdateOne) ClearError() *ImportJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *ImportJobUpdateOne) SetStartedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableStartedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *ImportJobUpdateOne) ClearStartedAt() *ImportJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportJobUpdateOne) SetFinishedAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableFinishedAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportJobUpdateOne) ClearFinishedAt() *ImportJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *ImportJobUpdateOne) SetOwner(v string) *ImportJobUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableOwner(v *string) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *ImportJobUpdateOne) ClearOwner() *ImportJobUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_u *ImportJobUpdateOne) SetLeaseExpiresAt(v time.Time) *ImportJobUpdateOne {
+	_u.mutation.SetLeaseExpiresAt(v)
+	return _u
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableLeaseExpiresAt(v *time.Time) *ImportJobUpdateOne {
+	if v != nil {
+		_u.SetLeaseExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (_u *ImportJobUpdateOne) ClearLeaseExpiresAt() *ImportJobUpdateOne {
+	_u.mutation.ClearLeaseExpiresAt()
+	return _u
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by ID.
+func (_u *ImportJobUpdateOne) SetImportRunID(id int) *ImportJobUpdateOne {
+	_u.mutation.SetImportRunID(id)
+	return _u
+}
+
+// SetNillableImportRunID sets the "import_run" edge to the ImportRun entity by ID if the given value is not nil.
+func (_u *ImportJobUpdateOne) SetNillableImportRunID(id *int) *ImportJobUpdateOne {
+	if id != nil {
+		_u = _u.SetImportRunID(*id)
+	}
+	return _u
+}
+
+// SetImportRun sets the "import_run" edge to the ImportRun entity.
+func (_u *ImportJobUpdateOne) SetImportRun(v *ImportRun) *ImportJobUpdateOne {
+	return _u.SetImportRunID(v.ID)
+}
+
+// Mutation returns the ImportJobMutation object of the builder.
+func (_u *ImportJobUpdateOne) Mutation() *ImportJobMutation {
+	return _u.mutation
+}
+
+// ClearImportRun clears the "import_run" edge to the ImportRun entity.
+func (_u *ImportJobUpdateOne) ClearImportRun() *ImportJobUpdateOne {
+	_u.mutation.ClearImportRun()
+	return _u
+}
+
+// Where appends a list predicates to the ImportJobUpdate builder.
+func (_u *ImportJobUpdateOne) Where(ps ...predicate.ImportJob) *ImportJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportJobUpdateOne) Select(field string, fields ...string) *ImportJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportJob entity.
+func (_u *ImportJobUpdateOne) Save(ctx context.Context) (*ImportJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) SaveX(ctx context.Context) *ImportJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImportJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := importjob.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportJobUpdateOne) check() error {
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := importjob.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ImportJob.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FilePath(); ok {
+		if err := importjob.FilePathValidator(v); err != nil {
+			return &ValidationError{Name: "file_path", err: fmt.Errorf(`ent: validator failed for field "ImportJob.file_path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImportJobUpdateOne) sqlSave(ctx context.Context) (_node *ImportJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importjob.Table, importjob.Columns, sqlgraph.NewFieldSpec(importjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importjob.FieldID)
+		for _, f := range fields {
+			if !importjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(importjob.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(importjob.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FilePath(); ok {
+		_spec.SetField(importjob.FieldFilePath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(importjob.FieldFormat, field.TypeString, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(importjob.FieldFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.Force(); ok {
+		_spec.SetField(importjob.FieldForce, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxErrors(); ok {
+		_spec.SetField(importjob.FieldMaxErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxErrors(); ok {
+		_spec.AddField(importjob.FieldMaxErrors, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RowsTotal(); ok {
+		_spec.SetField(importjob.FieldRowsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRowsTotal(); ok {
+		_spec.AddField(importjob.FieldRowsTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RowsProcessed(); ok {
+		_spec.SetField(importjob.FieldRowsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRowsProcessed(); ok {
+		_spec.AddField(importjob.FieldRowsProcessed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(importjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(importjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(importjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(importjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importjob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(importjob.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(importjob.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(importjob.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(importjob.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if _u.mutation.ImportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.ImportRunTable,
+			Columns: []string{importjob.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   importjob.ImportRunTable,
+			Columns: []string{importjob.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ImportJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/schema"
+	"offgrocery-assessment/internal/ent/store"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ImportRun is the model entity for the ImportRun schema.
+type ImportRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FileName holds the value of the "file_name" field.
+	FileName string `json:"file_name,omitempty"`
+	// Hex encoded SHA-256 of the imported file.
+	Sha256 string `json:"sha256,omitempty"`
+	// Format holds the value of the "format" field.
+	Format string `json:"format,omitempty"`
+	// interrupted means the import was cancelled, by a shutdown or a lost job lease, and rolled back.
+	Status importrun.Status `json:"status,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// CreatedCount holds the value of the "created_count" field.
+	CreatedCount int `json:"created_count,omitempty"`
+	// UpdatedCount holds the value of the "updated_count" field.
+	UpdatedCount int `json:"updated_count,omitempty"`
+	// DelistedCount holds the value of the "delisted_count" field.
+	DelistedCount int `json:"delisted_count,omitempty"`
+	// FailedCount holds the value of the "failed_count" field.
+	FailedCount int `json:"failed_count,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// The first rows skipped because they failed validation; failed_count counts them all.
+	Rejections []schema.Rejection `json:"rejections,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImportRunQuery when eager-loading is set.
+	Edges             ImportRunEdges `json:"edges"`
+	store_import_runs *int
+	selectValues      sql.SelectValues
+}
+
+// ImportRunEdges holds the relations/edges for other nodes in the graph.
+type ImportRunEdges struct {
+	// Store holds the value of the store edge.
+	Store *Store `json:"store,omitempty"`
+	// PriceObservations holds the value of the price_observations edge.
+	PriceObservations []*PriceObservation `json:"price_observations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// StoreOrErr returns the Store value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImportRunEdges) StoreOrErr() (*Store, error) {
+	if e.Store != nil {
+		return e.Store, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: store.Label}
+	}
+	return nil, &NotLoadedError{edge: "store"}
+}
+
+// PriceObservationsOrErr returns the PriceObservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ImportRunEdges) PriceObservationsOrErr() ([]*PriceObservation, error) {
+	if e.loadedTypes[1] {
+		return e.PriceObservations, nil
+	}
+	return nil, &NotLoadedError{edge: "price_observations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImportRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case importrun.FieldRejections:
+			values[i] = new([]byte)
+		case importrun.FieldID, importrun.FieldCreatedCount, importrun.FieldUpdatedCount, importrun.FieldDelistedCount, importrun.FieldFailedCount:
+			values[i] = new(sql.NullInt64)
+		case importrun.FieldFileName, importrun.FieldSha256, importrun.FieldFormat, importrun.FieldStatus, importrun.FieldError:
+			values[i] = new(sql.NullString)
+		case importrun.FieldStartedAt, importrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case importrun.ForeignKeys[0]: // store_import_runs
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImportRun fields.
+func (_m *ImportRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case importrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case importrun.FieldFileName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_name", values[i])
+			} else if value.Valid {
+				_m.FileName = value.String
+			}
+		case importrun.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				_m.Sha256 = value.String
+			}
+		case importrun.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case importrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = importrun.Status(value.String)
+			}
+		case importrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case importrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case importrun.FieldCreatedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_count", values[i])
+			} else if value.Valid {
+				_m.CreatedCount = int(value.Int64)
+			}
+		case importrun.FieldUpdatedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_count", values[i])
+			} else if value.Valid {
+				_m.UpdatedCount = int(value.Int64)
+			}
+		case importrun.FieldDelistedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delisted_count", values[i])
+			} else if value.Valid {
+				_m.DelistedCount = int(value.Int64)
+			}
+		case importrun.FieldFailedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_count", values[i])
+			} else if value.Valid {
+				_m.FailedCount = int(value.Int64)
+			}
+		case importrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case importrun.FieldRejections:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rejections", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Rejections); err != nil {
+					return fmt.Errorf("unmarshal field rejections: %w", err)
+				}
+			}
+		case importrun.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field store_import_runs", value)
+			} else if value.Valid {
+				_m.store_import_runs = new(int)
+				*_m.store_import_runs = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImportRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImportRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStore queries the "store" edge of the ImportRun entity.
+func (_m *ImportRun) QueryStore() *StoreQuery {
+	return NewImportRunClient(_m.config).QueryStore(_m)
+}
+
+// QueryPriceObservations queries the "price_observations" edge of the ImportRun entity.
+func (_m *ImportRun) QueryPriceObservations() *PriceObservationQuery {
+	return NewImportRunClient(_m.config).QueryPriceObservations(_m)
+}
+
+// Update returns a builder for updating this ImportRun.
+// Note that you need to call ImportRun.Unwrap() before calling this method if this ImportRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImportRun) Update() *ImportRunUpdateOne {
+	return NewImportRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImportRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImportRun) Unwrap() *ImportRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImportRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImportRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImportRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("file_name=")
+	builder.WriteString(_m.FileName)
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(_m.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedCount))
+	builder.WriteString(", ")
+	builder.WriteString("updated_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdatedCount))
+	builder.WriteString(", ")
+	builder.WriteString("delisted_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DelistedCount))
+	builder.WriteString(", ")
+	builder.WriteString("failed_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedCount))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("rejections=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rejections))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImportRuns is a parsable slice of ImportRun.
+type ImportRuns []*ImportRun
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package importrun
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the importrun type in the database.
+	Label = "import_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFileName holds the string denoting the file_name field in the database.
+	FieldFileName = "file_name"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldCreatedCount holds the string denoting the created_count field in the database.
+	FieldCreatedCount = "created_count"
+	// FieldUpdatedCount holds the string denoting the updated_count field in the database.
+	FieldUpdatedCount = "updated_count"
+	// FieldDelistedCount holds the string denoting the delisted_count field in the database.
+	FieldDelistedCount = "delisted_count"
+	// FieldFailedCount holds the string denoting the failed_count field in the database.
+	FieldFailedCount = "failed_count"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldRejections holds the string denoting the rejections field in the database.
+	FieldRejections = "rejections"
+	// EdgeStore holds the string denoting the store edge name in mutations.
+	EdgeStore = "store"
+	// EdgePriceObservations holds the string denoting the price_observations edge name in mutations.
+	EdgePriceObservations = "price_observations"
+	// Table holds the table name of the importrun in the database.
+	Table = "import_runs"
+	// StoreTable is the table that holds the store relation/edge.
+	StoreTable = "import_runs"
+	// StoreInverseTable is the table name for the Store entity.
+	// It exists in this package in order to avoid circular dependency with the "store" package.
+	StoreInverseTable = "stores"
+	// StoreColumn is the table column denoting the store relation/edge.
+	StoreColumn = "store_import_runs"
+	// PriceObservationsTable is the table that holds the price_observations relation/edge.
+	PriceObservationsTable = "price_observations"
+	// PriceObservationsInverseTable is the table name for the PriceObservation entity.
+	// It exists in this package in order to avoid circular dependency with the "priceobservation" package.
+	PriceObservationsInverseTable = "price_observations"
+	// PriceObservationsColumn is the table column denoting the price_observations relation/edge.
+	PriceObservationsColumn = "import_run_price_observations"
+)
+
+// Columns holds all SQL columns for importrun fields.
+var Columns = []string{
+	FieldID,
+	FieldFileName,
+	FieldSha256,
+	FieldFormat,
+	FieldStatus,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldCreatedCount,
+	FieldUpdatedCount,
+	FieldDelistedCount,
+	FieldFailedCount,
+	FieldError,
+	FieldRejections,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "import_runs"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"store_import_runs",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	FileNameValidator func(string) error
+	// Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	Sha256Validator func(string) error
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultCreatedCount holds the default value on creation for the "created_count" field.
+	DefaultCreatedCount int
+	// DefaultUpdatedCount holds the default value on creation for the "updated_count" field.
+	DefaultUpdatedCount int
+	// DefaultDelistedCount holds the default value on creation for the "delisted_count" field.
+	DefaultDelistedCount int
+	// DefaultFailedCount holds the default value on creation for the "failed_count" field.
+	DefaultFailedCount int
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRunning is the default value of the Status enum.
+const DefaultStatus = StatusRunning
+
+// Status values.
+const (
+	StatusRunning     Status = "running"
+	StatusSucceeded   Status = "succeeded"
+	StatusFailed      Status = "failed"
+	StatusInterrupted Status = "interrupted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusSucceeded, StatusFailed, StatusInterrupted:
+		return nil
+	default:
+		return fmt.Errorf("importrun: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ImportRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFileName orders the results by the file_name field.
+func ByFileName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileName, opts...).ToFunc()
+}
+
+// BySha256 orders the results by the sha256 field.
+func BySha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSha256, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByCreatedCount orders the results by the created_count field.
+func ByCreatedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedCount, opts...).ToFunc()
+}
+
+// ByUpdatedCount orders the results by the updated_count field.
+func ByUpdatedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedCount, opts...).ToFunc()
+}
+
+// ByDelistedCount orders the results by the delisted_count field.
+func ByDelistedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelistedCount, opts...).ToFunc()
+}
+
+// ByFailedCount orders the results by the failed_count field.
+func ByFailedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedCount, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByStoreField orders the results by store field.
+func ByStoreField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStoreStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPriceObservationsCount orders the results by price_observations count.
+func ByPriceObservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPriceObservationsStep(), opts...)
+	}
+}
+
+// ByPriceObservations orders the results by price_observations terms.
+func ByPriceObservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPriceObservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newStoreStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StoreInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StoreTable, StoreColumn),
+	)
+}
+func newPriceObservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PriceObservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PriceObservationsTable, PriceObservationsColumn),
+	)
+}
@@ -0,0 +1,1345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/schema"
+	"offgrocery-assessment/internal/ent/store"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportRunCreate is the builder for creating a ImportRun entity.
+type ImportRunCreate struct {
+	config
+	mutation *ImportRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *ImportRunCreate) SetFileName(v string) *ImportRunCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetSha256 sets the "sha256" field.
+func (_c *ImportRunCreate) SetSha256(v string) *ImportRunCreate {
+	_c.mutation.SetSha256(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *ImportRunCreate) SetFormat(v string) *ImportRunCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableFormat(v *string) *ImportRunCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ImportRunCreate) SetStatus(v importrun.Status) *ImportRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableStatus(v *importrun.Status) *ImportRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ImportRunCreate) SetStartedAt(v time.Time) *ImportRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableStartedAt(v *time.Time) *ImportRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ImportRunCreate) SetFinishedAt(v time.Time) *ImportRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableFinishedAt(v *time.Time) *ImportRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_c *ImportRunCreate) SetCreatedCount(v int) *ImportRunCreate {
+	_c.mutation.SetCreatedCount(v)
+	return _c
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableCreatedCount(v *int) *ImportRunCreate {
+	if v != nil {
+		_c.SetCreatedCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (_c *ImportRunCreate) SetUpdatedCount(v int) *ImportRunCreate {
+	_c.mutation.SetUpdatedCount(v)
+	return _c
+}
+
+// SetNillableUpdatedCount sets the "updated_count" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableUpdatedCount(v *int) *ImportRunCreate {
+	if v != nil {
+		_c.SetUpdatedCount(*v)
+	}
+	return _c
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (_c *ImportRunCreate) SetDelistedCount(v int) *ImportRunCreate {
+	_c.mutation.SetDelistedCount(v)
+	return _c
+}
+
+// SetNillableDelistedCount sets the "delisted_count" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableDelistedCount(v *int) *ImportRunCreate {
+	if v != nil {
+		_c.SetDelistedCount(*v)
+	}
+	return _c
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_c *ImportRunCreate) SetFailedCount(v int) *ImportRunCreate {
+	_c.mutation.SetFailedCount(v)
+	return _c
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableFailedCount(v *int) *ImportRunCreate {
+	if v != nil {
+		_c.SetFailedCount(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ImportRunCreate) SetError(v string) *ImportRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableError(v *string) *ImportRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetRejections sets the "rejections" field.
+func (_c *ImportRunCreate) SetRejections(v []schema.Rejection) *ImportRunCreate {
+	_c.mutation.SetRejections(v)
+	return _c
+}
+
+// SetStoreID sets the "store" edge to the Store entity by ID.
+func (_c *ImportRunCreate) SetStoreID(id int) *ImportRunCreate {
+	_c.mutation.SetStoreID(id)
+	return _c
+}
+
+// SetNillableStoreID sets the "store" edge to the Store entity by ID if the given value is not nil.
+func (_c *ImportRunCreate) SetNillableStoreID(id *int) *ImportRunCreate {
+	if id != nil {
+		_c = _c.SetStoreID(*id)
+	}
+	return _c
+}
+
+// SetStore sets the "store" edge to the Store entity.
+func (_c *ImportRunCreate) SetStore(v *Store) *ImportRunCreate {
+	return _c.SetStoreID(v.ID)
+}
+
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by IDs.
+func (_c *ImportRunCreate) AddPriceObservationIDs(ids ...int) *ImportRunCreate {
+	_c.mutation.AddPriceObservationIDs(ids...)
+	return _c
+}
+
+// AddPriceObservations adds the "price_observations" edges to the PriceObservation entity.
+func (_c *ImportRunCreate) AddPriceObservations(v ...*PriceObservation) *ImportRunCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPriceObservationIDs(ids...)
+}
+
+// Mutation returns the ImportRunMutation object of the builder.
+func (_c *ImportRunCreate) Mutation() *ImportRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ImportRun in the database.
+func (_c *ImportRunCreate) Save(ctx context.Context) (*ImportRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImportRunCreate) SaveX(ctx context.Context) *ImportRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImportRunCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := importrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := importrun.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		v := importrun.DefaultCreatedCount
+		_c.mutation.SetCreatedCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedCount(); !ok {
+		v := importrun.DefaultUpdatedCount
+		_c.mutation.SetUpdatedCount(v)
+	}
+	if _, ok := _c.mutation.DelistedCount(); !ok {
+		v := importrun.DefaultDelistedCount
+		_c.mutation.SetDelistedCount(v)
+	}
+	if _, ok := _c.mutation.FailedCount(); !ok {
+		v := importrun.DefaultFailedCount
+		_c.mutation.SetFailedCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImportRunCreate) check() error {
+	if _, ok := _c.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "ImportRun.file_name"`)}
+	}
+	if v, ok := _c.mutation.FileName(); ok {
+		if err := importrun.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ImportRun.file_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "ImportRun.sha256"`)}
+	}
+	if v, ok := _c.mutation.Sha256(); ok {
+		if err := importrun.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "ImportRun.sha256": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ImportRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := importrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "ImportRun.started_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedCount(); !ok {
+		return &ValidationError{Name: "created_count", err: errors.New(`ent: missing required field "ImportRun.created_count"`)}
+	}
+	if _, ok := _c.mutation.UpdatedCount(); !ok {
+		return &ValidationError{Name: "updated_count", err: errors.New(`ent: missing required field "ImportRun.updated_count"`)}
+	}
+	if _, ok := _c.mutation.DelistedCount(); !ok {
+		return &ValidationError{Name: "delisted_count", err: errors.New(`ent: missing required field "ImportRun.delisted_count"`)}
+	}
+	if _, ok := _c.mutation.FailedCount(); !ok {
+		return &ValidationError{Name: "failed_count", err: errors.New(`ent: missing required field "ImportRun.failed_count"`)}
+	}
+	return nil
+}
+
+func (_c *ImportRunCreate) sqlSave(ctx context.Context) (*ImportRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImportRunCreate) createSpec() (*ImportRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImportRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(importrun.Table, sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(importrun.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.Sha256(); ok {
+		_spec.SetField(importrun.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(importrun.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(importrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(importrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(importrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedCount(); ok {
+		_spec.SetField(importrun.FieldCreatedCount, field.TypeInt, value)
+		_node.CreatedCount = value
+	}
+	if value, ok := _c.mutation.UpdatedCount(); ok {
+		_spec.SetField(importrun.FieldUpdatedCount, field.TypeInt, value)
+		_node.UpdatedCount = value
+	}
+	if value, ok := _c.mutation.DelistedCount(); ok {
+		_spec.SetField(importrun.FieldDelistedCount, field.TypeInt, value)
+		_node.DelistedCount = value
+	}
+	if value, ok := _c.mutation.FailedCount(); ok {
+		_spec.SetField(importrun.FieldFailedCount, field.TypeInt, value)
+		_node.FailedCount = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(importrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.Rejections(); ok {
+		_spec.SetField(importrun.FieldRejections, field.TypeJSON, value)
+		_node.Rejections = value
+	}
+	if nodes := _c.mutation.StoreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrun.StoreTable,
+			Columns: []string{importrun.StoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(store.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.store_import_runs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PriceObservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportRun.Create().
+//		SetFileName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportRunUpsert) {
+//			SetFileName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportRunCreate) OnConflict(opts ...sql.ConflictOption) *ImportRunUpsertOne {
+	_c.conflict = opts
+	return &ImportRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportRunCreate) OnConflictColumns(columns ...string) *ImportRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ImportRunUpsertOne is the builder for "upsert"-ing
+	//  one ImportRun node.
+	ImportRunUpsertOne struct {
+		create *ImportRunCreate
+	}
+
+	// ImportRunUpsert is the "OnConflict" setter.
+	ImportRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFileName sets the "file_name" field.
+func (u *ImportRunUpsert) SetFileName(v string) *ImportRunUpsert {
+	u.Set(importrun.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateFileName() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldFileName)
+	return u
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *ImportRunUpsert) SetSha256(v string) *ImportRunUpsert {
+	u.Set(importrun.FieldSha256, v)
+	return u
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateSha256() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldSha256)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *ImportRunUpsert) SetFormat(v string) *ImportRunUpsert {
+	u.Set(importrun.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateFormat() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldFormat)
+	return u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *ImportRunUpsert) ClearFormat() *ImportRunUpsert {
+	u.SetNull(importrun.FieldFormat)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRunUpsert) SetStatus(v importrun.Status) *ImportRunUpsert {
+	u.Set(importrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateStatus() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldStatus)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportRunUpsert) SetFinishedAt(v time.Time) *ImportRunUpsert {
+	u.Set(importrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateFinishedAt() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportRunUpsert) ClearFinishedAt() *ImportRunUpsert {
+	u.SetNull(importrun.FieldFinishedAt)
+	return u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (u *ImportRunUpsert) SetCreatedCount(v int) *ImportRunUpsert {
+	u.Set(importrun.FieldCreatedCount, v)
+	return u
+}
+
+// UpdateCreatedCount sets the "created_count" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateCreatedCount() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldCreatedCount)
+	return u
+}
+
+// AddCreatedCount adds v to the "created_count" field.
+func (u *ImportRunUpsert) AddCreatedCount(v int) *ImportRunUpsert {
+	u.Add(importrun.FieldCreatedCount, v)
+	return u
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (u *ImportRunUpsert) SetUpdatedCount(v int) *ImportRunUpsert {
+	u.Set(importrun.FieldUpdatedCount, v)
+	return u
+}
+
+// UpdateUpdatedCount sets the "updated_count" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateUpdatedCount() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldUpdatedCount)
+	return u
+}
+
+// AddUpdatedCount adds v to the "updated_count" field.
+func (u *ImportRunUpsert) AddUpdatedCount(v int) *ImportRunUpsert {
+	u.Add(importrun.FieldUpdatedCount, v)
+	return u
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (u *ImportRunUpsert) SetDelistedCount(v int) *ImportRunUpsert {
+	u.Set(importrun.FieldDelistedCount, v)
+	return u
+}
+
+// UpdateDelistedCount sets the "delisted_count" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateDelistedCount() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldDelistedCount)
+	return u
+}
+
+// AddDelistedCount adds v to the "delisted_count" field.
+func (u *ImportRunUpsert) AddDelistedCount(v int) *ImportRunUpsert {
+	u.Add(importrun.FieldDelistedCount, v)
+	return u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *ImportRunUpsert) SetFailedCount(v int) *ImportRunUpsert {
+	u.Set(importrun.FieldFailedCount, v)
+	return u
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateFailedCount() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldFailedCount)
+	return u
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *ImportRunUpsert) AddFailedCount(v int) *ImportRunUpsert {
+	u.Add(importrun.FieldFailedCount, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ImportRunUpsert) SetError(v string) *ImportRunUpsert {
+	u.Set(importrun.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateError() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportRunUpsert) ClearError() *ImportRunUpsert {
+	u.SetNull(importrun.FieldError)
+	return u
+}
+
+// SetRejections sets the "rejections" field.
+func (u *ImportRunUpsert) SetRejections(v []schema.Rejection) *ImportRunUpsert {
+	u.Set(importrun.FieldRejections, v)
+	return u
+}
+
+// UpdateRejections sets the "rejections" field to the value that was provided on create.
+func (u *ImportRunUpsert) UpdateRejections() *ImportRunUpsert {
+	u.SetExcluded(importrun.FieldRejections)
+	return u
+}
+
+// ClearRejections clears the value of the "rejections" field.
+func (u *ImportRunUpsert) ClearRejections() *ImportRunUpsert {
+	u.SetNull(importrun.FieldRejections)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ImportRunUpsertOne) UpdateNewValues() *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.StartedAt(); exists {
+			s.SetIgnore(importrun.FieldStartedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ImportRunUpsertOne) Ignore() *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportRunUpsertOne) DoNothing() *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportRunCreate.OnConflict
+// documentation for more info.
+func (u *ImportRunUpsertOne) Update(set func(*ImportRunUpsert)) *ImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ImportRunUpsertOne) SetFileName(v string) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateFileName() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *ImportRunUpsertOne) SetSha256(v string) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateSha256() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *ImportRunUpsertOne) SetFormat(v string) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateFormat() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *ImportRunUpsertOne) ClearFormat() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearFormat()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRunUpsertOne) SetStatus(v importrun.Status) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateStatus() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportRunUpsertOne) SetFinishedAt(v time.Time) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateFinishedAt() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportRunUpsertOne) ClearFinishedAt() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (u *ImportRunUpsertOne) SetCreatedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetCreatedCount(v)
+	})
+}
+
+// AddCreatedCount adds v to the "created_count" field.
+func (u *ImportRunUpsertOne) AddCreatedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddCreatedCount(v)
+	})
+}
+
+// UpdateCreatedCount sets the "created_count" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateCreatedCount() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateCreatedCount()
+	})
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (u *ImportRunUpsertOne) SetUpdatedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetUpdatedCount(v)
+	})
+}
+
+// AddUpdatedCount adds v to the "updated_count" field.
+func (u *ImportRunUpsertOne) AddUpdatedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddUpdatedCount(v)
+	})
+}
+
+// UpdateUpdatedCount sets the "updated_count" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateUpdatedCount() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateUpdatedCount()
+	})
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (u *ImportRunUpsertOne) SetDelistedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetDelistedCount(v)
+	})
+}
+
+// AddDelistedCount adds v to the "delisted_count" field.
+func (u *ImportRunUpsertOne) AddDelistedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddDelistedCount(v)
+	})
+}
+
+// UpdateDelistedCount sets the "delisted_count" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateDelistedCount() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateDelistedCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *ImportRunUpsertOne) SetFailedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *ImportRunUpsertOne) AddFailedCount(v int) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateFailedCount() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ImportRunUpsertOne) SetError(v string) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateError() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportRunUpsertOne) ClearError() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetRejections sets the "rejections" field.
+func (u *ImportRunUpsertOne) SetRejections(v []schema.Rejection) *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetRejections(v)
+	})
+}
+
+// UpdateRejections sets the "rejections" field to the value that was provided on create.
+func (u *ImportRunUpsertOne) UpdateRejections() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateRejections()
+	})
+}
+
+// ClearRejections clears the value of the "rejections" field.
+func (u *ImportRunUpsertOne) ClearRejections() *ImportRunUpsertOne {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearRejections()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ImportRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ImportRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ImportRunCreateBulk is the builder for creating many ImportRun entities in bulk.
+type ImportRunCreateBulk struct {
+	config
+	err      error
+	builders []*ImportRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ImportRun entities in the database.
+func (_c *ImportRunCreateBulk) Save(ctx context.Context) ([]*ImportRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImportRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImportRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImportRunCreateBulk) SaveX(ctx context.Context) []*ImportRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImportRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImportRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ImportRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ImportRunUpsert) {
+//			SetFileName(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ImportRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *ImportRunUpsertBulk {
+	_c.conflict = opts
+	return &ImportRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ImportRunCreateBulk) OnConflictColumns(columns ...string) *ImportRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ImportRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// ImportRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of ImportRun nodes.
+type ImportRunUpsertBulk struct {
+	create *ImportRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ImportRunUpsertBulk) UpdateNewValues() *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.StartedAt(); exists {
+				s.SetIgnore(importrun.FieldStartedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ImportRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ImportRunUpsertBulk) Ignore() *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ImportRunUpsertBulk) DoNothing() *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ImportRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *ImportRunUpsertBulk) Update(set func(*ImportRunUpsert)) *ImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ImportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *ImportRunUpsertBulk) SetFileName(v string) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateFileName() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetSha256 sets the "sha256" field.
+func (u *ImportRunUpsertBulk) SetSha256(v string) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetSha256(v)
+	})
+}
+
+// UpdateSha256 sets the "sha256" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateSha256() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateSha256()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *ImportRunUpsertBulk) SetFormat(v string) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateFormat() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// ClearFormat clears the value of the "format" field.
+func (u *ImportRunUpsertBulk) ClearFormat() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearFormat()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ImportRunUpsertBulk) SetStatus(v importrun.Status) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateStatus() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *ImportRunUpsertBulk) SetFinishedAt(v time.Time) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateFinishedAt() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *ImportRunUpsertBulk) ClearFinishedAt() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (u *ImportRunUpsertBulk) SetCreatedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetCreatedCount(v)
+	})
+}
+
+// AddCreatedCount adds v to the "created_count" field.
+func (u *ImportRunUpsertBulk) AddCreatedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddCreatedCount(v)
+	})
+}
+
+// UpdateCreatedCount sets the "created_count" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateCreatedCount() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateCreatedCount()
+	})
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (u *ImportRunUpsertBulk) SetUpdatedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetUpdatedCount(v)
+	})
+}
+
+// AddUpdatedCount adds v to the "updated_count" field.
+func (u *ImportRunUpsertBulk) AddUpdatedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddUpdatedCount(v)
+	})
+}
+
+// UpdateUpdatedCount sets the "updated_count" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateUpdatedCount() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateUpdatedCount()
+	})
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (u *ImportRunUpsertBulk) SetDelistedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetDelistedCount(v)
+	})
+}
+
+// AddDelistedCount adds v to the "delisted_count" field.
+func (u *ImportRunUpsertBulk) AddDelistedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddDelistedCount(v)
+	})
+}
+
+// UpdateDelistedCount sets the "delisted_count" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateDelistedCount() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateDelistedCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *ImportRunUpsertBulk) SetFailedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *ImportRunUpsertBulk) AddFailedCount(v int) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateFailedCount() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ImportRunUpsertBulk) SetError(v string) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateError() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ImportRunUpsertBulk) ClearError() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetRejections sets the "rejections" field.
+func (u *ImportRunUpsertBulk) SetRejections(v []schema.Rejection) *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.SetRejections(v)
+	})
+}
+
+// UpdateRejections sets the "rejections" field to the value that was provided on create.
+func (u *ImportRunUpsertBulk) UpdateRejections() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.UpdateRejections()
+	})
+}
+
+// ClearRejections clears the value of the "rejections" field.
+func (u *ImportRunUpsertBulk) ClearRejections() *ImportRunUpsertBulk {
+	return u.Update(func(s *ImportRunUpsert) {
+		s.ClearRejections()
+	})
+}
+
+// Exec executes the query.
+func (u *ImportRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ImportRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ImportRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ImportRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
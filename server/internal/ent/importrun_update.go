@@ -0,0 +1,1028 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/predicate"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/schema"
+	"offgrocery-assessment/internal/ent/store"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ImportRunUpdate is the builder for updating ImportRun entities.
+type ImportRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImportRunMutation
+}
+
+// Where appends a list predicates to the ImportRunUpdate builder.
+func (_u *ImportRunUpdate) Where(ps ...predicate.ImportRun) *ImportRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *ImportRunUpdate) SetFileName(v string) *ImportRunUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableFileName(v *string) *ImportRunUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *ImportRunUpdate) SetSha256(v string) *ImportRunUpdate {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableSha256(v *string) *ImportRunUpdate {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ImportRunUpdate) SetFormat(v string) *ImportRunUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableFormat(v *string) *ImportRunUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *ImportRunUpdate) ClearFormat() *ImportRunUpdate {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportRunUpdate) SetStatus(v importrun.Status) *ImportRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableStatus(v *importrun.Status) *ImportRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportRunUpdate) SetFinishedAt(v time.Time) *ImportRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableFinishedAt(v *time.Time) *ImportRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportRunUpdate) ClearFinishedAt() *ImportRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *ImportRunUpdate) SetCreatedCount(v int) *ImportRunUpdate {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableCreatedCount(v *int) *ImportRunUpdate {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *ImportRunUpdate) AddCreatedCount(v int) *ImportRunUpdate {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (_u *ImportRunUpdate) SetUpdatedCount(v int) *ImportRunUpdate {
+	_u.mutation.ResetUpdatedCount()
+	_u.mutation.SetUpdatedCount(v)
+	return _u
+}
+
+// SetNillableUpdatedCount sets the "updated_count" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableUpdatedCount(v *int) *ImportRunUpdate {
+	if v != nil {
+		_u.SetUpdatedCount(*v)
+	}
+	return _u
+}
+
+// AddUpdatedCount adds value to the "updated_count" field.
+func (_u *ImportRunUpdate) AddUpdatedCount(v int) *ImportRunUpdate {
+	_u.mutation.AddUpdatedCount(v)
+	return _u
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (_u *ImportRunUpdate) SetDelistedCount(v int) *ImportRunUpdate {
+	_u.mutation.ResetDelistedCount()
+	_u.mutation.SetDelistedCount(v)
+	return _u
+}
+
+// SetNillableDelistedCount sets the "delisted_count" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableDelistedCount(v *int) *ImportRunUpdate {
+	if v != nil {
+		_u.SetDelistedCount(*v)
+	}
+	return _u
+}
+
+// AddDelistedCount adds value to the "delisted_count" field.
+func (_u *ImportRunUpdate) AddDelistedCount(v int) *ImportRunUpdate {
+	_u.mutation.AddDelistedCount(v)
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *ImportRunUpdate) SetFailedCount(v int) *ImportRunUpdate {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableFailedCount(v *int) *ImportRunUpdate {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *ImportRunUpdate) AddFailedCount(v int) *ImportRunUpdate {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ImportRunUpdate) SetError(v string) *ImportRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableError(v *string) *ImportRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ImportRunUpdate) ClearError() *ImportRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRejections sets the "rejections" field.
+func (_u *ImportRunUpdate) SetRejections(v []schema.Rejection) *ImportRunUpdate {
+	_u.mutation.SetRejections(v)
+	return _u
+}
+
+// AppendRejections appends value to the "rejections" field.
+func (_u *ImportRunUpdate) AppendRejections(v []schema.Rejection) *ImportRunUpdate {
+	_u.mutation.AppendRejections(v)
+	return _u
+}
+
+// ClearRejections clears the value of the "rejections" field.
+func (_u *ImportRunUpdate) ClearRejections() *ImportRunUpdate {
+	_u.mutation.ClearRejections()
+	return _u
+}
+
+// SetStoreID sets the "store" edge to the Store entity by ID.
+func (_u *ImportRunUpdate) SetStoreID(id int) *ImportRunUpdate {
+	_u.mutation.SetStoreID(id)
+	return _u
+}
+
+// SetNillableStoreID sets the "store" edge to the Store entity by ID if the given value is not nil.
+func (_u *ImportRunUpdate) SetNillableStoreID(id *int) *ImportRunUpdate {
+	if id != nil {
+		_u = _u.SetStoreID(*id)
+	}
+	return _u
+}
+
+// SetStore sets the "store" edge to the Store entity.
+func (_u *ImportRunUpdate) SetStore(v *Store) *ImportRunUpdate {
+	return _u.SetStoreID(v.ID)
+}
+
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by IDs.
+func (_u *ImportRunUpdate) AddPriceObservationIDs(ids ...int) *ImportRunUpdate {
+	_u.mutation.AddPriceObservationIDs(ids...)
+	return _u
+}
+
+// AddPriceObservations adds the "price_observations" edges to the PriceObservation entity.
+func (_u *ImportRunUpdate) AddPriceObservations(v ...*PriceObservation) *ImportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceObservationIDs(ids...)
+}
+
+// Mutation returns the ImportRunMutation object of the builder.
+func (_u *ImportRunUpdate) Mutation() *ImportRunMutation {
+	return _u.mutation
+}
+
+// ClearStore clears the "store" edge to the Store entity.
+func (_u *ImportRunUpdate) ClearStore() *ImportRunUpdate {
+	_u.mutation.ClearStore()
+	return _u
+}
+
+// ClearPriceObservations clears all "price_observations" edges to the PriceObservation entity.
+func (_u *ImportRunUpdate) ClearPriceObservations() *ImportRunUpdate {
+	_u.mutation.ClearPriceObservations()
+	return _u
+}
+
+// RemovePriceObservationIDs removes the "price_observations" edge to PriceObservation entities by IDs.
+func (_u *ImportRunUpdate) RemovePriceObservationIDs(ids ...int) *ImportRunUpdate {
+	_u.mutation.RemovePriceObservationIDs(ids...)
+	return _u
+}
+
+// RemovePriceObservations removes "price_observations" edges to PriceObservation entities.
+func (_u *ImportRunUpdate) RemovePriceObservations(v ...*PriceObservation) *ImportRunUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceObservationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImportRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImportRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportRunUpdate) check() error {
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := importrun.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ImportRun.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := importrun.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "ImportRun.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importrun.Table, importrun.Columns, sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(importrun.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(importrun.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(importrun.FieldFormat, field.TypeString, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(importrun.FieldFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(importrun.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(importrun.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedCount(); ok {
+		_spec.SetField(importrun.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedCount(); ok {
+		_spec.AddField(importrun.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DelistedCount(); ok {
+		_spec.SetField(importrun.FieldDelistedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDelistedCount(); ok {
+		_spec.AddField(importrun.FieldDelistedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(importrun.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(importrun.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(importrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(importrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rejections(); ok {
+		_spec.SetField(importrun.FieldRejections, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRejections(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importrun.FieldRejections, value)
+		})
+	}
+	if _u.mutation.RejectionsCleared() {
+		_spec.ClearField(importrun.FieldRejections, field.TypeJSON)
+	}
+	if _u.mutation.StoreCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrun.StoreTable,
+			Columns: []string{importrun.StoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(store.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StoreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrun.StoreTable,
+			Columns: []string{importrun.StoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(store.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPriceObservationsIDs(); len(nodes) > 0 && !_u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PriceObservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImportRunUpdateOne is the builder for updating a single ImportRun entity.
+type ImportRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImportRunMutation
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *ImportRunUpdateOne) SetFileName(v string) *ImportRunUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableFileName(v *string) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetSha256 sets the "sha256" field.
+func (_u *ImportRunUpdateOne) SetSha256(v string) *ImportRunUpdateOne {
+	_u.mutation.SetSha256(v)
+	return _u
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableSha256(v *string) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetSha256(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ImportRunUpdateOne) SetFormat(v string) *ImportRunUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableFormat(v *string) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *ImportRunUpdateOne) ClearFormat() *ImportRunUpdateOne {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ImportRunUpdateOne) SetStatus(v importrun.Status) *ImportRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableStatus(v *importrun.Status) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ImportRunUpdateOne) SetFinishedAt(v time.Time) *ImportRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableFinishedAt(v *time.Time) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ImportRunUpdateOne) ClearFinishedAt() *ImportRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (_u *ImportRunUpdateOne) SetCreatedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.ResetCreatedCount()
+	_u.mutation.SetCreatedCount(v)
+	return _u
+}
+
+// SetNillableCreatedCount sets the "created_count" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableCreatedCount(v *int) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedCount(*v)
+	}
+	return _u
+}
+
+// AddCreatedCount adds value to the "created_count" field.
+func (_u *ImportRunUpdateOne) AddCreatedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.AddCreatedCount(v)
+	return _u
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (_u *ImportRunUpdateOne) SetUpdatedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.ResetUpdatedCount()
+	_u.mutation.SetUpdatedCount(v)
+	return _u
+}
+
+// SetNillableUpdatedCount sets the "updated_count" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableUpdatedCount(v *int) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetUpdatedCount(*v)
+	}
+	return _u
+}
+
+// AddUpdatedCount adds value to the "updated_count" field.
+func (_u *ImportRunUpdateOne) AddUpdatedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.AddUpdatedCount(v)
+	return _u
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (_u *ImportRunUpdateOne) SetDelistedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.ResetDelistedCount()
+	_u.mutation.SetDelistedCount(v)
+	return _u
+}
+
+// SetNillableDelistedCount sets the "delisted_count" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableDelistedCount(v *int) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetDelistedCount(*v)
+	}
+	return _u
+}
+
+// AddDelistedCount adds value to the "delisted_count" field.
+func (_u *ImportRunUpdateOne) AddDelistedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.AddDelistedCount(v)
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *ImportRunUpdateOne) SetFailedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableFailedCount(v *int) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *ImportRunUpdateOne) AddFailedCount(v int) *ImportRunUpdateOne {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ImportRunUpdateOne) SetError(v string) *ImportRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableError(v *string) *ImportRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ImportRunUpdateOne) ClearError() *ImportRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRejections sets the "rejections" field.
+func (_u *ImportRunUpdateOne) SetRejections(v []schema.Rejection) *ImportRunUpdateOne {
+	_u.mutation.SetRejections(v)
+	return _u
+}
+
+// AppendRejections appends value to the "rejections" field.
+func (_u *ImportRunUpdateOne) AppendRejections(v []schema.Rejection) *ImportRunUpdateOne {
+	_u.mutation.AppendRejections(v)
+	return _u
+}
+
+// ClearRejections clears the value of the "rejections" field.
+func (_u *ImportRunUpdateOne) ClearRejections() *ImportRunUpdateOne {
+	_u.mutation.ClearRejections()
+	return _u
+}
+
+// SetStoreID sets the "store" edge to the Store entity by ID.
+func (_u *ImportRunUpdateOne) SetStoreID(id int) *ImportRunUpdateOne {
+	_u.mutation.SetStoreID(id)
+	return _u
+}
+
+// SetNillableStoreID sets the "store" edge to the Store entity by ID if the given value is not nil.
+func (_u *ImportRunUpdateOne) SetNillableStoreID(id *int) *ImportRunUpdateOne {
+	if id != nil {
+		_u = _u.SetStoreID(*id)
+	}
+	return _u
+}
+
+// SetStore sets the "store" edge to the Store entity.
+func (_u *ImportRunUpdateOne) SetStore(v *Store) *ImportRunUpdateOne {
+	return _u.SetStoreID(v.ID)
+}
+
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by IDs.
+func (_u *ImportRunUpdateOne) AddPriceObservationIDs(ids ...int) *ImportRunUpdateOne {
+	_u.mutation.AddPriceObservationIDs(ids...)
+	return _u
+}
+
+// AddPriceObservations adds the "price_observations" edges to the PriceObservation entity.
+func (_u *ImportRunUpdateOne) AddPriceObservations(v ...*PriceObservation) *ImportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceObservationIDs(ids...)
+}
+
+// Mutation returns the ImportRunMutation object of the builder.
+func (_u *ImportRunUpdateOne) Mutation() *ImportRunMutation {
+	return _u.mutation
+}
+
+// ClearStore clears the "store" edge to the Store entity.
+func (_u *ImportRunUpdateOne) ClearStore() *ImportRunUpdateOne {
+	_u.mutation.ClearStore()
+	return _u
+}
+
+// ClearPriceObservations clears all "price_observations" edges to the PriceObservation entity.
+func (_u *ImportRunUpdateOne) ClearPriceObservations() *ImportRunUpdateOne {
+	_u.mutation.ClearPriceObservations()
+	return _u
+}
+
+// RemovePriceObservationIDs removes the "price_observations" edge to PriceObservation entities by IDs.
+func (_u *ImportRunUpdateOne) RemovePriceObservationIDs(ids ...int) *ImportRunUpdateOne {
+	_u.mutation.RemovePriceObservationIDs(ids...)
+	return _u
+}
+
+// RemovePriceObservations removes "price_observations" edges to PriceObservation entities.
+func (_u *ImportRunUpdateOne) RemovePriceObservations(v ...*PriceObservation) *ImportRunUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceObservationIDs(ids...)
+}
+
+// Where appends a list predicates to the ImportRunUpdate builder.
+func (_u *ImportRunUpdateOne) Where(ps ...predicate.ImportRun) *ImportRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImportRunUpdateOne) Select(field string, fields ...string) *ImportRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImportRun entity.
+func (_u *ImportRunUpdateOne) Save(ctx context.Context) (*ImportRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImportRunUpdateOne) SaveX(ctx context.Context) *ImportRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImportRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImportRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImportRunUpdateOne) check() error {
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := importrun.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "ImportRun.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sha256(); ok {
+		if err := importrun.Sha256Validator(v); err != nil {
+			return &ValidationError{Name: "sha256", err: fmt.Errorf(`ent: validator failed for field "ImportRun.sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := importrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ImportRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ImportRunUpdateOne) sqlSave(ctx context.Context) (_node *ImportRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(importrun.Table, importrun.Columns, sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImportRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, importrun.FieldID)
+		for _, f := range fields {
+			if !importrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != importrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(importrun.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sha256(); ok {
+		_spec.SetField(importrun.FieldSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(importrun.FieldFormat, field.TypeString, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(importrun.FieldFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(importrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(importrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(importrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedCount(); ok {
+		_spec.SetField(importrun.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedCount(); ok {
+		_spec.AddField(importrun.FieldCreatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedCount(); ok {
+		_spec.SetField(importrun.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedCount(); ok {
+		_spec.AddField(importrun.FieldUpdatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DelistedCount(); ok {
+		_spec.SetField(importrun.FieldDelistedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDelistedCount(); ok {
+		_spec.AddField(importrun.FieldDelistedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(importrun.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(importrun.FieldFailedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(importrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(importrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rejections(); ok {
+		_spec.SetField(importrun.FieldRejections, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRejections(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, importrun.FieldRejections, value)
+		})
+	}
+	if _u.mutation.RejectionsCleared() {
+		_spec.ClearField(importrun.FieldRejections, field.TypeJSON)
+	}
+	if _u.mutation.StoreCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrun.StoreTable,
+			Columns: []string{importrun.StoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(store.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StoreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   importrun.StoreTable,
+			Columns: []string{importrun.StoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(store.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPriceObservationsIDs(); len(nodes) > 0 && !_u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PriceObservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   importrun.PriceObservationsTable,
+			Columns: []string{importrun.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ImportRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{importrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
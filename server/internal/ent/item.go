@@ -4,7 +4,9 @@ package ent
 
 import (
 	"fmt"
+	"offgrocery-assessment/internal/ent/category"
 	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/product"
 	"offgrocery-assessment/internal/ent/store"
 	"strings"
 	"time"
@@ -26,8 +28,36 @@ type Item struct {
 	Name string `json:"name,omitempty"`
 	// Brand holds the value of the "brand" field.
 	Brand string `json:"brand,omitempty"`
-	// Price holds the value of the "price" field.
-	Price float64 `json:"price,omitempty"`
+	// The price in minor units of currency, e.g. cents.
+	PriceAmount int64 `json:"price_amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// The grocer's own SKU, barcode or product id for the item.
+	ExternalID *string `json:"external_id,omitempty"`
+	// The grocer's own category or aisle label, as shipped in the feed.
+	Aisle string `json:"aisle,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// Unit holds the value of the "unit" field.
+	Unit string `json:"unit,omitempty"`
+	// The package size, in size_unit.
+	SizeQuantity *float64 `json:"size_quantity,omitempty"`
+	// SizeUnit holds the value of the "size_unit" field.
+	SizeUnit *item.SizeUnit `json:"size_unit,omitempty"`
+	// The price per 100 g, per 100 ml or each, depending on size_unit, in millionths of the currency's major unit.
+	UnitPriceMicros *int64 `json:"unit_price_micros,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID *int `json:"product_id,omitempty"`
+	// True once the item was assigned to its product by hand; the matcher leaves it alone.
+	ProductPinned bool `json:"product_pinned,omitempty"`
+	// True once the item's attributes were edited by hand; imports leave them alone.
+	AttributesPinned bool `json:"attributes_pinned,omitempty"`
+	// False once the item is missing from its grocer's latest feed.
+	Available bool `json:"available,omitempty"`
+	// DelistedAt holds the value of the "delisted_at" field.
+	DelistedAt *time.Time `json:"delisted_at,omitempty"`
+	// When the item last appeared in an imported feed.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ItemQuery when eager-loading is set.
 	Edges        ItemEdges `json:"edges"`
@@ -41,9 +71,17 @@ type ItemEdges struct {
 	Store *Store `json:"store,omitempty"`
 	// Lists holds the value of the lists edge.
 	Lists []*List `json:"lists,omitempty"`
+	// PriceObservations holds the value of the price_observations edge.
+	PriceObservations []*PriceObservation `json:"price_observations,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// Attributes holds the value of the attributes edge.
+	Attributes []*Attribute `json:"attributes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // StoreOrErr returns the Store value or an error if the edge
@@ -66,18 +104,60 @@ func (e ItemEdges) ListsOrErr() ([]*List, error) {
 	return nil, &NotLoadedError{edge: "lists"}
 }
 
+// PriceObservationsOrErr returns the PriceObservations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) PriceObservationsOrErr() ([]*PriceObservation, error) {
+	if e.loadedTypes[2] {
+		return e.PriceObservations, nil
+	}
+	return nil, &NotLoadedError{edge: "price_observations"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// AttributesOrErr returns the Attributes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) AttributesOrErr() ([]*Attribute, error) {
+	if e.loadedTypes[5] {
+		return e.Attributes, nil
+	}
+	return nil, &NotLoadedError{edge: "attributes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldPrice:
+		case item.FieldProductPinned, item.FieldAttributesPinned, item.FieldAvailable:
+			values[i] = new(sql.NullBool)
+		case item.FieldSizeQuantity:
 			values[i] = new(sql.NullFloat64)
-		case item.FieldID:
+		case item.FieldID, item.FieldPriceAmount, item.FieldCategoryID, item.FieldUnitPriceMicros, item.FieldProductID:
 			values[i] = new(sql.NullInt64)
-		case item.FieldName, item.FieldBrand:
+		case item.FieldName, item.FieldBrand, item.FieldCurrency, item.FieldExternalID, item.FieldAisle, item.FieldUnit, item.FieldSizeUnit:
 			values[i] = new(sql.NullString)
-		case item.FieldCreateTime, item.FieldUpdateTime:
+		case item.FieldCreateTime, item.FieldUpdateTime, item.FieldDelistedAt, item.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
 		case item.ForeignKeys[0]: // store_items
 			values[i] = new(sql.NullInt64)
@@ -126,11 +206,103 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Brand = value.String
 			}
-		case item.FieldPrice:
+		case item.FieldPriceAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_amount", values[i])
+			} else if value.Valid {
+				_m.PriceAmount = value.Int64
+			}
+		case item.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case item.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = new(string)
+				*_m.ExternalID = value.String
+			}
+		case item.FieldAisle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field aisle", values[i])
+			} else if value.Valid {
+				_m.Aisle = value.String
+			}
+		case item.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(int)
+				*_m.CategoryID = int(value.Int64)
+			}
+		case item.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				_m.Unit = value.String
+			}
+		case item.FieldSizeQuantity:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field price", values[i])
+				return fmt.Errorf("unexpected type %T for field size_quantity", values[i])
+			} else if value.Valid {
+				_m.SizeQuantity = new(float64)
+				*_m.SizeQuantity = value.Float64
+			}
+		case item.FieldSizeUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field size_unit", values[i])
+			} else if value.Valid {
+				_m.SizeUnit = new(item.SizeUnit)
+				*_m.SizeUnit = item.SizeUnit(value.String)
+			}
+		case item.FieldUnitPriceMicros:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price_micros", values[i])
+			} else if value.Valid {
+				_m.UnitPriceMicros = new(int64)
+				*_m.UnitPriceMicros = value.Int64
+			}
+		case item.FieldProductID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				_m.ProductID = new(int)
+				*_m.ProductID = int(value.Int64)
+			}
+		case item.FieldProductPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field product_pinned", values[i])
+			} else if value.Valid {
+				_m.ProductPinned = value.Bool
+			}
+		case item.FieldAttributesPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes_pinned", values[i])
+			} else if value.Valid {
+				_m.AttributesPinned = value.Bool
+			}
+		case item.FieldAvailable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field available", values[i])
+			} else if value.Valid {
+				_m.Available = value.Bool
+			}
+		case item.FieldDelistedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delisted_at", values[i])
+			} else if value.Valid {
+				_m.DelistedAt = new(time.Time)
+				*_m.DelistedAt = value.Time
+			}
+		case item.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
 			} else if value.Valid {
-				_m.Price = value.Float64
+				_m.LastSeenAt = new(time.Time)
+				*_m.LastSeenAt = value.Time
 			}
 		case item.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -162,6 +334,26 @@ func (_m *Item) QueryLists() *ListQuery {
 	return NewItemClient(_m.config).QueryLists(_m)
 }
 
+// QueryPriceObservations queries the "price_observations" edge of the Item entity.
+func (_m *Item) QueryPriceObservations() *PriceObservationQuery {
+	return NewItemClient(_m.config).QueryPriceObservations(_m)
+}
+
+// QueryCategory queries the "category" edge of the Item entity.
+func (_m *Item) QueryCategory() *CategoryQuery {
+	return NewItemClient(_m.config).QueryCategory(_m)
+}
+
+// QueryProduct queries the "product" edge of the Item entity.
+func (_m *Item) QueryProduct() *ProductQuery {
+	return NewItemClient(_m.config).QueryProduct(_m)
+}
+
+// QueryAttributes queries the "attributes" edge of the Item entity.
+func (_m *Item) QueryAttributes() *AttributeQuery {
+	return NewItemClient(_m.config).QueryAttributes(_m)
+}
+
 // Update returns a builder for updating this Item.
 // Note that you need to call Item.Unwrap() before calling this method if this Item
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -197,8 +389,66 @@ func (_m *Item) String() string {
 	builder.WriteString("brand=")
 	builder.WriteString(_m.Brand)
 	builder.WriteString(", ")
-	builder.WriteString("price=")
-	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString("price_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceAmount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	if v := _m.ExternalID; v != nil {
+		builder.WriteString("external_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("aisle=")
+	builder.WriteString(_m.Aisle)
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(_m.Unit)
+	builder.WriteString(", ")
+	if v := _m.SizeQuantity; v != nil {
+		builder.WriteString("size_quantity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SizeUnit; v != nil {
+		builder.WriteString("size_unit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnitPriceMicros; v != nil {
+		builder.WriteString("unit_price_micros=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ProductID; v != nil {
+		builder.WriteString("product_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("product_pinned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProductPinned))
+	builder.WriteString(", ")
+	builder.WriteString("attributes_pinned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttributesPinned))
+	builder.WriteString(", ")
+	builder.WriteString("available=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Available))
+	builder.WriteString(", ")
+	if v := _m.DelistedAt; v != nil {
+		builder.WriteString("delisted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,7 @@
 package item
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -22,12 +23,48 @@ const (
 	FieldName = "name"
 	// FieldBrand holds the string denoting the brand field in the database.
 	FieldBrand = "brand"
-	// FieldPrice holds the string denoting the price field in the database.
-	FieldPrice = "price"
+	// FieldPriceAmount holds the string denoting the price_amount field in the database.
+	FieldPriceAmount = "price_amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
+	// FieldAisle holds the string denoting the aisle field in the database.
+	FieldAisle = "aisle"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldSizeQuantity holds the string denoting the size_quantity field in the database.
+	FieldSizeQuantity = "size_quantity"
+	// FieldSizeUnit holds the string denoting the size_unit field in the database.
+	FieldSizeUnit = "size_unit"
+	// FieldUnitPriceMicros holds the string denoting the unit_price_micros field in the database.
+	FieldUnitPriceMicros = "unit_price_micros"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldProductPinned holds the string denoting the product_pinned field in the database.
+	FieldProductPinned = "product_pinned"
+	// FieldAttributesPinned holds the string denoting the attributes_pinned field in the database.
+	FieldAttributesPinned = "attributes_pinned"
+	// FieldAvailable holds the string denoting the available field in the database.
+	FieldAvailable = "available"
+	// FieldDelistedAt holds the string denoting the delisted_at field in the database.
+	FieldDelistedAt = "delisted_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
 	// EdgeStore holds the string denoting the store edge name in mutations.
 	EdgeStore = "store"
 	// EdgeLists holds the string denoting the lists edge name in mutations.
 	EdgeLists = "lists"
+	// EdgePriceObservations holds the string denoting the price_observations edge name in mutations.
+	EdgePriceObservations = "price_observations"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// EdgeProduct holds the string denoting the product edge name in mutations.
+	EdgeProduct = "product"
+	// EdgeAttributes holds the string denoting the attributes edge name in mutations.
+	EdgeAttributes = "attributes"
 	// Table holds the table name of the item in the database.
 	Table = "items"
 	// StoreTable is the table that holds the store relation/edge.
@@ -42,6 +79,32 @@ const (
 	// ListsInverseTable is the table name for the List entity.
 	// It exists in this package in order to avoid circular dependency with the "list" package.
 	ListsInverseTable = "lists"
+	// PriceObservationsTable is the table that holds the price_observations relation/edge.
+	PriceObservationsTable = "price_observations"
+	// PriceObservationsInverseTable is the table name for the PriceObservation entity.
+	// It exists in this package in order to avoid circular dependency with the "priceobservation" package.
+	PriceObservationsInverseTable = "price_observations"
+	// PriceObservationsColumn is the table column denoting the price_observations relation/edge.
+	PriceObservationsColumn = "item_price_observations"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "items"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_id"
+	// ProductTable is the table that holds the product relation/edge.
+	ProductTable = "items"
+	// ProductInverseTable is the table name for the Product entity.
+	// It exists in this package in order to avoid circular dependency with the "product" package.
+	ProductInverseTable = "products"
+	// ProductColumn is the table column denoting the product relation/edge.
+	ProductColumn = "product_id"
+	// AttributesTable is the table that holds the attributes relation/edge. The primary key declared below.
+	AttributesTable = "item_attributes"
+	// AttributesInverseTable is the table name for the Attribute entity.
+	// It exists in this package in order to avoid circular dependency with the "attribute" package.
+	AttributesInverseTable = "attributes"
 )
 
 // Columns holds all SQL columns for item fields.
@@ -51,7 +114,21 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldName,
 	FieldBrand,
-	FieldPrice,
+	FieldPriceAmount,
+	FieldCurrency,
+	FieldExternalID,
+	FieldAisle,
+	FieldCategoryID,
+	FieldUnit,
+	FieldSizeQuantity,
+	FieldSizeUnit,
+	FieldUnitPriceMicros,
+	FieldProductID,
+	FieldProductPinned,
+	FieldAttributesPinned,
+	FieldAvailable,
+	FieldDelistedAt,
+	FieldLastSeenAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "items"
@@ -64,6 +141,9 @@ var (
 	// ListsPrimaryKey and ListsColumn2 are the table columns denoting the
 	// primary key for the lists relation (M2M).
 	ListsPrimaryKey = []string{"list_id", "item_id"}
+	// AttributesPrimaryKey and AttributesColumn2 are the table columns denoting the
+	// primary key for the attributes relation (M2M).
+	AttributesPrimaryKey = []string{"item_id", "attribute_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -92,10 +172,44 @@ var (
 	NameValidator func(string) error
 	// BrandValidator is a validator for the "brand" field. It is called by the builders before save.
 	BrandValidator func(string) error
-	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
+	// PriceAmountValidator is a validator for the "price_amount" field. It is called by the builders before save.
+	PriceAmountValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultProductPinned holds the default value on creation for the "product_pinned" field.
+	DefaultProductPinned bool
+	// DefaultAttributesPinned holds the default value on creation for the "attributes_pinned" field.
+	DefaultAttributesPinned bool
+	// DefaultAvailable holds the default value on creation for the "available" field.
+	DefaultAvailable bool
 )
 
+// SizeUnit defines the type for the "size_unit" enum field.
+type SizeUnit string
+
+// SizeUnit values.
+const (
+	SizeUnitG     SizeUnit = "g"
+	SizeUnitMl    SizeUnit = "ml"
+	SizeUnitCount SizeUnit = "count"
+)
+
+func (su SizeUnit) String() string {
+	return string(su)
+}
+
+// SizeUnitValidator is a validator for the "size_unit" field enum values. It is called by the builders before save.
+func SizeUnitValidator(su SizeUnit) error {
+	switch su {
+	case SizeUnitG, SizeUnitMl, SizeUnitCount:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for size_unit field: %q", su)
+	}
+}
+
 // OrderOption defines the ordering options for the Item queries.
 type OrderOption func(*sql.Selector)
 
@@ -124,9 +238,79 @@ func ByBrand(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBrand, opts...).ToFunc()
 }
 
-// ByPrice orders the results by the price field.
-func ByPrice(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+// ByPriceAmount orders the results by the price_amount field.
+func ByPriceAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
+
+// ByAisle orders the results by the aisle field.
+func ByAisle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAisle, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByUnit orders the results by the unit field.
+func ByUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnit, opts...).ToFunc()
+}
+
+// BySizeQuantity orders the results by the size_quantity field.
+func BySizeQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizeQuantity, opts...).ToFunc()
+}
+
+// BySizeUnit orders the results by the size_unit field.
+func BySizeUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizeUnit, opts...).ToFunc()
+}
+
+// ByUnitPriceMicros orders the results by the unit_price_micros field.
+func ByUnitPriceMicros(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPriceMicros, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
+// ByProductPinned orders the results by the product_pinned field.
+func ByProductPinned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductPinned, opts...).ToFunc()
+}
+
+// ByAttributesPinned orders the results by the attributes_pinned field.
+func ByAttributesPinned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttributesPinned, opts...).ToFunc()
+}
+
+// ByAvailable orders the results by the available field.
+func ByAvailable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailable, opts...).ToFunc()
+}
+
+// ByDelistedAt orders the results by the delisted_at field.
+func ByDelistedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDelistedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
 }
 
 // ByStoreField orders the results by store field.
@@ -149,6 +333,48 @@ func ByLists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newListsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPriceObservationsCount orders the results by price_observations count.
+func ByPriceObservationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPriceObservationsStep(), opts...)
+	}
+}
+
+// ByPriceObservations orders the results by price_observations terms.
+func ByPriceObservations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPriceObservationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProductField orders the results by product field.
+func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProductStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttributesCount orders the results by attributes count.
+func ByAttributesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttributesStep(), opts...)
+	}
+}
+
+// ByAttributes orders the results by attributes terms.
+func ByAttributes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttributesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newStoreStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -163,3 +389,31 @@ func newListsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, ListsTable, ListsPrimaryKey...),
 	)
 }
+func newPriceObservationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PriceObservationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PriceObservationsTable, PriceObservationsColumn),
+	)
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
+func newProductStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProductInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+	)
+}
+func newAttributesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttributesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, AttributesTable, AttributesPrimaryKey...),
+	)
+}
@@ -75,9 +75,74 @@ func Brand(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldBrand, v))
 }
 
-// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.Item {
-	return predicate.Item(sql.FieldEQ(FieldPrice, v))
+// PriceAmount applies equality check predicate on the "price_amount" field. It's identical to PriceAmountEQ.
+func PriceAmount(v int64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPriceAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldExternalID, v))
+}
+
+// Aisle applies equality check predicate on the "aisle" field. It's identical to AisleEQ.
+func Aisle(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAisle, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldUnit, v))
+}
+
+// SizeQuantity applies equality check predicate on the "size_quantity" field. It's identical to SizeQuantityEQ.
+func SizeQuantity(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSizeQuantity, v))
+}
+
+// UnitPriceMicros applies equality check predicate on the "unit_price_micros" field. It's identical to UnitPriceMicrosEQ.
+func UnitPriceMicros(v int64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldUnitPriceMicros, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductPinned applies equality check predicate on the "product_pinned" field. It's identical to ProductPinnedEQ.
+func ProductPinned(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProductPinned, v))
+}
+
+// AttributesPinned applies equality check predicate on the "attributes_pinned" field. It's identical to AttributesPinnedEQ.
+func AttributesPinned(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAttributesPinned, v))
+}
+
+// Available applies equality check predicate on the "available" field. It's identical to AvailableEQ.
+func Available(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAvailable, v))
+}
+
+// DelistedAt applies equality check predicate on the "delisted_at" field. It's identical to DelistedAtEQ.
+func DelistedAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDelistedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLastSeenAt, v))
 }
 
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
@@ -290,44 +355,654 @@ func BrandContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldBrand, v))
 }
 
-// PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.Item {
-	return predicate.Item(sql.FieldEQ(FieldPrice, v))
+// PriceAmountEQ applies the EQ predicate on the "price_amount" field.
+func PriceAmountEQ(v int64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPriceAmount, v))
+}
+
+// PriceAmountNEQ applies the NEQ predicate on the "price_amount" field.
+func PriceAmountNEQ(v int64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldPriceAmount, v))
+}
+
+// PriceAmountIn applies the In predicate on the "price_amount" field.
+func PriceAmountIn(vs ...int64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldPriceAmount, vs...))
+}
+
+// PriceAmountNotIn applies the NotIn predicate on the "price_amount" field.
+func PriceAmountNotIn(vs ...int64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldPriceAmount, vs...))
+}
+
+// PriceAmountGT applies the GT predicate on the "price_amount" field.
+func PriceAmountGT(v int64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldPriceAmount, v))
+}
+
+// PriceAmountGTE applies the GTE predicate on the "price_amount" field.
+func PriceAmountGTE(v int64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldPriceAmount, v))
+}
+
+// PriceAmountLT applies the LT predicate on the "price_amount" field.
+func PriceAmountLT(v int64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldPriceAmount, v))
+}
+
+// PriceAmountLTE applies the LTE predicate on the "price_amount" field.
+func PriceAmountLTE(v int64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldPriceAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldExternalID))
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldExternalID))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// AisleEQ applies the EQ predicate on the "aisle" field.
+func AisleEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAisle, v))
+}
+
+// AisleNEQ applies the NEQ predicate on the "aisle" field.
+func AisleNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldAisle, v))
+}
+
+// AisleIn applies the In predicate on the "aisle" field.
+func AisleIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldAisle, vs...))
+}
+
+// AisleNotIn applies the NotIn predicate on the "aisle" field.
+func AisleNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldAisle, vs...))
+}
+
+// AisleGT applies the GT predicate on the "aisle" field.
+func AisleGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldAisle, v))
+}
+
+// AisleGTE applies the GTE predicate on the "aisle" field.
+func AisleGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldAisle, v))
+}
+
+// AisleLT applies the LT predicate on the "aisle" field.
+func AisleLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldAisle, v))
+}
+
+// AisleLTE applies the LTE predicate on the "aisle" field.
+func AisleLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldAisle, v))
+}
+
+// AisleContains applies the Contains predicate on the "aisle" field.
+func AisleContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldAisle, v))
+}
+
+// AisleHasPrefix applies the HasPrefix predicate on the "aisle" field.
+func AisleHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldAisle, v))
+}
+
+// AisleHasSuffix applies the HasSuffix predicate on the "aisle" field.
+func AisleHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldAisle, v))
+}
+
+// AisleIsNil applies the IsNil predicate on the "aisle" field.
+func AisleIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldAisle))
+}
+
+// AisleNotNil applies the NotNil predicate on the "aisle" field.
+func AisleNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldAisle))
+}
+
+// AisleEqualFold applies the EqualFold predicate on the "aisle" field.
+func AisleEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldAisle, v))
+}
+
+// AisleContainsFold applies the ContainsFold predicate on the "aisle" field.
+func AisleContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldAisle, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCategoryID))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldUnit, v))
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldUnit, v))
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldUnit, v))
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldUnit, v))
+}
+
+// UnitContains applies the Contains predicate on the "unit" field.
+func UnitContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldUnit, v))
+}
+
+// UnitHasPrefix applies the HasPrefix predicate on the "unit" field.
+func UnitHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldUnit, v))
+}
+
+// UnitHasSuffix applies the HasSuffix predicate on the "unit" field.
+func UnitHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldUnit, v))
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldUnit))
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldUnit))
+}
+
+// UnitEqualFold applies the EqualFold predicate on the "unit" field.
+func UnitEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldUnit, v))
+}
+
+// UnitContainsFold applies the ContainsFold predicate on the "unit" field.
+func UnitContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldUnit, v))
+}
+
+// SizeQuantityEQ applies the EQ predicate on the "size_quantity" field.
+func SizeQuantityEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSizeQuantity, v))
+}
+
+// SizeQuantityNEQ applies the NEQ predicate on the "size_quantity" field.
+func SizeQuantityNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldSizeQuantity, v))
+}
+
+// SizeQuantityIn applies the In predicate on the "size_quantity" field.
+func SizeQuantityIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldSizeQuantity, vs...))
+}
+
+// SizeQuantityNotIn applies the NotIn predicate on the "size_quantity" field.
+func SizeQuantityNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldSizeQuantity, vs...))
+}
+
+// SizeQuantityGT applies the GT predicate on the "size_quantity" field.
+func SizeQuantityGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldSizeQuantity, v))
+}
+
+// SizeQuantityGTE applies the GTE predicate on the "size_quantity" field.
+func SizeQuantityGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldSizeQuantity, v))
+}
+
+// SizeQuantityLT applies the LT predicate on the "size_quantity" field.
+func SizeQuantityLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldSizeQuantity, v))
+}
+
+// SizeQuantityLTE applies the LTE predicate on the "size_quantity" field.
+func SizeQuantityLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldSizeQuantity, v))
+}
+
+// SizeQuantityIsNil applies the IsNil predicate on the "size_quantity" field.
+func SizeQuantityIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldSizeQuantity))
+}
+
+// SizeQuantityNotNil applies the NotNil predicate on the "size_quantity" field.
+func SizeQuantityNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldSizeQuantity))
+}
+
+// SizeUnitEQ applies the EQ predicate on the "size_unit" field.
+func SizeUnitEQ(v SizeUnit) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldSizeUnit, v))
+}
+
+// SizeUnitNEQ applies the NEQ predicate on the "size_unit" field.
+func SizeUnitNEQ(v SizeUnit) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldSizeUnit, v))
+}
+
+// SizeUnitIn applies the In predicate on the "size_unit" field.
+func SizeUnitIn(vs ...SizeUnit) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldSizeUnit, vs...))
+}
+
+// SizeUnitNotIn applies the NotIn predicate on the "size_unit" field.
+func SizeUnitNotIn(vs ...SizeUnit) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldSizeUnit, vs...))
+}
+
+// SizeUnitIsNil applies the IsNil predicate on the "size_unit" field.
+func SizeUnitIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldSizeUnit))
+}
+
+// SizeUnitNotNil applies the NotNil predicate on the "size_unit" field.
+func SizeUnitNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldSizeUnit))
+}
+
+// UnitPriceMicrosEQ applies the EQ predicate on the "unit_price_micros" field.
+func UnitPriceMicrosEQ(v int64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldUnitPriceMicros, v))
+}
+
+// UnitPriceMicrosNEQ applies the NEQ predicate on the "unit_price_micros" field.
+func UnitPriceMicrosNEQ(v int64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldUnitPriceMicros, v))
+}
+
+// UnitPriceMicrosIn applies the In predicate on the "unit_price_micros" field.
+func UnitPriceMicrosIn(vs ...int64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldUnitPriceMicros, vs...))
+}
+
+// UnitPriceMicrosNotIn applies the NotIn predicate on the "unit_price_micros" field.
+func UnitPriceMicrosNotIn(vs ...int64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldUnitPriceMicros, vs...))
+}
+
+// UnitPriceMicrosGT applies the GT predicate on the "unit_price_micros" field.
+func UnitPriceMicrosGT(v int64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldUnitPriceMicros, v))
+}
+
+// UnitPriceMicrosGTE applies the GTE predicate on the "unit_price_micros" field.
+func UnitPriceMicrosGTE(v int64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldUnitPriceMicros, v))
+}
+
+// UnitPriceMicrosLT applies the LT predicate on the "unit_price_micros" field.
+func UnitPriceMicrosLT(v int64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldUnitPriceMicros, v))
+}
+
+// UnitPriceMicrosLTE applies the LTE predicate on the "unit_price_micros" field.
+func UnitPriceMicrosLTE(v int64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldUnitPriceMicros, v))
+}
+
+// UnitPriceMicrosIsNil applies the IsNil predicate on the "unit_price_micros" field.
+func UnitPriceMicrosIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldUnitPriceMicros))
+}
+
+// UnitPriceMicrosNotNil applies the NotNil predicate on the "unit_price_micros" field.
+func UnitPriceMicrosNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldUnitPriceMicros))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDIsNil applies the IsNil predicate on the "product_id" field.
+func ProductIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldProductID))
+}
+
+// ProductIDNotNil applies the NotNil predicate on the "product_id" field.
+func ProductIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldProductID))
+}
+
+// ProductPinnedEQ applies the EQ predicate on the "product_pinned" field.
+func ProductPinnedEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProductPinned, v))
+}
+
+// ProductPinnedNEQ applies the NEQ predicate on the "product_pinned" field.
+func ProductPinnedNEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldProductPinned, v))
+}
+
+// AttributesPinnedEQ applies the EQ predicate on the "attributes_pinned" field.
+func AttributesPinnedEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAttributesPinned, v))
+}
+
+// AttributesPinnedNEQ applies the NEQ predicate on the "attributes_pinned" field.
+func AttributesPinnedNEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldAttributesPinned, v))
+}
+
+// AvailableEQ applies the EQ predicate on the "available" field.
+func AvailableEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAvailable, v))
+}
+
+// AvailableNEQ applies the NEQ predicate on the "available" field.
+func AvailableNEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldAvailable, v))
+}
+
+// DelistedAtEQ applies the EQ predicate on the "delisted_at" field.
+func DelistedAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldDelistedAt, v))
+}
+
+// DelistedAtNEQ applies the NEQ predicate on the "delisted_at" field.
+func DelistedAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldDelistedAt, v))
+}
+
+// DelistedAtIn applies the In predicate on the "delisted_at" field.
+func DelistedAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldDelistedAt, vs...))
+}
+
+// DelistedAtNotIn applies the NotIn predicate on the "delisted_at" field.
+func DelistedAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldDelistedAt, vs...))
+}
+
+// DelistedAtGT applies the GT predicate on the "delisted_at" field.
+func DelistedAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldDelistedAt, v))
 }
 
-// PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.Item {
-	return predicate.Item(sql.FieldNEQ(FieldPrice, v))
+// DelistedAtGTE applies the GTE predicate on the "delisted_at" field.
+func DelistedAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldDelistedAt, v))
 }
 
-// PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.Item {
-	return predicate.Item(sql.FieldIn(FieldPrice, vs...))
+// DelistedAtLT applies the LT predicate on the "delisted_at" field.
+func DelistedAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldDelistedAt, v))
 }
 
-// PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.Item {
-	return predicate.Item(sql.FieldNotIn(FieldPrice, vs...))
+// DelistedAtLTE applies the LTE predicate on the "delisted_at" field.
+func DelistedAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldDelistedAt, v))
 }
 
-// PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.Item {
-	return predicate.Item(sql.FieldGT(FieldPrice, v))
+// DelistedAtIsNil applies the IsNil predicate on the "delisted_at" field.
+func DelistedAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldDelistedAt))
 }
 
-// PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.Item {
-	return predicate.Item(sql.FieldGTE(FieldPrice, v))
+// DelistedAtNotNil applies the NotNil predicate on the "delisted_at" field.
+func DelistedAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldDelistedAt))
 }
 
-// PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.Item {
-	return predicate.Item(sql.FieldLT(FieldPrice, v))
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLastSeenAt, v))
 }
 
-// PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.Item {
-	return predicate.Item(sql.FieldLTE(FieldPrice, v))
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIsNil applies the IsNil predicate on the "last_seen_at" field.
+func LastSeenAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldLastSeenAt))
+}
+
+// LastSeenAtNotNil applies the NotNil predicate on the "last_seen_at" field.
+func LastSeenAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldLastSeenAt))
 }
 
 // HasStore applies the HasEdge predicate on the "store" edge.
@@ -376,6 +1051,98 @@ func HasListsWith(preds ...predicate.List) predicate.Item {
 	})
 }
 
+// HasPriceObservations applies the HasEdge predicate on the "price_observations" edge.
+func HasPriceObservations() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PriceObservationsTable, PriceObservationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPriceObservationsWith applies the HasEdge predicate on the "price_observations" edge with a given conditions (other predicates).
+func HasPriceObservationsWith(preds ...predicate.PriceObservation) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newPriceObservationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttributes applies the HasEdge predicate on the "attributes" edge.
+func HasAttributes() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, AttributesTable, AttributesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttributesWith applies the HasEdge predicate on the "attributes" edge with a given conditions (other predicates).
+func HasAttributesWith(preds ...predicate.Attribute) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newAttributesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.Item(sql.AndPredicates(predicates...))
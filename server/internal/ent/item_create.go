@@ -6,11 +6,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/list"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/product"
 	"offgrocery-assessment/internal/ent/store"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,6 +25,7 @@ type ItemCreate struct {
 	config
 	mutation *ItemMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
@@ -62,9 +68,205 @@ func (_c *ItemCreate) SetBrand(v string) *ItemCreate {
 	return _c
 }
 
-// SetPrice sets the "price" field.
-func (_c *ItemCreate) SetPrice(v float64) *ItemCreate {
-	_c.mutation.SetPrice(v)
+// SetPriceAmount sets the "price_amount" field.
+func (_c *ItemCreate) SetPriceAmount(v int64) *ItemCreate {
+	_c.mutation.SetPriceAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *ItemCreate) SetCurrency(v string) *ItemCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableCurrency(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *ItemCreate) SetExternalID(v string) *ItemCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableExternalID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetAisle sets the "aisle" field.
+func (_c *ItemCreate) SetAisle(v string) *ItemCreate {
+	_c.mutation.SetAisle(v)
+	return _c
+}
+
+// SetNillableAisle sets the "aisle" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableAisle(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetAisle(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *ItemCreate) SetCategoryID(v int) *ItemCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableCategoryID(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetUnit sets the "unit" field.
+func (_c *ItemCreate) SetUnit(v string) *ItemCreate {
+	_c.mutation.SetUnit(v)
+	return _c
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableUnit(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetUnit(*v)
+	}
+	return _c
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (_c *ItemCreate) SetSizeQuantity(v float64) *ItemCreate {
+	_c.mutation.SetSizeQuantity(v)
+	return _c
+}
+
+// SetNillableSizeQuantity sets the "size_quantity" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableSizeQuantity(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetSizeQuantity(*v)
+	}
+	return _c
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (_c *ItemCreate) SetSizeUnit(v item.SizeUnit) *ItemCreate {
+	_c.mutation.SetSizeUnit(v)
+	return _c
+}
+
+// SetNillableSizeUnit sets the "size_unit" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableSizeUnit(v *item.SizeUnit) *ItemCreate {
+	if v != nil {
+		_c.SetSizeUnit(*v)
+	}
+	return _c
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (_c *ItemCreate) SetUnitPriceMicros(v int64) *ItemCreate {
+	_c.mutation.SetUnitPriceMicros(v)
+	return _c
+}
+
+// SetNillableUnitPriceMicros sets the "unit_price_micros" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableUnitPriceMicros(v *int64) *ItemCreate {
+	if v != nil {
+		_c.SetUnitPriceMicros(*v)
+	}
+	return _c
+}
+
+// SetProductID sets the "product_id" field.
+func (_c *ItemCreate) SetProductID(v int) *ItemCreate {
+	_c.mutation.SetProductID(v)
+	return _c
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableProductID(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetProductID(*v)
+	}
+	return _c
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (_c *ItemCreate) SetProductPinned(v bool) *ItemCreate {
+	_c.mutation.SetProductPinned(v)
+	return _c
+}
+
+// SetNillableProductPinned sets the "product_pinned" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableProductPinned(v *bool) *ItemCreate {
+	if v != nil {
+		_c.SetProductPinned(*v)
+	}
+	return _c
+}
+
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (_c *ItemCreate) SetAttributesPinned(v bool) *ItemCreate {
+	_c.mutation.SetAttributesPinned(v)
+	return _c
+}
+
+// SetNillableAttributesPinned sets the "attributes_pinned" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableAttributesPinned(v *bool) *ItemCreate {
+	if v != nil {
+		_c.SetAttributesPinned(*v)
+	}
+	return _c
+}
+
+// SetAvailable sets the "available" field.
+func (_c *ItemCreate) SetAvailable(v bool) *ItemCreate {
+	_c.mutation.SetAvailable(v)
+	return _c
+}
+
+// SetNillableAvailable sets the "available" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableAvailable(v *bool) *ItemCreate {
+	if v != nil {
+		_c.SetAvailable(*v)
+	}
+	return _c
+}
+
+// SetDelistedAt sets the "delisted_at" field.
+func (_c *ItemCreate) SetDelistedAt(v time.Time) *ItemCreate {
+	_c.mutation.SetDelistedAt(v)
+	return _c
+}
+
+// SetNillableDelistedAt sets the "delisted_at" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableDelistedAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetDelistedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *ItemCreate) SetLastSeenAt(v time.Time) *ItemCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableLastSeenAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
 	return _c
 }
 
@@ -94,6 +296,46 @@ func (_c *ItemCreate) AddLists(v ...*List) *ItemCreate {
 	return _c.AddListIDs(ids...)
 }
 
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by IDs.
+func (_c *ItemCreate) AddPriceObservationIDs(ids ...int) *ItemCreate {
+	_c.mutation.AddPriceObservationIDs(ids...)
+	return _c
+}
+
+// AddPriceObservations adds the "price_observations" edges to the PriceObservation entity.
+func (_c *ItemCreate) AddPriceObservations(v ...*PriceObservation) *ItemCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPriceObservationIDs(ids...)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *ItemCreate) SetCategory(v *Category) *ItemCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_c *ItemCreate) SetProduct(v *Product) *ItemCreate {
+	return _c.SetProductID(v.ID)
+}
+
+// AddAttributeIDs adds the "attributes" edge to the Attribute entity by IDs.
+func (_c *ItemCreate) AddAttributeIDs(ids ...int) *ItemCreate {
+	_c.mutation.AddAttributeIDs(ids...)
+	return _c
+}
+
+// AddAttributes adds the "attributes" edges to the Attribute entity.
+func (_c *ItemCreate) AddAttributes(v ...*Attribute) *ItemCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (_c *ItemCreate) Mutation() *ItemMutation {
 	return _c.mutation
@@ -137,6 +379,22 @@ func (_c *ItemCreate) defaults() {
 		v := item.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := item.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ProductPinned(); !ok {
+		v := item.DefaultProductPinned
+		_c.mutation.SetProductPinned(v)
+	}
+	if _, ok := _c.mutation.AttributesPinned(); !ok {
+		v := item.DefaultAttributesPinned
+		_c.mutation.SetAttributesPinned(v)
+	}
+	if _, ok := _c.mutation.Available(); !ok {
+		v := item.DefaultAvailable
+		_c.mutation.SetAvailable(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -163,14 +421,36 @@ func (_c *ItemCreate) check() error {
 			return &ValidationError{Name: "brand", err: fmt.Errorf(`ent: validator failed for field "Item.brand": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.Price(); !ok {
-		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Item.price"`)}
+	if _, ok := _c.mutation.PriceAmount(); !ok {
+		return &ValidationError{Name: "price_amount", err: errors.New(`ent: missing required field "Item.price_amount"`)}
+	}
+	if v, ok := _c.mutation.PriceAmount(); ok {
+		if err := item.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`ent: validator failed for field "Item.price_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Item.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
 	}
-	if v, ok := _c.mutation.Price(); ok {
-		if err := item.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Item.price": %w`, err)}
+	if v, ok := _c.mutation.SizeUnit(); ok {
+		if err := item.SizeUnitValidator(v); err != nil {
+			return &ValidationError{Name: "size_unit", err: fmt.Errorf(`ent: validator failed for field "Item.size_unit": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ProductPinned(); !ok {
+		return &ValidationError{Name: "product_pinned", err: errors.New(`ent: missing required field "Item.product_pinned"`)}
+	}
+	if _, ok := _c.mutation.AttributesPinned(); !ok {
+		return &ValidationError{Name: "attributes_pinned", err: errors.New(`ent: missing required field "Item.attributes_pinned"`)}
+	}
+	if _, ok := _c.mutation.Available(); !ok {
+		return &ValidationError{Name: "available", err: errors.New(`ent: missing required field "Item.available"`)}
+	}
 	if len(_c.mutation.StoreIDs()) == 0 {
 		return &ValidationError{Name: "store", err: errors.New(`ent: missing required edge "Item.store"`)}
 	}
@@ -200,6 +480,7 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_node = &Item{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(item.Table, sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(item.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
@@ -216,9 +497,57 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldBrand, field.TypeString, value)
 		_node.Brand = value
 	}
-	if value, ok := _c.mutation.Price(); ok {
-		_spec.SetField(item.FieldPrice, field.TypeFloat64, value)
-		_node.Price = value
+	if value, ok := _c.mutation.PriceAmount(); ok {
+		_spec.SetField(item.FieldPriceAmount, field.TypeInt64, value)
+		_node.PriceAmount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = &value
+	}
+	if value, ok := _c.mutation.Aisle(); ok {
+		_spec.SetField(item.FieldAisle, field.TypeString, value)
+		_node.Aisle = value
+	}
+	if value, ok := _c.mutation.Unit(); ok {
+		_spec.SetField(item.FieldUnit, field.TypeString, value)
+		_node.Unit = value
+	}
+	if value, ok := _c.mutation.SizeQuantity(); ok {
+		_spec.SetField(item.FieldSizeQuantity, field.TypeFloat64, value)
+		_node.SizeQuantity = &value
+	}
+	if value, ok := _c.mutation.SizeUnit(); ok {
+		_spec.SetField(item.FieldSizeUnit, field.TypeEnum, value)
+		_node.SizeUnit = &value
+	}
+	if value, ok := _c.mutation.UnitPriceMicros(); ok {
+		_spec.SetField(item.FieldUnitPriceMicros, field.TypeInt64, value)
+		_node.UnitPriceMicros = &value
+	}
+	if value, ok := _c.mutation.ProductPinned(); ok {
+		_spec.SetField(item.FieldProductPinned, field.TypeBool, value)
+		_node.ProductPinned = value
+	}
+	if value, ok := _c.mutation.AttributesPinned(); ok {
+		_spec.SetField(item.FieldAttributesPinned, field.TypeBool, value)
+		_node.AttributesPinned = value
+	}
+	if value, ok := _c.mutation.Available(); ok {
+		_spec.SetField(item.FieldAvailable, field.TypeBool, value)
+		_node.Available = value
+	}
+	if value, ok := _c.mutation.DelistedAt(); ok {
+		_spec.SetField(item.FieldDelistedAt, field.TypeTime, value)
+		_node.DelistedAt = &value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(item.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
 	}
 	if nodes := _c.mutation.StoreIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -253,64 +582,896 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PriceObservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.CategoryTable,
+			Columns: []string{item.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ProductTable,
+			Columns: []string{item.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// ItemCreateBulk is the builder for creating many Item entities in bulk.
-type ItemCreateBulk struct {
-	config
-	err      error
-	builders []*ItemCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Item.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ItemCreate) OnConflict(opts ...sql.ConflictOption) *ItemUpsertOne {
+	_c.conflict = opts
+	return &ItemUpsertOne{
+		create: _c,
+	}
 }
 
-// Save creates the Item entities in the database.
-func (_c *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
-	if _c.err != nil {
-		return nil, _c.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ItemCreate) OnConflictColumns(columns ...string) *ItemUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ItemUpsertOne{
+		create: _c,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*Item, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*ItemMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
+}
+
+type (
+	// ItemUpsertOne is the builder for "upsert"-ing
+	//  one Item node.
+	ItemUpsertOne struct {
+		create *ItemCreate
+	}
+
+	// ItemUpsert is the "OnConflict" setter.
+	ItemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ItemUpsert) SetUpdateTime(v time.Time) *ItemUpsert {
+	u.Set(item.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateUpdateTime() *ItemUpsert {
+	u.SetExcluded(item.FieldUpdateTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ItemUpsert) SetName(v string) *ItemUpsert {
+	u.Set(item.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateName() *ItemUpsert {
+	u.SetExcluded(item.FieldName)
+	return u
+}
+
+// SetBrand sets the "brand" field.
+func (u *ItemUpsert) SetBrand(v string) *ItemUpsert {
+	u.Set(item.FieldBrand, v)
+	return u
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateBrand() *ItemUpsert {
+	u.SetExcluded(item.FieldBrand)
+	return u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *ItemUpsert) SetPriceAmount(v int64) *ItemUpsert {
+	u.Set(item.FieldPriceAmount, v)
+	return u
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *ItemUpsert) UpdatePriceAmount() *ItemUpsert {
+	u.SetExcluded(item.FieldPriceAmount)
+	return u
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *ItemUpsert) AddPriceAmount(v int64) *ItemUpsert {
+	u.Add(item.FieldPriceAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ItemUpsert) SetCurrency(v string) *ItemUpsert {
+	u.Set(item.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateCurrency() *ItemUpsert {
+	u.SetExcluded(item.FieldCurrency)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsert) SetExternalID(v string) *ItemUpsert {
+	u.Set(item.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateExternalID() *ItemUpsert {
+	u.SetExcluded(item.FieldExternalID)
+	return u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *ItemUpsert) ClearExternalID() *ItemUpsert {
+	u.SetNull(item.FieldExternalID)
+	return u
+}
+
+// SetAisle sets the "aisle" field.
+func (u *ItemUpsert) SetAisle(v string) *ItemUpsert {
+	u.Set(item.FieldAisle, v)
+	return u
+}
+
+// UpdateAisle sets the "aisle" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateAisle() *ItemUpsert {
+	u.SetExcluded(item.FieldAisle)
+	return u
+}
+
+// ClearAisle clears the value of the "aisle" field.
+func (u *ItemUpsert) ClearAisle() *ItemUpsert {
+	u.SetNull(item.FieldAisle)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *ItemUpsert) SetCategoryID(v int) *ItemUpsert {
+	u.Set(item.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateCategoryID() *ItemUpsert {
+	u.SetExcluded(item.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *ItemUpsert) ClearCategoryID() *ItemUpsert {
+	u.SetNull(item.FieldCategoryID)
+	return u
+}
+
+// SetUnit sets the "unit" field.
+func (u *ItemUpsert) SetUnit(v string) *ItemUpsert {
+	u.Set(item.FieldUnit, v)
+	return u
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateUnit() *ItemUpsert {
+	u.SetExcluded(item.FieldUnit)
+	return u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *ItemUpsert) ClearUnit() *ItemUpsert {
+	u.SetNull(item.FieldUnit)
+	return u
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (u *ItemUpsert) SetSizeQuantity(v float64) *ItemUpsert {
+	u.Set(item.FieldSizeQuantity, v)
+	return u
+}
+
+// UpdateSizeQuantity sets the "size_quantity" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateSizeQuantity() *ItemUpsert {
+	u.SetExcluded(item.FieldSizeQuantity)
+	return u
+}
+
+// AddSizeQuantity adds v to the "size_quantity" field.
+func (u *ItemUpsert) AddSizeQuantity(v float64) *ItemUpsert {
+	u.Add(item.FieldSizeQuantity, v)
+	return u
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (u *ItemUpsert) ClearSizeQuantity() *ItemUpsert {
+	u.SetNull(item.FieldSizeQuantity)
+	return u
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (u *ItemUpsert) SetSizeUnit(v item.SizeUnit) *ItemUpsert {
+	u.Set(item.FieldSizeUnit, v)
+	return u
+}
+
+// UpdateSizeUnit sets the "size_unit" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateSizeUnit() *ItemUpsert {
+	u.SetExcluded(item.FieldSizeUnit)
+	return u
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (u *ItemUpsert) ClearSizeUnit() *ItemUpsert {
+	u.SetNull(item.FieldSizeUnit)
+	return u
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (u *ItemUpsert) SetUnitPriceMicros(v int64) *ItemUpsert {
+	u.Set(item.FieldUnitPriceMicros, v)
+	return u
+}
+
+// UpdateUnitPriceMicros sets the "unit_price_micros" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateUnitPriceMicros() *ItemUpsert {
+	u.SetExcluded(item.FieldUnitPriceMicros)
+	return u
+}
+
+// AddUnitPriceMicros adds v to the "unit_price_micros" field.
+func (u *ItemUpsert) AddUnitPriceMicros(v int64) *ItemUpsert {
+	u.Add(item.FieldUnitPriceMicros, v)
+	return u
+}
+
+// ClearUnitPriceMicros clears the value of the "unit_price_micros" field.
+func (u *ItemUpsert) ClearUnitPriceMicros() *ItemUpsert {
+	u.SetNull(item.FieldUnitPriceMicros)
+	return u
+}
+
+// SetProductID sets the "product_id" field.
+func (u *ItemUpsert) SetProductID(v int) *ItemUpsert {
+	u.Set(item.FieldProductID, v)
+	return u
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateProductID() *ItemUpsert {
+	u.SetExcluded(item.FieldProductID)
+	return u
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (u *ItemUpsert) ClearProductID() *ItemUpsert {
+	u.SetNull(item.FieldProductID)
+	return u
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (u *ItemUpsert) SetProductPinned(v bool) *ItemUpsert {
+	u.Set(item.FieldProductPinned, v)
+	return u
+}
+
+// UpdateProductPinned sets the "product_pinned" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateProductPinned() *ItemUpsert {
+	u.SetExcluded(item.FieldProductPinned)
+	return u
+}
+
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (u *ItemUpsert) SetAttributesPinned(v bool) *ItemUpsert {
+	u.Set(item.FieldAttributesPinned, v)
+	return u
+}
+
+// UpdateAttributesPinned sets the "attributes_pinned" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateAttributesPinned() *ItemUpsert {
+	u.SetExcluded(item.FieldAttributesPinned)
+	return u
+}
+
+// SetAvailable sets the "available" field.
+func (u *ItemUpsert) SetAvailable(v bool) *ItemUpsert {
+	u.Set(item.FieldAvailable, v)
+	return u
+}
+
+// UpdateAvailable sets the "available" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateAvailable() *ItemUpsert {
+	u.SetExcluded(item.FieldAvailable)
+	return u
+}
+
+// SetDelistedAt sets the "delisted_at" field.
+func (u *ItemUpsert) SetDelistedAt(v time.Time) *ItemUpsert {
+	u.Set(item.FieldDelistedAt, v)
+	return u
+}
+
+// UpdateDelistedAt sets the "delisted_at" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateDelistedAt() *ItemUpsert {
+	u.SetExcluded(item.FieldDelistedAt)
+	return u
+}
+
+// ClearDelistedAt clears the value of the "delisted_at" field.
+func (u *ItemUpsert) ClearDelistedAt() *ItemUpsert {
+	u.SetNull(item.FieldDelistedAt)
+	return u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *ItemUpsert) SetLastSeenAt(v time.Time) *ItemUpsert {
+	u.Set(item.FieldLastSeenAt, v)
+	return u
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateLastSeenAt() *ItemUpsert {
+	u.SetExcluded(item.FieldLastSeenAt)
+	return u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *ItemUpsert) ClearLastSeenAt() *ItemUpsert {
+	u.SetNull(item.FieldLastSeenAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ItemUpsertOne) UpdateNewValues() *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(item.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ItemUpsertOne) Ignore() *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemUpsertOne) DoNothing() *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemCreate.OnConflict
+// documentation for more info.
+func (u *ItemUpsertOne) Update(set func(*ItemUpsert)) *ItemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ItemUpsertOne) SetUpdateTime(v time.Time) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateUpdateTime() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ItemUpsertOne) SetName(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateName() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBrand sets the "brand" field.
+func (u *ItemUpsertOne) SetBrand(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetBrand(v)
+	})
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateBrand() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateBrand()
+	})
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *ItemUpsertOne) SetPriceAmount(v int64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetPriceAmount(v)
+	})
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *ItemUpsertOne) AddPriceAmount(v int64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddPriceAmount(v)
+	})
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdatePriceAmount() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdatePriceAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ItemUpsertOne) SetCurrency(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateCurrency() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsertOne) SetExternalID(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateExternalID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *ItemUpsertOne) ClearExternalID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearExternalID()
+	})
+}
+
+// SetAisle sets the "aisle" field.
+func (u *ItemUpsertOne) SetAisle(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAisle(v)
+	})
+}
+
+// UpdateAisle sets the "aisle" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateAisle() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAisle()
+	})
+}
+
+// ClearAisle clears the value of the "aisle" field.
+func (u *ItemUpsertOne) ClearAisle() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearAisle()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *ItemUpsertOne) SetCategoryID(v int) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateCategoryID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *ItemUpsertOne) ClearCategoryID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *ItemUpsertOne) SetUnit(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateUnit() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *ItemUpsertOne) ClearUnit() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (u *ItemUpsertOne) SetSizeQuantity(v float64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetSizeQuantity(v)
+	})
+}
+
+// AddSizeQuantity adds v to the "size_quantity" field.
+func (u *ItemUpsertOne) AddSizeQuantity(v float64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddSizeQuantity(v)
+	})
+}
+
+// UpdateSizeQuantity sets the "size_quantity" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateSizeQuantity() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateSizeQuantity()
+	})
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (u *ItemUpsertOne) ClearSizeQuantity() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearSizeQuantity()
+	})
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (u *ItemUpsertOne) SetSizeUnit(v item.SizeUnit) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetSizeUnit(v)
+	})
+}
+
+// UpdateSizeUnit sets the "size_unit" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateSizeUnit() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateSizeUnit()
+	})
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (u *ItemUpsertOne) ClearSizeUnit() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearSizeUnit()
+	})
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (u *ItemUpsertOne) SetUnitPriceMicros(v int64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUnitPriceMicros(v)
+	})
+}
+
+// AddUnitPriceMicros adds v to the "unit_price_micros" field.
+func (u *ItemUpsertOne) AddUnitPriceMicros(v int64) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddUnitPriceMicros(v)
+	})
+}
+
+// UpdateUnitPriceMicros sets the "unit_price_micros" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateUnitPriceMicros() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUnitPriceMicros()
+	})
+}
+
+// ClearUnitPriceMicros clears the value of the "unit_price_micros" field.
+func (u *ItemUpsertOne) ClearUnitPriceMicros() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearUnitPriceMicros()
+	})
+}
+
+// SetProductID sets the "product_id" field.
+func (u *ItemUpsertOne) SetProductID(v int) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetProductID(v)
+	})
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateProductID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateProductID()
+	})
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (u *ItemUpsertOne) ClearProductID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearProductID()
+	})
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (u *ItemUpsertOne) SetProductPinned(v bool) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetProductPinned(v)
+	})
+}
+
+// UpdateProductPinned sets the "product_pinned" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateProductPinned() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateProductPinned()
+	})
+}
+
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (u *ItemUpsertOne) SetAttributesPinned(v bool) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAttributesPinned(v)
+	})
+}
+
+// UpdateAttributesPinned sets the "attributes_pinned" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateAttributesPinned() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAttributesPinned()
+	})
+}
+
+// SetAvailable sets the "available" field.
+func (u *ItemUpsertOne) SetAvailable(v bool) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAvailable(v)
+	})
+}
+
+// UpdateAvailable sets the "available" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateAvailable() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAvailable()
+	})
+}
+
+// SetDelistedAt sets the "delisted_at" field.
+func (u *ItemUpsertOne) SetDelistedAt(v time.Time) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetDelistedAt(v)
+	})
+}
+
+// UpdateDelistedAt sets the "delisted_at" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateDelistedAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateDelistedAt()
+	})
+}
+
+// ClearDelistedAt clears the value of the "delisted_at" field.
+func (u *ItemUpsertOne) ClearDelistedAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearDelistedAt()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *ItemUpsertOne) SetLastSeenAt(v time.Time) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateLastSeenAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *ItemUpsertOne) ClearLastSeenAt() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ItemUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ItemUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ItemCreateBulk is the builder for creating many Item entities in bulk.
+type ItemCreateBulk struct {
+	config
+	err      error
+	builders []*ItemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Item entities in the database.
+func (_c *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Item, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
 			mutators[i] = mut
 		}(i, ctx)
 	}
@@ -343,3 +1504,457 @@ func (_c *ItemCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Item.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ItemUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ItemCreateBulk) OnConflict(opts ...sql.ConflictOption) *ItemUpsertBulk {
+	_c.conflict = opts
+	return &ItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ItemCreateBulk) OnConflictColumns(columns ...string) *ItemUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ItemUpsertBulk{
+		create: _c,
+	}
+}
+
+// ItemUpsertBulk is the builder for "upsert"-ing
+// a bulk of Item nodes.
+type ItemUpsertBulk struct {
+	create *ItemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ItemUpsertBulk) UpdateNewValues() *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(item.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Item.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ItemUpsertBulk) Ignore() *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ItemUpsertBulk) DoNothing() *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ItemCreateBulk.OnConflict
+// documentation for more info.
+func (u *ItemUpsertBulk) Update(set func(*ItemUpsert)) *ItemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ItemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ItemUpsertBulk) SetUpdateTime(v time.Time) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateUpdateTime() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ItemUpsertBulk) SetName(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateName() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBrand sets the "brand" field.
+func (u *ItemUpsertBulk) SetBrand(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetBrand(v)
+	})
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateBrand() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateBrand()
+	})
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *ItemUpsertBulk) SetPriceAmount(v int64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetPriceAmount(v)
+	})
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *ItemUpsertBulk) AddPriceAmount(v int64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddPriceAmount(v)
+	})
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdatePriceAmount() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdatePriceAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ItemUpsertBulk) SetCurrency(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateCurrency() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsertBulk) SetExternalID(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateExternalID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (u *ItemUpsertBulk) ClearExternalID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearExternalID()
+	})
+}
+
+// SetAisle sets the "aisle" field.
+func (u *ItemUpsertBulk) SetAisle(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAisle(v)
+	})
+}
+
+// UpdateAisle sets the "aisle" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateAisle() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAisle()
+	})
+}
+
+// ClearAisle clears the value of the "aisle" field.
+func (u *ItemUpsertBulk) ClearAisle() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearAisle()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *ItemUpsertBulk) SetCategoryID(v int) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateCategoryID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *ItemUpsertBulk) ClearCategoryID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetUnit sets the "unit" field.
+func (u *ItemUpsertBulk) SetUnit(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateUnit() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (u *ItemUpsertBulk) ClearUnit() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearUnit()
+	})
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (u *ItemUpsertBulk) SetSizeQuantity(v float64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetSizeQuantity(v)
+	})
+}
+
+// AddSizeQuantity adds v to the "size_quantity" field.
+func (u *ItemUpsertBulk) AddSizeQuantity(v float64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddSizeQuantity(v)
+	})
+}
+
+// UpdateSizeQuantity sets the "size_quantity" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateSizeQuantity() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateSizeQuantity()
+	})
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (u *ItemUpsertBulk) ClearSizeQuantity() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearSizeQuantity()
+	})
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (u *ItemUpsertBulk) SetSizeUnit(v item.SizeUnit) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetSizeUnit(v)
+	})
+}
+
+// UpdateSizeUnit sets the "size_unit" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateSizeUnit() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateSizeUnit()
+	})
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (u *ItemUpsertBulk) ClearSizeUnit() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearSizeUnit()
+	})
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (u *ItemUpsertBulk) SetUnitPriceMicros(v int64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetUnitPriceMicros(v)
+	})
+}
+
+// AddUnitPriceMicros adds v to the "unit_price_micros" field.
+func (u *ItemUpsertBulk) AddUnitPriceMicros(v int64) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.AddUnitPriceMicros(v)
+	})
+}
+
+// UpdateUnitPriceMicros sets the "unit_price_micros" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateUnitPriceMicros() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateUnitPriceMicros()
+	})
+}
+
+// ClearUnitPriceMicros clears the value of the "unit_price_micros" field.
+func (u *ItemUpsertBulk) ClearUnitPriceMicros() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearUnitPriceMicros()
+	})
+}
+
+// SetProductID sets the "product_id" field.
+func (u *ItemUpsertBulk) SetProductID(v int) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetProductID(v)
+	})
+}
+
+// UpdateProductID sets the "product_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateProductID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateProductID()
+	})
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (u *ItemUpsertBulk) ClearProductID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearProductID()
+	})
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (u *ItemUpsertBulk) SetProductPinned(v bool) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetProductPinned(v)
+	})
+}
+
+// UpdateProductPinned sets the "product_pinned" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateProductPinned() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateProductPinned()
+	})
+}
+
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (u *ItemUpsertBulk) SetAttributesPinned(v bool) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAttributesPinned(v)
+	})
+}
+
+// UpdateAttributesPinned sets the "attributes_pinned" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateAttributesPinned() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAttributesPinned()
+	})
+}
+
+// SetAvailable sets the "available" field.
+func (u *ItemUpsertBulk) SetAvailable(v bool) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAvailable(v)
+	})
+}
+
+// UpdateAvailable sets the "available" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateAvailable() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAvailable()
+	})
+}
+
+// SetDelistedAt sets the "delisted_at" field.
+func (u *ItemUpsertBulk) SetDelistedAt(v time.Time) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetDelistedAt(v)
+	})
+}
+
+// UpdateDelistedAt sets the "delisted_at" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateDelistedAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateDelistedAt()
+	})
+}
+
+// ClearDelistedAt clears the value of the "delisted_at" field.
+func (u *ItemUpsertBulk) ClearDelistedAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearDelistedAt()
+	})
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (u *ItemUpsertBulk) SetLastSeenAt(v time.Time) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetLastSeenAt(v)
+	})
+}
+
+// UpdateLastSeenAt sets the "last_seen_at" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateLastSeenAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateLastSeenAt()
+	})
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (u *ItemUpsertBulk) ClearLastSeenAt() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearLastSeenAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ItemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ItemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ItemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -6,9 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/list"
 	"offgrocery-assessment/internal/ent/predicate"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/product"
 	"offgrocery-assessment/internal/ent/store"
 	"time"
 
@@ -64,24 +68,294 @@ func (_u *ItemUpdate) SetNillableBrand(v *string) *ItemUpdate {
 	return _u
 }
 
-// SetPrice sets the "price" field.
-func (_u *ItemUpdate) SetPrice(v float64) *ItemUpdate {
-	_u.mutation.ResetPrice()
-	_u.mutation.SetPrice(v)
+// SetPriceAmount sets the "price_amount" field.
+func (_u *ItemUpdate) SetPriceAmount(v int64) *ItemUpdate {
+	_u.mutation.ResetPriceAmount()
+	_u.mutation.SetPriceAmount(v)
 	return _u
 }
 
-// SetNillablePrice sets the "price" field if the given value is not nil.
-func (_u *ItemUpdate) SetNillablePrice(v *float64) *ItemUpdate {
+// SetNillablePriceAmount sets the "price_amount" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillablePriceAmount(v *int64) *ItemUpdate {
 	if v != nil {
-		_u.SetPrice(*v)
+		_u.SetPriceAmount(*v)
 	}
 	return _u
 }
 
-// AddPrice adds value to the "price" field.
-func (_u *ItemUpdate) AddPrice(v float64) *ItemUpdate {
-	_u.mutation.AddPrice(v)
+// AddPriceAmount adds value to the "price_amount" field.
+func (_u *ItemUpdate) AddPriceAmount(v int64) *ItemUpdate {
+	_u.mutation.AddPriceAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ItemUpdate) SetCurrency(v string) *ItemUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableCurrency(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ItemUpdate) SetExternalID(v string) *ItemUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableExternalID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *ItemUpdate) ClearExternalID() *ItemUpdate {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetAisle sets the "aisle" field.
+func (_u *ItemUpdate) SetAisle(v string) *ItemUpdate {
+	_u.mutation.SetAisle(v)
+	return _u
+}
+
+// SetNillableAisle sets the "aisle" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableAisle(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetAisle(*v)
+	}
+	return _u
+}
+
+// ClearAisle clears the value of the "aisle" field.
+func (_u *ItemUpdate) ClearAisle() *ItemUpdate {
+	_u.mutation.ClearAisle()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *ItemUpdate) SetCategoryID(v int) *ItemUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableCategoryID(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *ItemUpdate) ClearCategoryID() *ItemUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *ItemUpdate) SetUnit(v string) *ItemUpdate {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableUnit(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *ItemUpdate) ClearUnit() *ItemUpdate {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (_u *ItemUpdate) SetSizeQuantity(v float64) *ItemUpdate {
+	_u.mutation.ResetSizeQuantity()
+	_u.mutation.SetSizeQuantity(v)
+	return _u
+}
+
+// SetNillableSizeQuantity sets the "size_quantity" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableSizeQuantity(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetSizeQuantity(*v)
+	}
+	return _u
+}
+
+// AddSizeQuantity adds value to the "size_quantity" field.
+func (_u *ItemUpdate) AddSizeQuantity(v float64) *ItemUpdate {
+	_u.mutation.AddSizeQuantity(v)
+	return _u
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (_u *ItemUpdate) ClearSizeQuantity() *ItemUpdate {
+	_u.mutation.ClearSizeQuantity()
+	return _u
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (_u *ItemUpdate) SetSizeUnit(v item.SizeUnit) *ItemUpdate {
+	_u.mutation.SetSizeUnit(v)
+	return _u
+}
+
+// SetNillableSizeUnit sets the "size_unit" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableSizeUnit(v *item.SizeUnit) *ItemUpdate {
+	if v != nil {
+		_u.SetSizeUnit(*v)
+	}
+	return _u
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (_u *ItemUpdate) ClearSizeUnit() *ItemUpdate {
+	_u.mutation.ClearSizeUnit()
+	return _u
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (_u *ItemUpdate) SetUnitPriceMicros(v int64) *ItemUpdate {
+	_u.mutation.ResetUnitPriceMicros()
+	_u.mutation.SetUnitPriceMicros(v)
+	return _u
+}
+
+// SetNillableUnitPriceMicros sets the "unit_price_micros" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableUnitPriceMicros(v *int64) *ItemUpdate {
+	if v != nil {
+		_u.SetUnitPriceMicros(*v)
+	}
+	return _u
+}
+
+// AddUnitPriceMicros adds value to the "unit_price_micros" field.
+func (_u *ItemUpdate) AddUnitPriceMicros(v int64) *ItemUpdate {
+	_u.mutation.AddUnitPriceMicros(v)
+	return _u
+}
+
+// ClearUnitPriceMicros clears the value of the "unit_price_micros" field.
+func (_u *ItemUpdate) ClearUnitPriceMicros() *ItemUpdate {
+	_u.mutation.ClearUnitPriceMicros()
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *ItemUpdate) SetProductID(v int) *ItemUpdate {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableProductID(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (_u *ItemUpdate) ClearProductID() *ItemUpdate {
+	_u.mutation.ClearProductID()
+	return _u
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (_u *ItemUpdate) SetProductPinned(v bool) *ItemUpdate {
+	_u.mutation.SetProductPinned(v)
+	return _u
+}
+
+// SetNillableProductPinned sets the "product_pinned" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableProductPinned(v *bool) *ItemUpdate {
+	if v != nil {
+		_u.SetProductPinned(*v)
+	}
+	return _u
+}
+
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (_u *ItemUpdate) SetAttributesPinned(v bool) *ItemUpdate {
+	_u.mutation.SetAttributesPinned(v)
+	return _u
+}
+
+// SetNillableAttributesPinned sets the "attributes_pinned" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableAttributesPinned(v *bool) *ItemUpdate {
+	if v != nil {
+		_u.SetAttributesPinned(*v)
+	}
+	return _u
+}
+
+// SetAvailable sets the "available" field.
+func (_u *ItemUpdate) SetAvailable(v bool) *ItemUpdate {
+	_u.mutation.SetAvailable(v)
+	return _u
+}
+
+// SetNillableAvailable sets the "available" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableAvailable(v *bool) *ItemUpdate {
+	if v != nil {
+		_u.SetAvailable(*v)
+	}
+	return _u
+}
+
+// SetDelistedAt sets the "delisted_at" field.
+func (_u *ItemUpdate) SetDelistedAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetDelistedAt(v)
+	return _u
+}
+
+// SetNillableDelistedAt sets the "delisted_at" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableDelistedAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetDelistedAt(*v)
+	}
+	return _u
+}
+
+// ClearDelistedAt clears the value of the "delisted_at" field.
+func (_u *ItemUpdate) ClearDelistedAt() *ItemUpdate {
+	_u.mutation.ClearDelistedAt()
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ItemUpdate) SetLastSeenAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableLastSeenAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *ItemUpdate) ClearLastSeenAt() *ItemUpdate {
+	_u.mutation.ClearLastSeenAt()
 	return _u
 }
 
@@ -111,6 +385,46 @@ func (_u *ItemUpdate) AddLists(v ...*List) *ItemUpdate {
 	return _u.AddListIDs(ids...)
 }
 
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by IDs.
+func (_u *ItemUpdate) AddPriceObservationIDs(ids ...int) *ItemUpdate {
+	_u.mutation.AddPriceObservationIDs(ids...)
+	return _u
+}
+
+// AddPriceObservations adds the "price_observations" edges to the PriceObservation entity.
+func (_u *ItemUpdate) AddPriceObservations(v ...*PriceObservation) *ItemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceObservationIDs(ids...)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *ItemUpdate) SetCategory(v *Category) *ItemUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *ItemUpdate) SetProduct(v *Product) *ItemUpdate {
+	return _u.SetProductID(v.ID)
+}
+
+// AddAttributeIDs adds the "attributes" edge to the Attribute entity by IDs.
+func (_u *ItemUpdate) AddAttributeIDs(ids ...int) *ItemUpdate {
+	_u.mutation.AddAttributeIDs(ids...)
+	return _u
+}
+
+// AddAttributes adds the "attributes" edges to the Attribute entity.
+func (_u *ItemUpdate) AddAttributes(v ...*Attribute) *ItemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (_u *ItemUpdate) Mutation() *ItemMutation {
 	return _u.mutation
@@ -143,6 +457,60 @@ func (_u *ItemUpdate) RemoveLists(v ...*List) *ItemUpdate {
 	return _u.RemoveListIDs(ids...)
 }
 
+// ClearPriceObservations clears all "price_observations" edges to the PriceObservation entity.
+func (_u *ItemUpdate) ClearPriceObservations() *ItemUpdate {
+	_u.mutation.ClearPriceObservations()
+	return _u
+}
+
+// RemovePriceObservationIDs removes the "price_observations" edge to PriceObservation entities by IDs.
+func (_u *ItemUpdate) RemovePriceObservationIDs(ids ...int) *ItemUpdate {
+	_u.mutation.RemovePriceObservationIDs(ids...)
+	return _u
+}
+
+// RemovePriceObservations removes "price_observations" edges to PriceObservation entities.
+func (_u *ItemUpdate) RemovePriceObservations(v ...*PriceObservation) *ItemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceObservationIDs(ids...)
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *ItemUpdate) ClearCategory() *ItemUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *ItemUpdate) ClearProduct() *ItemUpdate {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearAttributes clears all "attributes" edges to the Attribute entity.
+func (_u *ItemUpdate) ClearAttributes() *ItemUpdate {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to Attribute entities by IDs.
+func (_u *ItemUpdate) RemoveAttributeIDs(ids ...int) *ItemUpdate {
+	_u.mutation.RemoveAttributeIDs(ids...)
+	return _u
+}
+
+// RemoveAttributes removes "attributes" edges to Attribute entities.
+func (_u *ItemUpdate) RemoveAttributes(v ...*Attribute) *ItemUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttributeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ItemUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -191,9 +559,19 @@ func (_u *ItemUpdate) check() error {
 			return &ValidationError{Name: "brand", err: fmt.Errorf(`ent: validator failed for field "Item.brand": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Price(); ok {
-		if err := item.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Item.price": %w`, err)}
+	if v, ok := _u.mutation.PriceAmount(); ok {
+		if err := item.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`ent: validator failed for field "Item.price_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeUnit(); ok {
+		if err := item.SizeUnitValidator(v); err != nil {
+			return &ValidationError{Name: "size_unit", err: fmt.Errorf(`ent: validator failed for field "Item.size_unit": %w`, err)}
 		}
 	}
 	if _u.mutation.StoreCleared() && len(_u.mutation.StoreIDs()) > 0 {
@@ -223,11 +601,77 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Brand(); ok {
 		_spec.SetField(item.FieldBrand, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Price(); ok {
-		_spec.SetField(item.FieldPrice, field.TypeFloat64, value)
+	if value, ok := _u.mutation.PriceAmount(); ok {
+		_spec.SetField(item.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceAmount(); ok {
+		_spec.AddField(item.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(item.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aisle(); ok {
+		_spec.SetField(item.FieldAisle, field.TypeString, value)
+	}
+	if _u.mutation.AisleCleared() {
+		_spec.ClearField(item.FieldAisle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(item.FieldUnit, field.TypeString, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(item.FieldUnit, field.TypeString)
 	}
-	if value, ok := _u.mutation.AddedPrice(); ok {
-		_spec.AddField(item.FieldPrice, field.TypeFloat64, value)
+	if value, ok := _u.mutation.SizeQuantity(); ok {
+		_spec.SetField(item.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeQuantity(); ok {
+		_spec.AddField(item.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.SizeQuantityCleared() {
+		_spec.ClearField(item.FieldSizeQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SizeUnit(); ok {
+		_spec.SetField(item.FieldSizeUnit, field.TypeEnum, value)
+	}
+	if _u.mutation.SizeUnitCleared() {
+		_spec.ClearField(item.FieldSizeUnit, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UnitPriceMicros(); ok {
+		_spec.SetField(item.FieldUnitPriceMicros, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPriceMicros(); ok {
+		_spec.AddField(item.FieldUnitPriceMicros, field.TypeInt64, value)
+	}
+	if _u.mutation.UnitPriceMicrosCleared() {
+		_spec.ClearField(item.FieldUnitPriceMicros, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ProductPinned(); ok {
+		_spec.SetField(item.FieldProductPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AttributesPinned(); ok {
+		_spec.SetField(item.FieldAttributesPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Available(); ok {
+		_spec.SetField(item.FieldAvailable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DelistedAt(); ok {
+		_spec.SetField(item.FieldDelistedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DelistedAtCleared() {
+		_spec.ClearField(item.FieldDelistedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(item.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(item.FieldLastSeenAt, field.TypeTime)
 	}
 	if _u.mutation.StoreCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -287,94 +731,512 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ListsIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   item.ListsTable,
-			Columns: item.ListsPrimaryKey,
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if nodes := _u.mutation.ListsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   item.ListsTable,
+			Columns: item.ListsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(list.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPriceObservationsIDs(); len(nodes) > 0 && !_u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PriceObservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.CategoryTable,
+			Columns: []string{item.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.CategoryTable,
+			Columns: []string{item.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ProductTable,
+			Columns: []string{item.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ProductTable,
+			Columns: []string{item.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !_u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{item.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ItemUpdateOne is the builder for updating a single Item entity.
+type ItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ItemUpdateOne) SetUpdateTime(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ItemUpdateOne) SetName(v string) *ItemUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableName(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBrand sets the "brand" field.
+func (_u *ItemUpdateOne) SetBrand(v string) *ItemUpdateOne {
+	_u.mutation.SetBrand(v)
+	return _u
+}
+
+// SetNillableBrand sets the "brand" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableBrand(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetBrand(*v)
+	}
+	return _u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_u *ItemUpdateOne) SetPriceAmount(v int64) *ItemUpdateOne {
+	_u.mutation.ResetPriceAmount()
+	_u.mutation.SetPriceAmount(v)
+	return _u
+}
+
+// SetNillablePriceAmount sets the "price_amount" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillablePriceAmount(v *int64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetPriceAmount(*v)
+	}
+	return _u
+}
+
+// AddPriceAmount adds value to the "price_amount" field.
+func (_u *ItemUpdateOne) AddPriceAmount(v int64) *ItemUpdateOne {
+	_u.mutation.AddPriceAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ItemUpdateOne) SetCurrency(v string) *ItemUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableCurrency(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ItemUpdateOne) SetExternalID(v string) *ItemUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableExternalID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
+	}
+	return _u
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (_u *ItemUpdateOne) ClearExternalID() *ItemUpdateOne {
+	_u.mutation.ClearExternalID()
+	return _u
+}
+
+// SetAisle sets the "aisle" field.
+func (_u *ItemUpdateOne) SetAisle(v string) *ItemUpdateOne {
+	_u.mutation.SetAisle(v)
+	return _u
+}
+
+// SetNillableAisle sets the "aisle" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableAisle(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetAisle(*v)
+	}
+	return _u
+}
+
+// ClearAisle clears the value of the "aisle" field.
+func (_u *ItemUpdateOne) ClearAisle() *ItemUpdateOne {
+	_u.mutation.ClearAisle()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *ItemUpdateOne) SetCategoryID(v int) *ItemUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableCategoryID(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *ItemUpdateOne) ClearCategoryID() *ItemUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *ItemUpdateOne) SetUnit(v string) *ItemUpdateOne {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableUnit(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *ItemUpdateOne) ClearUnit() *ItemUpdateOne {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (_u *ItemUpdateOne) SetSizeQuantity(v float64) *ItemUpdateOne {
+	_u.mutation.ResetSizeQuantity()
+	_u.mutation.SetSizeQuantity(v)
+	return _u
+}
+
+// SetNillableSizeQuantity sets the "size_quantity" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableSizeQuantity(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetSizeQuantity(*v)
+	}
+	return _u
+}
+
+// AddSizeQuantity adds value to the "size_quantity" field.
+func (_u *ItemUpdateOne) AddSizeQuantity(v float64) *ItemUpdateOne {
+	_u.mutation.AddSizeQuantity(v)
+	return _u
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (_u *ItemUpdateOne) ClearSizeQuantity() *ItemUpdateOne {
+	_u.mutation.ClearSizeQuantity()
+	return _u
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (_u *ItemUpdateOne) SetSizeUnit(v item.SizeUnit) *ItemUpdateOne {
+	_u.mutation.SetSizeUnit(v)
+	return _u
+}
+
+// SetNillableSizeUnit sets the "size_unit" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableSizeUnit(v *item.SizeUnit) *ItemUpdateOne {
+	if v != nil {
+		_u.SetSizeUnit(*v)
+	}
+	return _u
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (_u *ItemUpdateOne) ClearSizeUnit() *ItemUpdateOne {
+	_u.mutation.ClearSizeUnit()
+	return _u
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (_u *ItemUpdateOne) SetUnitPriceMicros(v int64) *ItemUpdateOne {
+	_u.mutation.ResetUnitPriceMicros()
+	_u.mutation.SetUnitPriceMicros(v)
+	return _u
+}
+
+// SetNillableUnitPriceMicros sets the "unit_price_micros" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableUnitPriceMicros(v *int64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetUnitPriceMicros(*v)
+	}
+	return _u
+}
+
+// AddUnitPriceMicros adds value to the "unit_price_micros" field.
+func (_u *ItemUpdateOne) AddUnitPriceMicros(v int64) *ItemUpdateOne {
+	_u.mutation.AddUnitPriceMicros(v)
+	return _u
+}
+
+// ClearUnitPriceMicros clears the value of the "unit_price_micros" field.
+func (_u *ItemUpdateOne) ClearUnitPriceMicros() *ItemUpdateOne {
+	_u.mutation.ClearUnitPriceMicros()
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *ItemUpdateOne) SetProductID(v int) *ItemUpdateOne {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableProductID(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{item.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (_u *ItemUpdateOne) ClearProductID() *ItemUpdateOne {
+	_u.mutation.ClearProductID()
+	return _u
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (_u *ItemUpdateOne) SetProductPinned(v bool) *ItemUpdateOne {
+	_u.mutation.SetProductPinned(v)
+	return _u
+}
+
+// SetNillableProductPinned sets the "product_pinned" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableProductPinned(v *bool) *ItemUpdateOne {
+	if v != nil {
+		_u.SetProductPinned(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// ItemUpdateOne is the builder for updating a single Item entity.
-type ItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (_u *ItemUpdateOne) SetAttributesPinned(v bool) *ItemUpdateOne {
+	_u.mutation.SetAttributesPinned(v)
+	return _u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (_u *ItemUpdateOne) SetUpdateTime(v time.Time) *ItemUpdateOne {
-	_u.mutation.SetUpdateTime(v)
+// SetNillableAttributesPinned sets the "attributes_pinned" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableAttributesPinned(v *bool) *ItemUpdateOne {
+	if v != nil {
+		_u.SetAttributesPinned(*v)
+	}
 	return _u
 }
 
-// SetName sets the "name" field.
-func (_u *ItemUpdateOne) SetName(v string) *ItemUpdateOne {
-	_u.mutation.SetName(v)
+// SetAvailable sets the "available" field.
+func (_u *ItemUpdateOne) SetAvailable(v bool) *ItemUpdateOne {
+	_u.mutation.SetAvailable(v)
 	return _u
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *ItemUpdateOne) SetNillableName(v *string) *ItemUpdateOne {
+// SetNillableAvailable sets the "available" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableAvailable(v *bool) *ItemUpdateOne {
 	if v != nil {
-		_u.SetName(*v)
+		_u.SetAvailable(*v)
 	}
 	return _u
 }
 
-// SetBrand sets the "brand" field.
-func (_u *ItemUpdateOne) SetBrand(v string) *ItemUpdateOne {
-	_u.mutation.SetBrand(v)
+// SetDelistedAt sets the "delisted_at" field.
+func (_u *ItemUpdateOne) SetDelistedAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetDelistedAt(v)
 	return _u
 }
 
-// SetNillableBrand sets the "brand" field if the given value is not nil.
-func (_u *ItemUpdateOne) SetNillableBrand(v *string) *ItemUpdateOne {
+// SetNillableDelistedAt sets the "delisted_at" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableDelistedAt(v *time.Time) *ItemUpdateOne {
 	if v != nil {
-		_u.SetBrand(*v)
+		_u.SetDelistedAt(*v)
 	}
 	return _u
 }
 
-// SetPrice sets the "price" field.
-func (_u *ItemUpdateOne) SetPrice(v float64) *ItemUpdateOne {
-	_u.mutation.ResetPrice()
-	_u.mutation.SetPrice(v)
+// ClearDelistedAt clears the value of the "delisted_at" field.
+func (_u *ItemUpdateOne) ClearDelistedAt() *ItemUpdateOne {
+	_u.mutation.ClearDelistedAt()
 	return _u
 }
 
-// SetNillablePrice sets the "price" field if the given value is not nil.
-func (_u *ItemUpdateOne) SetNillablePrice(v *float64) *ItemUpdateOne {
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *ItemUpdateOne) SetLastSeenAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableLastSeenAt(v *time.Time) *ItemUpdateOne {
 	if v != nil {
-		_u.SetPrice(*v)
+		_u.SetLastSeenAt(*v)
 	}
 	return _u
 }
 
-// AddPrice adds value to the "price" field.
-func (_u *ItemUpdateOne) AddPrice(v float64) *ItemUpdateOne {
-	_u.mutation.AddPrice(v)
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *ItemUpdateOne) ClearLastSeenAt() *ItemUpdateOne {
+	_u.mutation.ClearLastSeenAt()
 	return _u
 }
 
@@ -404,6 +1266,46 @@ func (_u *ItemUpdateOne) AddLists(v ...*List) *ItemUpdateOne {
 	return _u.AddListIDs(ids...)
 }
 
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by IDs.
+func (_u *ItemUpdateOne) AddPriceObservationIDs(ids ...int) *ItemUpdateOne {
+	_u.mutation.AddPriceObservationIDs(ids...)
+	return _u
+}
+
+// AddPriceObservations adds the "price_observations" edges to the PriceObservation entity.
+func (_u *ItemUpdateOne) AddPriceObservations(v ...*PriceObservation) *ItemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPriceObservationIDs(ids...)
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *ItemUpdateOne) SetCategory(v *Category) *ItemUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (_u *ItemUpdateOne) SetProduct(v *Product) *ItemUpdateOne {
+	return _u.SetProductID(v.ID)
+}
+
+// AddAttributeIDs adds the "attributes" edge to the Attribute entity by IDs.
+func (_u *ItemUpdateOne) AddAttributeIDs(ids ...int) *ItemUpdateOne {
+	_u.mutation.AddAttributeIDs(ids...)
+	return _u
+}
+
+// AddAttributes adds the "attributes" edges to the Attribute entity.
+func (_u *ItemUpdateOne) AddAttributes(v ...*Attribute) *ItemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (_u *ItemUpdateOne) Mutation() *ItemMutation {
 	return _u.mutation
@@ -436,6 +1338,60 @@ func (_u *ItemUpdateOne) RemoveLists(v ...*List) *ItemUpdateOne {
 	return _u.RemoveListIDs(ids...)
 }
 
+// ClearPriceObservations clears all "price_observations" edges to the PriceObservation entity.
+func (_u *ItemUpdateOne) ClearPriceObservations() *ItemUpdateOne {
+	_u.mutation.ClearPriceObservations()
+	return _u
+}
+
+// RemovePriceObservationIDs removes the "price_observations" edge to PriceObservation entities by IDs.
+func (_u *ItemUpdateOne) RemovePriceObservationIDs(ids ...int) *ItemUpdateOne {
+	_u.mutation.RemovePriceObservationIDs(ids...)
+	return _u
+}
+
+// RemovePriceObservations removes "price_observations" edges to PriceObservation entities.
+func (_u *ItemUpdateOne) RemovePriceObservations(v ...*PriceObservation) *ItemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePriceObservationIDs(ids...)
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *ItemUpdateOne) ClearCategory() *ItemUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (_u *ItemUpdateOne) ClearProduct() *ItemUpdateOne {
+	_u.mutation.ClearProduct()
+	return _u
+}
+
+// ClearAttributes clears all "attributes" edges to the Attribute entity.
+func (_u *ItemUpdateOne) ClearAttributes() *ItemUpdateOne {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to Attribute entities by IDs.
+func (_u *ItemUpdateOne) RemoveAttributeIDs(ids ...int) *ItemUpdateOne {
+	_u.mutation.RemoveAttributeIDs(ids...)
+	return _u
+}
+
+// RemoveAttributes removes "attributes" edges to Attribute entities.
+func (_u *ItemUpdateOne) RemoveAttributes(v ...*Attribute) *ItemUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttributeIDs(ids...)
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (_u *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	_u.mutation.Where(ps...)
@@ -497,9 +1453,19 @@ func (_u *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "brand", err: fmt.Errorf(`ent: validator failed for field "Item.brand": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Price(); ok {
-		if err := item.PriceValidator(v); err != nil {
-			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Item.price": %w`, err)}
+	if v, ok := _u.mutation.PriceAmount(); ok {
+		if err := item.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`ent: validator failed for field "Item.price_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := item.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Item.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeUnit(); ok {
+		if err := item.SizeUnitValidator(v); err != nil {
+			return &ValidationError{Name: "size_unit", err: fmt.Errorf(`ent: validator failed for field "Item.size_unit": %w`, err)}
 		}
 	}
 	if _u.mutation.StoreCleared() && len(_u.mutation.StoreIDs()) > 0 {
@@ -546,11 +1512,77 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if value, ok := _u.mutation.Brand(); ok {
 		_spec.SetField(item.FieldBrand, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Price(); ok {
-		_spec.SetField(item.FieldPrice, field.TypeFloat64, value)
+	if value, ok := _u.mutation.PriceAmount(); ok {
+		_spec.SetField(item.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceAmount(); ok {
+		_spec.AddField(item.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(item.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(item.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Aisle(); ok {
+		_spec.SetField(item.FieldAisle, field.TypeString, value)
+	}
+	if _u.mutation.AisleCleared() {
+		_spec.ClearField(item.FieldAisle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(item.FieldUnit, field.TypeString, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(item.FieldUnit, field.TypeString)
+	}
+	if value, ok := _u.mutation.SizeQuantity(); ok {
+		_spec.SetField(item.FieldSizeQuantity, field.TypeFloat64, value)
 	}
-	if value, ok := _u.mutation.AddedPrice(); ok {
-		_spec.AddField(item.FieldPrice, field.TypeFloat64, value)
+	if value, ok := _u.mutation.AddedSizeQuantity(); ok {
+		_spec.AddField(item.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.SizeQuantityCleared() {
+		_spec.ClearField(item.FieldSizeQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SizeUnit(); ok {
+		_spec.SetField(item.FieldSizeUnit, field.TypeEnum, value)
+	}
+	if _u.mutation.SizeUnitCleared() {
+		_spec.ClearField(item.FieldSizeUnit, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.UnitPriceMicros(); ok {
+		_spec.SetField(item.FieldUnitPriceMicros, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPriceMicros(); ok {
+		_spec.AddField(item.FieldUnitPriceMicros, field.TypeInt64, value)
+	}
+	if _u.mutation.UnitPriceMicrosCleared() {
+		_spec.ClearField(item.FieldUnitPriceMicros, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ProductPinned(); ok {
+		_spec.SetField(item.FieldProductPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AttributesPinned(); ok {
+		_spec.SetField(item.FieldAttributesPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Available(); ok {
+		_spec.SetField(item.FieldAvailable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DelistedAt(); ok {
+		_spec.SetField(item.FieldDelistedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DelistedAtCleared() {
+		_spec.ClearField(item.FieldDelistedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(item.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(item.FieldLastSeenAt, field.TypeTime)
 	}
 	if _u.mutation.StoreCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -626,6 +1658,154 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPriceObservationsIDs(); len(nodes) > 0 && !_u.mutation.PriceObservationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PriceObservationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.PriceObservationsTable,
+			Columns: []string{item.PriceObservationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.CategoryTable,
+			Columns: []string{item.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.CategoryTable,
+			Columns: []string{item.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ProductTable,
+			Columns: []string{item.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ProductTable,
+			Columns: []string{item.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !_u.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   item.AttributesTable,
+			Columns: item.AttributesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attribute.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Item{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,6 +9,159 @@ import (
 )
 
 var (
+	// AttributesColumns holds the columns for the "attributes" table.
+	AttributesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// AttributesTable holds the schema information for the "attributes" table.
+	AttributesTable = &schema.Table{
+		Name:       "attributes",
+		Columns:    AttributesColumns,
+		PrimaryKey: []*schema.Column{AttributesColumns[0]},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+	}
+	// CategoryMappingsColumns holds the columns for the "category_mappings" table.
+	CategoryMappingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "grocer", Type: field.TypeEnum, Enums: []string{"store_a", "store_b", "store_c"}},
+		{Name: "raw_value", Type: field.TypeString},
+		{Name: "category_mappings", Type: field.TypeInt},
+	}
+	// CategoryMappingsTable holds the schema information for the "category_mappings" table.
+	CategoryMappingsTable = &schema.Table{
+		Name:       "category_mappings",
+		Columns:    CategoryMappingsColumns,
+		PrimaryKey: []*schema.Column{CategoryMappingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "category_mappings_categories_mappings",
+				Columns:    []*schema.Column{CategoryMappingsColumns[3]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "categorymapping_grocer_raw_value",
+				Unique:  true,
+				Columns: []*schema.Column{CategoryMappingsColumns[1], CategoryMappingsColumns[2]},
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "base", Type: field.TypeString, Size: 3},
+		{Name: "currency", Type: field.TypeString, Size: 3},
+		{Name: "rate", Type: field.TypeFloat64},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchangerate_base_currency",
+				Unique:  true,
+				Columns: []*schema.Column{ExchangeRatesColumns[3], ExchangeRatesColumns[4]},
+			},
+		},
+	}
+	// ImportJobsColumns holds the columns for the "import_jobs" table.
+	ImportJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "file_path", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString, Nullable: true},
+		{Name: "force", Type: field.TypeBool, Default: false},
+		{Name: "max_errors", Type: field.TypeInt, Default: 10},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "running", "succeeded", "skipped", "failed"}, Default: "queued"},
+		{Name: "rows_total", Type: field.TypeInt, Default: 0},
+		{Name: "rows_processed", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "import_job_import_run", Type: field.TypeInt, Nullable: true},
+	}
+	// ImportJobsTable holds the schema information for the "import_jobs" table.
+	ImportJobsTable = &schema.Table{
+		Name:       "import_jobs",
+		Columns:    ImportJobsColumns,
+		PrimaryKey: []*schema.Column{ImportJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_jobs_import_runs_import_run",
+				Columns:    []*schema.Column{ImportJobsColumns[16]},
+				RefColumns: []*schema.Column{ImportRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{ImportJobsColumns[8]},
+			},
+		},
+	}
+	// ImportRunsColumns holds the columns for the "import_runs" table.
+	ImportRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "file_name", Type: field.TypeString},
+		{Name: "sha256", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed", "interrupted"}, Default: "running"},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_count", Type: field.TypeInt, Default: 0},
+		{Name: "updated_count", Type: field.TypeInt, Default: 0},
+		{Name: "delisted_count", Type: field.TypeInt, Default: 0},
+		{Name: "failed_count", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "rejections", Type: field.TypeJSON, Nullable: true},
+		{Name: "store_import_runs", Type: field.TypeInt, Nullable: true},
+	}
+	// ImportRunsTable holds the schema information for the "import_runs" table.
+	ImportRunsTable = &schema.Table{
+		Name:       "import_runs",
+		Columns:    ImportRunsColumns,
+		PrimaryKey: []*schema.Column{ImportRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "import_runs_stores_import_runs",
+				Columns:    []*schema.Column{ImportRunsColumns[13]},
+				RefColumns: []*schema.Column{StoresColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "importrun_sha256_status",
+				Unique:  false,
+				Columns: []*schema.Column{ImportRunsColumns[2], ImportRunsColumns[4]},
+			},
+		},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -16,7 +169,21 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString},
 		{Name: "brand", Type: field.TypeString},
-		{Name: "price", Type: field.TypeFloat64},
+		{Name: "price_amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "CAD"},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "aisle", Type: field.TypeString, Nullable: true},
+		{Name: "unit", Type: field.TypeString, Nullable: true},
+		{Name: "size_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "size_unit", Type: field.TypeEnum, Nullable: true, Enums: []string{"g", "ml", "count"}},
+		{Name: "unit_price_micros", Type: field.TypeInt64, Nullable: true},
+		{Name: "product_pinned", Type: field.TypeBool, Default: false},
+		{Name: "attributes_pinned", Type: field.TypeBool, Default: false},
+		{Name: "available", Type: field.TypeBool, Default: true},
+		{Name: "delisted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "product_id", Type: field.TypeInt, Nullable: true},
 		{Name: "store_items", Type: field.TypeInt},
 	}
 	// ItemsTable holds the schema information for the "items" table.
@@ -25,9 +192,21 @@ var (
 		Columns:    ItemsColumns,
 		PrimaryKey: []*schema.Column{ItemsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "items_categories_items",
+				Columns:    []*schema.Column{ItemsColumns[18]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "items_products_items",
+				Columns:    []*schema.Column{ItemsColumns[19]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "items_stores_items",
-				Columns:    []*schema.Column{ItemsColumns[6]},
+				Columns:    []*schema.Column{ItemsColumns[20]},
 				RefColumns: []*schema.Column{StoresColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -41,6 +220,11 @@ var (
 					Type: "FULLTEXT",
 				},
 			},
+			{
+				Name:    "item_external_id_store_items",
+				Unique:  true,
+				Columns: []*schema.Column{ItemsColumns[7], ItemsColumns[20]},
+			},
 		},
 	}
 	// ListsColumns holds the columns for the "lists" table.
@@ -65,6 +249,66 @@ var (
 			},
 		},
 	}
+	// PriceObservationsColumns holds the columns for the "price_observations" table.
+	PriceObservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "price_amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "CAD"},
+		{Name: "observed_at", Type: field.TypeTime},
+		{Name: "import_run_price_observations", Type: field.TypeInt, Nullable: true},
+		{Name: "item_price_observations", Type: field.TypeInt},
+	}
+	// PriceObservationsTable holds the schema information for the "price_observations" table.
+	PriceObservationsTable = &schema.Table{
+		Name:       "price_observations",
+		Columns:    PriceObservationsColumns,
+		PrimaryKey: []*schema.Column{PriceObservationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "price_observations_import_runs_price_observations",
+				Columns:    []*schema.Column{PriceObservationsColumns[4]},
+				RefColumns: []*schema.Column{ImportRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "price_observations_items_price_observations",
+				Columns:    []*schema.Column{PriceObservationsColumns[5]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "priceobservation_observed_at_item_price_observations",
+				Unique:  false,
+				Columns: []*schema.Column{PriceObservationsColumns[3], PriceObservationsColumns[5]},
+			},
+		},
+	}
+	// ProductsColumns holds the columns for the "products" table.
+	ProductsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "brand", Type: field.TypeString},
+		{Name: "size_quantity", Type: field.TypeFloat64, Nullable: true},
+		{Name: "size_unit", Type: field.TypeEnum, Nullable: true, Enums: []string{"g", "ml", "count"}},
+		{Name: "match_key", Type: field.TypeString, Nullable: true},
+	}
+	// ProductsTable holds the schema information for the "products" table.
+	ProductsTable = &schema.Table{
+		Name:       "products",
+		Columns:    ProductsColumns,
+		PrimaryKey: []*schema.Column{ProductsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "product_match_key",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[7]},
+			},
+		},
+	}
 	// StoresColumns holds the columns for the "stores" table.
 	StoresColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -84,6 +328,7 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "display_currency", Type: field.TypeString, Nullable: true, Size: 3},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -91,6 +336,31 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// ItemAttributesColumns holds the columns for the "item_attributes" table.
+	ItemAttributesColumns = []*schema.Column{
+		{Name: "item_id", Type: field.TypeInt},
+		{Name: "attribute_id", Type: field.TypeInt},
+	}
+	// ItemAttributesTable holds the schema information for the "item_attributes" table.
+	ItemAttributesTable = &schema.Table{
+		Name:       "item_attributes",
+		Columns:    ItemAttributesColumns,
+		PrimaryKey: []*schema.Column{ItemAttributesColumns[0], ItemAttributesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_attributes_item_id",
+				Columns:    []*schema.Column{ItemAttributesColumns[0]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_attributes_attribute_id",
+				Columns:    []*schema.Column{ItemAttributesColumns[1]},
+				RefColumns: []*schema.Column{AttributesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// ListItemsColumns holds the columns for the "list_items" table.
 	ListItemsColumns = []*schema.Column{
 		{Name: "list_id", Type: field.TypeInt},
@@ -118,17 +388,35 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AttributesTable,
+		CategoriesTable,
+		CategoryMappingsTable,
+		ExchangeRatesTable,
+		ImportJobsTable,
+		ImportRunsTable,
 		ItemsTable,
 		ListsTable,
+		PriceObservationsTable,
+		ProductsTable,
 		StoresTable,
 		UsersTable,
+		ItemAttributesTable,
 		ListItemsTable,
 	}
 )
 
 func init() {
-	ItemsTable.ForeignKeys[0].RefTable = StoresTable
+	CategoryMappingsTable.ForeignKeys[0].RefTable = CategoriesTable
+	ImportJobsTable.ForeignKeys[0].RefTable = ImportRunsTable
+	ImportRunsTable.ForeignKeys[0].RefTable = StoresTable
+	ItemsTable.ForeignKeys[0].RefTable = CategoriesTable
+	ItemsTable.ForeignKeys[1].RefTable = ProductsTable
+	ItemsTable.ForeignKeys[2].RefTable = StoresTable
 	ListsTable.ForeignKeys[0].RefTable = UsersTable
+	PriceObservationsTable.ForeignKeys[0].RefTable = ImportRunsTable
+	PriceObservationsTable.ForeignKeys[1].RefTable = ItemsTable
+	ItemAttributesTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemAttributesTable.ForeignKeys[1].RefTable = AttributesTable
 	ListItemsTable.ForeignKeys[0].RefTable = ListsTable
 	ListItemsTable.ForeignKeys[1].RefTable = ItemsTable
 }
@@ -6,9 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/ent/exchangerate"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/list"
 	"offgrocery-assessment/internal/ent/predicate"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/product"
+	"offgrocery-assessment/internal/ent/schema"
 	"offgrocery-assessment/internal/ent/store"
 	"offgrocery-assessment/internal/ent/user"
 	"sync"
@@ -27,46 +36,7367 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeItem  = "Item"
-	TypeList  = "List"
-	TypeStore = "Store"
-	TypeUser  = "User"
+	TypeAttribute        = "Attribute"
+	TypeCategory         = "Category"
+	TypeCategoryMapping  = "CategoryMapping"
+	TypeExchangeRate     = "ExchangeRate"
+	TypeImportJob        = "ImportJob"
+	TypeImportRun        = "ImportRun"
+	TypeItem             = "Item"
+	TypeList             = "List"
+	TypePriceObservation = "PriceObservation"
+	TypeProduct          = "Product"
+	TypeStore            = "Store"
+	TypeUser             = "User"
 )
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// AttributeMutation represents an operation that mutates the Attribute nodes in the graph.
+type AttributeMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
+	slug          *string
 	name          *string
-	brand         *string
-	price         *float64
-	addprice      *float64
 	clearedFields map[string]struct{}
-	store         *int
-	clearedstore  bool
-	lists         map[int]struct{}
-	removedlists  map[int]struct{}
-	clearedlists  bool
+	items         map[int]struct{}
+	removeditems  map[int]struct{}
+	cleareditems  bool
 	done          bool
-	oldValue      func(context.Context) (*Item, error)
-	predicates    []predicate.Item
+	oldValue      func(context.Context) (*Attribute, error)
+	predicates    []predicate.Attribute
 }
 
-var _ ent.Mutation = (*ItemMutation)(nil)
+var _ ent.Mutation = (*AttributeMutation)(nil)
 
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
+// attributeOption allows management of the mutation configuration using functional options.
+type attributeOption func(*AttributeMutation)
+
+// newAttributeMutation creates new mutation for the Attribute entity.
+func newAttributeMutation(c config, op Op, opts ...attributeOption) *AttributeMutation {
+	m := &AttributeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAttribute,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAttributeID sets the ID field of the mutation.
+func withAttributeID(id int) attributeOption {
+	return func(m *AttributeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Attribute
+		)
+		m.oldValue = func(ctx context.Context) (*Attribute, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Attribute.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAttribute sets the old Attribute of the mutation.
+func withAttribute(node *Attribute) attributeOption {
+	return func(m *AttributeMutation) {
+		m.oldValue = func(context.Context) (*Attribute, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AttributeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AttributeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AttributeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AttributeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Attribute.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *AttributeMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *AttributeMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Attribute entity.
+// If the Attribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *AttributeMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *AttributeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AttributeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Attribute entity.
+// If the Attribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AttributeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *AttributeMutation) ResetName() {
+	m.name = nil
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *AttributeMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *AttributeMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *AttributeMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *AttributeMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *AttributeMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *AttributeMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *AttributeMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the AttributeMutation builder.
+func (m *AttributeMutation) Where(ps ...predicate.Attribute) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AttributeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AttributeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Attribute, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AttributeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AttributeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Attribute).
+func (m *AttributeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AttributeMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.slug != nil {
+		fields = append(fields, attribute.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, attribute.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AttributeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case attribute.FieldSlug:
+		return m.Slug()
+	case attribute.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AttributeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case attribute.FieldSlug:
+		return m.OldSlug(ctx)
+	case attribute.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Attribute field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttributeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case attribute.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case attribute.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Attribute field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AttributeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AttributeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AttributeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Attribute numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AttributeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AttributeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AttributeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Attribute nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AttributeMutation) ResetField(name string) error {
+	switch name {
+	case attribute.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case attribute.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Attribute field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AttributeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.items != nil {
+		edges = append(edges, attribute.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AttributeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case attribute.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AttributeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeditems != nil {
+		edges = append(edges, attribute.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AttributeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case attribute.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AttributeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditems {
+		edges = append(edges, attribute.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AttributeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case attribute.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AttributeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Attribute unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AttributeMutation) ResetEdge(name string) error {
+	switch name {
+	case attribute.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Attribute edge %s", name)
+}
+
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	slug            *string
+	name            *string
+	clearedFields   map[string]struct{}
+	items           map[int]struct{}
+	removeditems    map[int]struct{}
+	cleareditems    bool
+	mappings        map[int]struct{}
+	removedmappings map[int]struct{}
+	clearedmappings bool
+	done            bool
+	oldValue        func(context.Context) (*Category, error)
+	predicates      []predicate.Category
+}
+
+var _ ent.Mutation = (*CategoryMutation)(nil)
+
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
+
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id int) categoryOption {
+	return func(m *CategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Category
+		)
+		m.oldValue = func(ctx context.Context) (*Category, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Category.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *CategoryMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *CategoryMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *CategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryMutation) ResetName() {
+	m.name = nil
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *CategoryMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *CategoryMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *CategoryMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *CategoryMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *CategoryMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *CategoryMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *CategoryMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// AddMappingIDs adds the "mappings" edge to the CategoryMapping entity by ids.
+func (m *CategoryMutation) AddMappingIDs(ids ...int) {
+	if m.mappings == nil {
+		m.mappings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.mappings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMappings clears the "mappings" edge to the CategoryMapping entity.
+func (m *CategoryMutation) ClearMappings() {
+	m.clearedmappings = true
+}
+
+// MappingsCleared reports if the "mappings" edge to the CategoryMapping entity was cleared.
+func (m *CategoryMutation) MappingsCleared() bool {
+	return m.clearedmappings
+}
+
+// RemoveMappingIDs removes the "mappings" edge to the CategoryMapping entity by IDs.
+func (m *CategoryMutation) RemoveMappingIDs(ids ...int) {
+	if m.removedmappings == nil {
+		m.removedmappings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.mappings, ids[i])
+		m.removedmappings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMappings returns the removed IDs of the "mappings" edge to the CategoryMapping entity.
+func (m *CategoryMutation) RemovedMappingsIDs() (ids []int) {
+	for id := range m.removedmappings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MappingsIDs returns the "mappings" edge IDs in the mutation.
+func (m *CategoryMutation) MappingsIDs() (ids []int) {
+	for id := range m.mappings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMappings resets all changes to the "mappings" edge.
+func (m *CategoryMutation) ResetMappings() {
+	m.mappings = nil
+	m.clearedmappings = false
+	m.removedmappings = nil
+}
+
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.slug != nil {
+		fields = append(fields, category.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case category.FieldSlug:
+		return m.Slug()
+	case category.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case category.FieldSlug:
+		return m.OldSlug(ctx)
+	case category.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Category field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case category.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case category.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Category nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMutation) ResetField(name string) error {
+	switch name {
+	case category.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case category.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.items != nil {
+		edges = append(edges, category.EdgeItems)
+	}
+	if m.mappings != nil {
+		edges = append(edges, category.EdgeMappings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeMappings:
+		ids := make([]ent.Value, 0, len(m.mappings))
+		for id := range m.mappings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, category.EdgeItems)
+	}
+	if m.removedmappings != nil {
+		edges = append(edges, category.EdgeMappings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeMappings:
+		ids := make([]ent.Value, 0, len(m.removedmappings))
+		for id := range m.removedmappings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditems {
+		edges = append(edges, category.EdgeItems)
+	}
+	if m.clearedmappings {
+		edges = append(edges, category.EdgeMappings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case category.EdgeItems:
+		return m.cleareditems
+	case category.EdgeMappings:
+		return m.clearedmappings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case category.EdgeItems:
+		m.ResetItems()
+		return nil
+	case category.EdgeMappings:
+		m.ResetMappings()
+		return nil
+	}
+	return fmt.Errorf("unknown Category edge %s", name)
+}
+
+// CategoryMappingMutation represents an operation that mutates the CategoryMapping nodes in the graph.
+type CategoryMappingMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	grocer          *categorymapping.Grocer
+	raw_value       *string
+	clearedFields   map[string]struct{}
+	category        *int
+	clearedcategory bool
+	done            bool
+	oldValue        func(context.Context) (*CategoryMapping, error)
+	predicates      []predicate.CategoryMapping
+}
+
+var _ ent.Mutation = (*CategoryMappingMutation)(nil)
+
+// categorymappingOption allows management of the mutation configuration using functional options.
+type categorymappingOption func(*CategoryMappingMutation)
+
+// newCategoryMappingMutation creates new mutation for the CategoryMapping entity.
+func newCategoryMappingMutation(c config, op Op, opts ...categorymappingOption) *CategoryMappingMutation {
+	m := &CategoryMappingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategoryMapping,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryMappingID sets the ID field of the mutation.
+func withCategoryMappingID(id int) categorymappingOption {
+	return func(m *CategoryMappingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CategoryMapping
+		)
+		m.oldValue = func(ctx context.Context) (*CategoryMapping, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CategoryMapping.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategoryMapping sets the old CategoryMapping of the mutation.
+func withCategoryMapping(node *CategoryMapping) categorymappingOption {
+	return func(m *CategoryMappingMutation) {
+		m.oldValue = func(context.Context) (*CategoryMapping, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryMappingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryMappingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryMappingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryMappingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CategoryMapping.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGrocer sets the "grocer" field.
+func (m *CategoryMappingMutation) SetGrocer(c categorymapping.Grocer) {
+	m.grocer = &c
+}
+
+// Grocer returns the value of the "grocer" field in the mutation.
+func (m *CategoryMappingMutation) Grocer() (r categorymapping.Grocer, exists bool) {
+	v := m.grocer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrocer returns the old "grocer" field's value of the CategoryMapping entity.
+// If the CategoryMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMappingMutation) OldGrocer(ctx context.Context) (v categorymapping.Grocer, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrocer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrocer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrocer: %w", err)
+	}
+	return oldValue.Grocer, nil
+}
+
+// ResetGrocer resets all changes to the "grocer" field.
+func (m *CategoryMappingMutation) ResetGrocer() {
+	m.grocer = nil
+}
+
+// SetRawValue sets the "raw_value" field.
+func (m *CategoryMappingMutation) SetRawValue(s string) {
+	m.raw_value = &s
+}
+
+// RawValue returns the value of the "raw_value" field in the mutation.
+func (m *CategoryMappingMutation) RawValue() (r string, exists bool) {
+	v := m.raw_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawValue returns the old "raw_value" field's value of the CategoryMapping entity.
+// If the CategoryMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMappingMutation) OldRawValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawValue: %w", err)
+	}
+	return oldValue.RawValue, nil
+}
+
+// ResetRawValue resets all changes to the "raw_value" field.
+func (m *CategoryMappingMutation) ResetRawValue() {
+	m.raw_value = nil
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by id.
+func (m *CategoryMappingMutation) SetCategoryID(id int) {
+	m.category = &id
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *CategoryMappingMutation) ClearCategory() {
+	m.clearedcategory = true
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *CategoryMappingMutation) CategoryCleared() bool {
+	return m.clearedcategory
+}
+
+// CategoryID returns the "category" edge ID in the mutation.
+func (m *CategoryMappingMutation) CategoryID() (id int, exists bool) {
+	if m.category != nil {
+		return *m.category, true
+	}
+	return
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *CategoryMappingMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *CategoryMappingMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// Where appends a list predicates to the CategoryMappingMutation builder.
+func (m *CategoryMappingMutation) Where(ps ...predicate.CategoryMapping) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryMappingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMappingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CategoryMapping, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryMappingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryMappingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CategoryMapping).
+func (m *CategoryMappingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryMappingMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.grocer != nil {
+		fields = append(fields, categorymapping.FieldGrocer)
+	}
+	if m.raw_value != nil {
+		fields = append(fields, categorymapping.FieldRawValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMappingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case categorymapping.FieldGrocer:
+		return m.Grocer()
+	case categorymapping.FieldRawValue:
+		return m.RawValue()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMappingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case categorymapping.FieldGrocer:
+		return m.OldGrocer(ctx)
+	case categorymapping.FieldRawValue:
+		return m.OldRawValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown CategoryMapping field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMappingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case categorymapping.FieldGrocer:
+		v, ok := value.(categorymapping.Grocer)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrocer(v)
+		return nil
+	case categorymapping.FieldRawValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryMapping field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMappingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMappingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMappingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CategoryMapping numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMappingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMappingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMappingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CategoryMapping nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMappingMutation) ResetField(name string) error {
+	switch name {
+	case categorymapping.FieldGrocer:
+		m.ResetGrocer()
+		return nil
+	case categorymapping.FieldRawValue:
+		m.ResetRawValue()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryMapping field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMappingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.category != nil {
+		edges = append(edges, categorymapping.EdgeCategory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMappingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case categorymapping.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMappingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMappingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMappingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcategory {
+		edges = append(edges, categorymapping.EdgeCategory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMappingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case categorymapping.EdgeCategory:
+		return m.clearedcategory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMappingMutation) ClearEdge(name string) error {
+	switch name {
+	case categorymapping.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryMapping unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMappingMutation) ResetEdge(name string) error {
+	switch name {
+	case categorymapping.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown CategoryMapping edge %s", name)
+}
+
+// ExchangeRateMutation represents an operation that mutates the ExchangeRate nodes in the graph.
+type ExchangeRateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	base          *string
+	currency      *string
+	rate          *float64
+	addrate       *float64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExchangeRate, error)
+	predicates    []predicate.ExchangeRate
+}
+
+var _ ent.Mutation = (*ExchangeRateMutation)(nil)
+
+// exchangerateOption allows management of the mutation configuration using functional options.
+type exchangerateOption func(*ExchangeRateMutation)
+
+// newExchangeRateMutation creates new mutation for the ExchangeRate entity.
+func newExchangeRateMutation(c config, op Op, opts ...exchangerateOption) *ExchangeRateMutation {
+	m := &ExchangeRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExchangeRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExchangeRateID sets the ID field of the mutation.
+func withExchangeRateID(id int) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExchangeRate
+		)
+		m.oldValue = func(ctx context.Context) (*ExchangeRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExchangeRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExchangeRate sets the old ExchangeRate of the mutation.
+func withExchangeRate(node *ExchangeRate) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		m.oldValue = func(context.Context) (*ExchangeRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExchangeRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExchangeRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExchangeRateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExchangeRateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExchangeRate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ExchangeRateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ExchangeRateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ExchangeRateMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ExchangeRateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ExchangeRateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ExchangeRateMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetBase sets the "base" field.
+func (m *ExchangeRateMutation) SetBase(s string) {
+	m.base = &s
+}
+
+// Base returns the value of the "base" field in the mutation.
+func (m *ExchangeRateMutation) Base() (r string, exists bool) {
+	v := m.base
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBase returns the old "base" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldBase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBase: %w", err)
+	}
+	return oldValue.Base, nil
+}
+
+// ResetBase resets all changes to the "base" field.
+func (m *ExchangeRateMutation) ResetBase() {
+	m.base = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *ExchangeRateMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ExchangeRateMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ExchangeRateMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *ExchangeRateMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *ExchangeRateMutation) Rate() (r float64, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds f to the "rate" field.
+func (m *ExchangeRateMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *ExchangeRateMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *ExchangeRateMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// Where appends a list predicates to the ExchangeRateMutation builder.
+func (m *ExchangeRateMutation) Where(ps ...predicate.ExchangeRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExchangeRateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExchangeRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExchangeRate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExchangeRateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExchangeRateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExchangeRate).
+func (m *ExchangeRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExchangeRateMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, exchangerate.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, exchangerate.FieldUpdateTime)
+	}
+	if m.base != nil {
+		fields = append(fields, exchangerate.FieldBase)
+	}
+	if m.currency != nil {
+		fields = append(fields, exchangerate.FieldCurrency)
+	}
+	if m.rate != nil {
+		fields = append(fields, exchangerate.FieldRate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExchangeRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		return m.CreateTime()
+	case exchangerate.FieldUpdateTime:
+		return m.UpdateTime()
+	case exchangerate.FieldBase:
+		return m.Base()
+	case exchangerate.FieldCurrency:
+		return m.Currency()
+	case exchangerate.FieldRate:
+		return m.Rate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExchangeRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case exchangerate.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case exchangerate.FieldBase:
+		return m.OldBase(ctx)
+	case exchangerate.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case exchangerate.FieldRate:
+		return m.OldRate(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case exchangerate.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case exchangerate.FieldBase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBase(v)
+		return nil
+	case exchangerate.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case exchangerate.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExchangeRateMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, exchangerate.FieldRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExchangeRateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldRate:
+		return m.AddedRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExchangeRateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExchangeRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExchangeRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ResetField(name string) error {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case exchangerate.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case exchangerate.FieldBase:
+		m.ResetBase()
+		return nil
+	case exchangerate.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case exchangerate.FieldRate:
+		m.ResetRate()
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExchangeRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExchangeRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExchangeRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExchangeRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExchangeRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExchangeRateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExchangeRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExchangeRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate edge %s", name)
+}
+
+// ImportJobMutation represents an operation that mutates the ImportJob nodes in the graph.
+type ImportJobMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	create_time       *time.Time
+	update_time       *time.Time
+	file_name         *string
+	file_path         *string
+	format            *string
+	force             *bool
+	max_errors        *int
+	addmax_errors     *int
+	status            *importjob.Status
+	rows_total        *int
+	addrows_total     *int
+	rows_processed    *int
+	addrows_processed *int
+	error             *string
+	started_at        *time.Time
+	finished_at       *time.Time
+	owner             *string
+	lease_expires_at  *time.Time
+	clearedFields     map[string]struct{}
+	import_run        *int
+	clearedimport_run bool
+	done              bool
+	oldValue          func(context.Context) (*ImportJob, error)
+	predicates        []predicate.ImportJob
+}
+
+var _ ent.Mutation = (*ImportJobMutation)(nil)
+
+// importjobOption allows management of the mutation configuration using functional options.
+type importjobOption func(*ImportJobMutation)
+
+// newImportJobMutation creates new mutation for the ImportJob entity.
+func newImportJobMutation(c config, op Op, opts ...importjobOption) *ImportJobMutation {
+	m := &ImportJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportJobID sets the ID field of the mutation.
+func withImportJobID(id int) importjobOption {
+	return func(m *ImportJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportJob
+		)
+		m.oldValue = func(ctx context.Context) (*ImportJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportJob sets the old ImportJob of the mutation.
+func withImportJob(node *ImportJob) importjobOption {
+	return func(m *ImportJobMutation) {
+		m.oldValue = func(context.Context) (*ImportJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ImportJobMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ImportJobMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ImportJobMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ImportJobMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ImportJobMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ImportJobMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetFileName sets the "file_name" field.
+func (m *ImportJobMutation) SetFileName(s string) {
+	m.file_name = &s
+}
+
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *ImportJobMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileName returns the old "file_name" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
+}
+
+// ResetFileName resets all changes to the "file_name" field.
+func (m *ImportJobMutation) ResetFileName() {
+	m.file_name = nil
+}
+
+// SetFilePath sets the "file_path" field.
+func (m *ImportJobMutation) SetFilePath(s string) {
+	m.file_path = &s
+}
+
+// FilePath returns the value of the "file_path" field in the mutation.
+func (m *ImportJobMutation) FilePath() (r string, exists bool) {
+	v := m.file_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilePath returns the old "file_path" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFilePath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilePath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilePath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilePath: %w", err)
+	}
+	return oldValue.FilePath, nil
+}
+
+// ResetFilePath resets all changes to the "file_path" field.
+func (m *ImportJobMutation) ResetFilePath() {
+	m.file_path = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *ImportJobMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ImportJobMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ClearFormat clears the value of the "format" field.
+func (m *ImportJobMutation) ClearFormat() {
+	m.format = nil
+	m.clearedFields[importjob.FieldFormat] = struct{}{}
+}
+
+// FormatCleared returns if the "format" field was cleared in this mutation.
+func (m *ImportJobMutation) FormatCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldFormat]
+	return ok
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *ImportJobMutation) ResetFormat() {
+	m.format = nil
+	delete(m.clearedFields, importjob.FieldFormat)
+}
+
+// SetForce sets the "force" field.
+func (m *ImportJobMutation) SetForce(b bool) {
+	m.force = &b
+}
+
+// Force returns the value of the "force" field in the mutation.
+func (m *ImportJobMutation) Force() (r bool, exists bool) {
+	v := m.force
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForce returns the old "force" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldForce(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForce: %w", err)
+	}
+	return oldValue.Force, nil
+}
+
+// ResetForce resets all changes to the "force" field.
+func (m *ImportJobMutation) ResetForce() {
+	m.force = nil
+}
+
+// SetMaxErrors sets the "max_errors" field.
+func (m *ImportJobMutation) SetMaxErrors(i int) {
+	m.max_errors = &i
+	m.addmax_errors = nil
+}
+
+// MaxErrors returns the value of the "max_errors" field in the mutation.
+func (m *ImportJobMutation) MaxErrors() (r int, exists bool) {
+	v := m.max_errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxErrors returns the old "max_errors" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldMaxErrors(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxErrors: %w", err)
+	}
+	return oldValue.MaxErrors, nil
+}
+
+// AddMaxErrors adds i to the "max_errors" field.
+func (m *ImportJobMutation) AddMaxErrors(i int) {
+	if m.addmax_errors != nil {
+		*m.addmax_errors += i
+	} else {
+		m.addmax_errors = &i
+	}
+}
+
+// AddedMaxErrors returns the value that was added to the "max_errors" field in this mutation.
+func (m *ImportJobMutation) AddedMaxErrors() (r int, exists bool) {
+	v := m.addmax_errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxErrors resets all changes to the "max_errors" field.
+func (m *ImportJobMutation) ResetMaxErrors() {
+	m.max_errors = nil
+	m.addmax_errors = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ImportJobMutation) SetStatus(i importjob.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportJobMutation) Status() (r importjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldStatus(ctx context.Context) (v importjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRowsTotal sets the "rows_total" field.
+func (m *ImportJobMutation) SetRowsTotal(i int) {
+	m.rows_total = &i
+	m.addrows_total = nil
+}
+
+// RowsTotal returns the value of the "rows_total" field in the mutation.
+func (m *ImportJobMutation) RowsTotal() (r int, exists bool) {
+	v := m.rows_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRowsTotal returns the old "rows_total" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldRowsTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRowsTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRowsTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRowsTotal: %w", err)
+	}
+	return oldValue.RowsTotal, nil
+}
+
+// AddRowsTotal adds i to the "rows_total" field.
+func (m *ImportJobMutation) AddRowsTotal(i int) {
+	if m.addrows_total != nil {
+		*m.addrows_total += i
+	} else {
+		m.addrows_total = &i
+	}
+}
+
+// AddedRowsTotal returns the value that was added to the "rows_total" field in this mutation.
+func (m *ImportJobMutation) AddedRowsTotal() (r int, exists bool) {
+	v := m.addrows_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRowsTotal resets all changes to the "rows_total" field.
+func (m *ImportJobMutation) ResetRowsTotal() {
+	m.rows_total = nil
+	m.addrows_total = nil
+}
+
+// SetRowsProcessed sets the "rows_processed" field.
+func (m *ImportJobMutation) SetRowsProcessed(i int) {
+	m.rows_processed = &i
+	m.addrows_processed = nil
+}
+
+// RowsProcessed returns the value of the "rows_processed" field in the mutation.
+func (m *ImportJobMutation) RowsProcessed() (r int, exists bool) {
+	v := m.rows_processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRowsProcessed returns the old "rows_processed" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldRowsProcessed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRowsProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRowsProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRowsProcessed: %w", err)
+	}
+	return oldValue.RowsProcessed, nil
+}
+
+// AddRowsProcessed adds i to the "rows_processed" field.
+func (m *ImportJobMutation) AddRowsProcessed(i int) {
+	if m.addrows_processed != nil {
+		*m.addrows_processed += i
+	} else {
+		m.addrows_processed = &i
+	}
+}
+
+// AddedRowsProcessed returns the value that was added to the "rows_processed" field in this mutation.
+func (m *ImportJobMutation) AddedRowsProcessed() (r int, exists bool) {
+	v := m.addrows_processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRowsProcessed resets all changes to the "rows_processed" field.
+func (m *ImportJobMutation) ResetRowsProcessed() {
+	m.rows_processed = nil
+	m.addrows_processed = nil
+}
+
+// SetError sets the "error" field.
+func (m *ImportJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ImportJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ImportJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[importjob.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ImportJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ImportJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, importjob.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ImportJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ImportJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *ImportJobMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[importjob.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *ImportJobMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ImportJobMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, importjob.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ImportJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ImportJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ImportJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[importjob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ImportJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ImportJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, importjob.FieldFinishedAt)
+}
+
+// SetOwner sets the "owner" field.
+func (m *ImportJobMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *ImportJobMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *ImportJobMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[importjob.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *ImportJobMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *ImportJobMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, importjob.FieldOwner)
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *ImportJobMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *ImportJobMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the ImportJob entity.
+// If the ImportJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportJobMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *ImportJobMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[importjob.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *ImportJobMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[importjob.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *ImportJobMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, importjob.FieldLeaseExpiresAt)
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by id.
+func (m *ImportJobMutation) SetImportRunID(id int) {
+	m.import_run = &id
+}
+
+// ClearImportRun clears the "import_run" edge to the ImportRun entity.
+func (m *ImportJobMutation) ClearImportRun() {
+	m.clearedimport_run = true
+}
+
+// ImportRunCleared reports if the "import_run" edge to the ImportRun entity was cleared.
+func (m *ImportJobMutation) ImportRunCleared() bool {
+	return m.clearedimport_run
+}
+
+// ImportRunID returns the "import_run" edge ID in the mutation.
+func (m *ImportJobMutation) ImportRunID() (id int, exists bool) {
+	if m.import_run != nil {
+		return *m.import_run, true
+	}
+	return
+}
+
+// ImportRunIDs returns the "import_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ImportRunID instead. It exists only for internal usage by the builders.
+func (m *ImportJobMutation) ImportRunIDs() (ids []int) {
+	if id := m.import_run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetImportRun resets all changes to the "import_run" edge.
+func (m *ImportJobMutation) ResetImportRun() {
+	m.import_run = nil
+	m.clearedimport_run = false
+}
+
+// Where appends a list predicates to the ImportJobMutation builder.
+func (m *ImportJobMutation) Where(ps ...predicate.ImportJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportJob).
+func (m *ImportJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportJobMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, importjob.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, importjob.FieldUpdateTime)
+	}
+	if m.file_name != nil {
+		fields = append(fields, importjob.FieldFileName)
+	}
+	if m.file_path != nil {
+		fields = append(fields, importjob.FieldFilePath)
+	}
+	if m.format != nil {
+		fields = append(fields, importjob.FieldFormat)
+	}
+	if m.force != nil {
+		fields = append(fields, importjob.FieldForce)
+	}
+	if m.max_errors != nil {
+		fields = append(fields, importjob.FieldMaxErrors)
+	}
+	if m.status != nil {
+		fields = append(fields, importjob.FieldStatus)
+	}
+	if m.rows_total != nil {
+		fields = append(fields, importjob.FieldRowsTotal)
+	}
+	if m.rows_processed != nil {
+		fields = append(fields, importjob.FieldRowsProcessed)
+	}
+	if m.error != nil {
+		fields = append(fields, importjob.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, importjob.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	if m.owner != nil {
+		fields = append(fields, importjob.FieldOwner)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, importjob.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldCreateTime:
+		return m.CreateTime()
+	case importjob.FieldUpdateTime:
+		return m.UpdateTime()
+	case importjob.FieldFileName:
+		return m.FileName()
+	case importjob.FieldFilePath:
+		return m.FilePath()
+	case importjob.FieldFormat:
+		return m.Format()
+	case importjob.FieldForce:
+		return m.Force()
+	case importjob.FieldMaxErrors:
+		return m.MaxErrors()
+	case importjob.FieldStatus:
+		return m.Status()
+	case importjob.FieldRowsTotal:
+		return m.RowsTotal()
+	case importjob.FieldRowsProcessed:
+		return m.RowsProcessed()
+	case importjob.FieldError:
+		return m.Error()
+	case importjob.FieldStartedAt:
+		return m.StartedAt()
+	case importjob.FieldFinishedAt:
+		return m.FinishedAt()
+	case importjob.FieldOwner:
+		return m.Owner()
+	case importjob.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importjob.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case importjob.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case importjob.FieldFileName:
+		return m.OldFileName(ctx)
+	case importjob.FieldFilePath:
+		return m.OldFilePath(ctx)
+	case importjob.FieldFormat:
+		return m.OldFormat(ctx)
+	case importjob.FieldForce:
+		return m.OldForce(ctx)
+	case importjob.FieldMaxErrors:
+		return m.OldMaxErrors(ctx)
+	case importjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case importjob.FieldRowsTotal:
+		return m.OldRowsTotal(ctx)
+	case importjob.FieldRowsProcessed:
+		return m.OldRowsProcessed(ctx)
+	case importjob.FieldError:
+		return m.OldError(ctx)
+	case importjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case importjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case importjob.FieldOwner:
+		return m.OldOwner(ctx)
+	case importjob.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case importjob.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case importjob.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case importjob.FieldFilePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilePath(v)
+		return nil
+	case importjob.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case importjob.FieldForce:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForce(v)
+		return nil
+	case importjob.FieldMaxErrors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxErrors(v)
+		return nil
+	case importjob.FieldStatus:
+		v, ok := value.(importjob.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importjob.FieldRowsTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRowsTotal(v)
+		return nil
+	case importjob.FieldRowsProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRowsProcessed(v)
+		return nil
+	case importjob.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case importjob.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case importjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case importjob.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case importjob.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_errors != nil {
+		fields = append(fields, importjob.FieldMaxErrors)
+	}
+	if m.addrows_total != nil {
+		fields = append(fields, importjob.FieldRowsTotal)
+	}
+	if m.addrows_processed != nil {
+		fields = append(fields, importjob.FieldRowsProcessed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case importjob.FieldMaxErrors:
+		return m.AddedMaxErrors()
+	case importjob.FieldRowsTotal:
+		return m.AddedRowsTotal()
+	case importjob.FieldRowsProcessed:
+		return m.AddedRowsProcessed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case importjob.FieldMaxErrors:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxErrors(v)
+		return nil
+	case importjob.FieldRowsTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRowsTotal(v)
+		return nil
+	case importjob.FieldRowsProcessed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRowsProcessed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importjob.FieldFormat) {
+		fields = append(fields, importjob.FieldFormat)
+	}
+	if m.FieldCleared(importjob.FieldError) {
+		fields = append(fields, importjob.FieldError)
+	}
+	if m.FieldCleared(importjob.FieldStartedAt) {
+		fields = append(fields, importjob.FieldStartedAt)
+	}
+	if m.FieldCleared(importjob.FieldFinishedAt) {
+		fields = append(fields, importjob.FieldFinishedAt)
+	}
+	if m.FieldCleared(importjob.FieldOwner) {
+		fields = append(fields, importjob.FieldOwner)
+	}
+	if m.FieldCleared(importjob.FieldLeaseExpiresAt) {
+		fields = append(fields, importjob.FieldLeaseExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportJobMutation) ClearField(name string) error {
+	switch name {
+	case importjob.FieldFormat:
+		m.ClearFormat()
+		return nil
+	case importjob.FieldError:
+		m.ClearError()
+		return nil
+	case importjob.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case importjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case importjob.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case importjob.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportJobMutation) ResetField(name string) error {
+	switch name {
+	case importjob.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case importjob.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case importjob.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case importjob.FieldFilePath:
+		m.ResetFilePath()
+		return nil
+	case importjob.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case importjob.FieldForce:
+		m.ResetForce()
+		return nil
+	case importjob.FieldMaxErrors:
+		m.ResetMaxErrors()
+		return nil
+	case importjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importjob.FieldRowsTotal:
+		m.ResetRowsTotal()
+		return nil
+	case importjob.FieldRowsProcessed:
+		m.ResetRowsProcessed()
+		return nil
+	case importjob.FieldError:
+		m.ResetError()
+		return nil
+	case importjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case importjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case importjob.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case importjob.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.import_run != nil {
+		edges = append(edges, importjob.EdgeImportRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importjob.EdgeImportRun:
+		if id := m.import_run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedimport_run {
+		edges = append(edges, importjob.EdgeImportRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importjob.EdgeImportRun:
+		return m.clearedimport_run
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportJobMutation) ClearEdge(name string) error {
+	switch name {
+	case importjob.EdgeImportRun:
+		m.ClearImportRun()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportJobMutation) ResetEdge(name string) error {
+	switch name {
+	case importjob.EdgeImportRun:
+		m.ResetImportRun()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportJob edge %s", name)
+}
+
+// ImportRunMutation represents an operation that mutates the ImportRun nodes in the graph.
+type ImportRunMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	file_name                 *string
+	sha256                    *string
+	format                    *string
+	status                    *importrun.Status
+	started_at                *time.Time
+	finished_at               *time.Time
+	created_count             *int
+	addcreated_count          *int
+	updated_count             *int
+	addupdated_count          *int
+	delisted_count            *int
+	adddelisted_count         *int
+	failed_count              *int
+	addfailed_count           *int
+	error                     *string
+	rejections                *[]schema.Rejection
+	appendrejections          []schema.Rejection
+	clearedFields             map[string]struct{}
+	store                     *int
+	clearedstore              bool
+	price_observations        map[int]struct{}
+	removedprice_observations map[int]struct{}
+	clearedprice_observations bool
+	done                      bool
+	oldValue                  func(context.Context) (*ImportRun, error)
+	predicates                []predicate.ImportRun
+}
+
+var _ ent.Mutation = (*ImportRunMutation)(nil)
+
+// importrunOption allows management of the mutation configuration using functional options.
+type importrunOption func(*ImportRunMutation)
+
+// newImportRunMutation creates new mutation for the ImportRun entity.
+func newImportRunMutation(c config, op Op, opts ...importrunOption) *ImportRunMutation {
+	m := &ImportRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImportRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImportRunID sets the ID field of the mutation.
+func withImportRunID(id int) importrunOption {
+	return func(m *ImportRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImportRun
+		)
+		m.oldValue = func(ctx context.Context) (*ImportRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImportRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImportRun sets the old ImportRun of the mutation.
+func withImportRun(node *ImportRun) importrunOption {
+	return func(m *ImportRunMutation) {
+		m.oldValue = func(context.Context) (*ImportRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImportRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImportRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImportRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImportRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImportRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFileName sets the "file_name" field.
+func (m *ImportRunMutation) SetFileName(s string) {
+	m.file_name = &s
+}
+
+// FileName returns the value of the "file_name" field in the mutation.
+func (m *ImportRunMutation) FileName() (r string, exists bool) {
+	v := m.file_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileName returns the old "file_name" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldFileName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileName: %w", err)
+	}
+	return oldValue.FileName, nil
+}
+
+// ResetFileName resets all changes to the "file_name" field.
+func (m *ImportRunMutation) ResetFileName() {
+	m.file_name = nil
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *ImportRunMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *ImportRunMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *ImportRunMutation) ResetSha256() {
+	m.sha256 = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *ImportRunMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ImportRunMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ClearFormat clears the value of the "format" field.
+func (m *ImportRunMutation) ClearFormat() {
+	m.format = nil
+	m.clearedFields[importrun.FieldFormat] = struct{}{}
+}
+
+// FormatCleared returns if the "format" field was cleared in this mutation.
+func (m *ImportRunMutation) FormatCleared() bool {
+	_, ok := m.clearedFields[importrun.FieldFormat]
+	return ok
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *ImportRunMutation) ResetFormat() {
+	m.format = nil
+	delete(m.clearedFields, importrun.FieldFormat)
+}
+
+// SetStatus sets the "status" field.
+func (m *ImportRunMutation) SetStatus(i importrun.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ImportRunMutation) Status() (r importrun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldStatus(ctx context.Context) (v importrun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ImportRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ImportRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ImportRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ImportRunMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ImportRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ImportRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ImportRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[importrun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ImportRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[importrun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ImportRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, importrun.FieldFinishedAt)
+}
+
+// SetCreatedCount sets the "created_count" field.
+func (m *ImportRunMutation) SetCreatedCount(i int) {
+	m.created_count = &i
+	m.addcreated_count = nil
+}
+
+// CreatedCount returns the value of the "created_count" field in the mutation.
+func (m *ImportRunMutation) CreatedCount() (r int, exists bool) {
+	v := m.created_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedCount returns the old "created_count" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldCreatedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedCount: %w", err)
+	}
+	return oldValue.CreatedCount, nil
+}
+
+// AddCreatedCount adds i to the "created_count" field.
+func (m *ImportRunMutation) AddCreatedCount(i int) {
+	if m.addcreated_count != nil {
+		*m.addcreated_count += i
+	} else {
+		m.addcreated_count = &i
+	}
+}
+
+// AddedCreatedCount returns the value that was added to the "created_count" field in this mutation.
+func (m *ImportRunMutation) AddedCreatedCount() (r int, exists bool) {
+	v := m.addcreated_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedCount resets all changes to the "created_count" field.
+func (m *ImportRunMutation) ResetCreatedCount() {
+	m.created_count = nil
+	m.addcreated_count = nil
+}
+
+// SetUpdatedCount sets the "updated_count" field.
+func (m *ImportRunMutation) SetUpdatedCount(i int) {
+	m.updated_count = &i
+	m.addupdated_count = nil
+}
+
+// UpdatedCount returns the value of the "updated_count" field in the mutation.
+func (m *ImportRunMutation) UpdatedCount() (r int, exists bool) {
+	v := m.updated_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedCount returns the old "updated_count" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldUpdatedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedCount: %w", err)
+	}
+	return oldValue.UpdatedCount, nil
+}
+
+// AddUpdatedCount adds i to the "updated_count" field.
+func (m *ImportRunMutation) AddUpdatedCount(i int) {
+	if m.addupdated_count != nil {
+		*m.addupdated_count += i
+	} else {
+		m.addupdated_count = &i
+	}
+}
+
+// AddedUpdatedCount returns the value that was added to the "updated_count" field in this mutation.
+func (m *ImportRunMutation) AddedUpdatedCount() (r int, exists bool) {
+	v := m.addupdated_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedCount resets all changes to the "updated_count" field.
+func (m *ImportRunMutation) ResetUpdatedCount() {
+	m.updated_count = nil
+	m.addupdated_count = nil
+}
+
+// SetDelistedCount sets the "delisted_count" field.
+func (m *ImportRunMutation) SetDelistedCount(i int) {
+	m.delisted_count = &i
+	m.adddelisted_count = nil
+}
+
+// DelistedCount returns the value of the "delisted_count" field in the mutation.
+func (m *ImportRunMutation) DelistedCount() (r int, exists bool) {
+	v := m.delisted_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDelistedCount returns the old "delisted_count" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldDelistedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDelistedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDelistedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDelistedCount: %w", err)
+	}
+	return oldValue.DelistedCount, nil
+}
+
+// AddDelistedCount adds i to the "delisted_count" field.
+func (m *ImportRunMutation) AddDelistedCount(i int) {
+	if m.adddelisted_count != nil {
+		*m.adddelisted_count += i
+	} else {
+		m.adddelisted_count = &i
+	}
+}
+
+// AddedDelistedCount returns the value that was added to the "delisted_count" field in this mutation.
+func (m *ImportRunMutation) AddedDelistedCount() (r int, exists bool) {
+	v := m.adddelisted_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDelistedCount resets all changes to the "delisted_count" field.
+func (m *ImportRunMutation) ResetDelistedCount() {
+	m.delisted_count = nil
+	m.adddelisted_count = nil
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (m *ImportRunMutation) SetFailedCount(i int) {
+	m.failed_count = &i
+	m.addfailed_count = nil
+}
+
+// FailedCount returns the value of the "failed_count" field in the mutation.
+func (m *ImportRunMutation) FailedCount() (r int, exists bool) {
+	v := m.failed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedCount returns the old "failed_count" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldFailedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedCount: %w", err)
+	}
+	return oldValue.FailedCount, nil
+}
+
+// AddFailedCount adds i to the "failed_count" field.
+func (m *ImportRunMutation) AddFailedCount(i int) {
+	if m.addfailed_count != nil {
+		*m.addfailed_count += i
+	} else {
+		m.addfailed_count = &i
+	}
+}
+
+// AddedFailedCount returns the value that was added to the "failed_count" field in this mutation.
+func (m *ImportRunMutation) AddedFailedCount() (r int, exists bool) {
+	v := m.addfailed_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedCount resets all changes to the "failed_count" field.
+func (m *ImportRunMutation) ResetFailedCount() {
+	m.failed_count = nil
+	m.addfailed_count = nil
+}
+
+// SetError sets the "error" field.
+func (m *ImportRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ImportRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ImportRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[importrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ImportRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[importrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ImportRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, importrun.FieldError)
+}
+
+// SetRejections sets the "rejections" field.
+func (m *ImportRunMutation) SetRejections(s []schema.Rejection) {
+	m.rejections = &s
+	m.appendrejections = nil
+}
+
+// Rejections returns the value of the "rejections" field in the mutation.
+func (m *ImportRunMutation) Rejections() (r []schema.Rejection, exists bool) {
+	v := m.rejections
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRejections returns the old "rejections" field's value of the ImportRun entity.
+// If the ImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImportRunMutation) OldRejections(ctx context.Context) (v []schema.Rejection, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRejections is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRejections requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRejections: %w", err)
+	}
+	return oldValue.Rejections, nil
+}
+
+// AppendRejections adds s to the "rejections" field.
+func (m *ImportRunMutation) AppendRejections(s []schema.Rejection) {
+	m.appendrejections = append(m.appendrejections, s...)
+}
+
+// AppendedRejections returns the list of values that were appended to the "rejections" field in this mutation.
+func (m *ImportRunMutation) AppendedRejections() ([]schema.Rejection, bool) {
+	if len(m.appendrejections) == 0 {
+		return nil, false
+	}
+	return m.appendrejections, true
+}
+
+// ClearRejections clears the value of the "rejections" field.
+func (m *ImportRunMutation) ClearRejections() {
+	m.rejections = nil
+	m.appendrejections = nil
+	m.clearedFields[importrun.FieldRejections] = struct{}{}
+}
+
+// RejectionsCleared returns if the "rejections" field was cleared in this mutation.
+func (m *ImportRunMutation) RejectionsCleared() bool {
+	_, ok := m.clearedFields[importrun.FieldRejections]
+	return ok
+}
+
+// ResetRejections resets all changes to the "rejections" field.
+func (m *ImportRunMutation) ResetRejections() {
+	m.rejections = nil
+	m.appendrejections = nil
+	delete(m.clearedFields, importrun.FieldRejections)
+}
+
+// SetStoreID sets the "store" edge to the Store entity by id.
+func (m *ImportRunMutation) SetStoreID(id int) {
+	m.store = &id
+}
+
+// ClearStore clears the "store" edge to the Store entity.
+func (m *ImportRunMutation) ClearStore() {
+	m.clearedstore = true
+}
+
+// StoreCleared reports if the "store" edge to the Store entity was cleared.
+func (m *ImportRunMutation) StoreCleared() bool {
+	return m.clearedstore
+}
+
+// StoreID returns the "store" edge ID in the mutation.
+func (m *ImportRunMutation) StoreID() (id int, exists bool) {
+	if m.store != nil {
+		return *m.store, true
+	}
+	return
+}
+
+// StoreIDs returns the "store" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StoreID instead. It exists only for internal usage by the builders.
+func (m *ImportRunMutation) StoreIDs() (ids []int) {
+	if id := m.store; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStore resets all changes to the "store" edge.
+func (m *ImportRunMutation) ResetStore() {
+	m.store = nil
+	m.clearedstore = false
+}
+
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by ids.
+func (m *ImportRunMutation) AddPriceObservationIDs(ids ...int) {
+	if m.price_observations == nil {
+		m.price_observations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.price_observations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPriceObservations clears the "price_observations" edge to the PriceObservation entity.
+func (m *ImportRunMutation) ClearPriceObservations() {
+	m.clearedprice_observations = true
+}
+
+// PriceObservationsCleared reports if the "price_observations" edge to the PriceObservation entity was cleared.
+func (m *ImportRunMutation) PriceObservationsCleared() bool {
+	return m.clearedprice_observations
+}
+
+// RemovePriceObservationIDs removes the "price_observations" edge to the PriceObservation entity by IDs.
+func (m *ImportRunMutation) RemovePriceObservationIDs(ids ...int) {
+	if m.removedprice_observations == nil {
+		m.removedprice_observations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.price_observations, ids[i])
+		m.removedprice_observations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPriceObservations returns the removed IDs of the "price_observations" edge to the PriceObservation entity.
+func (m *ImportRunMutation) RemovedPriceObservationsIDs() (ids []int) {
+	for id := range m.removedprice_observations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PriceObservationsIDs returns the "price_observations" edge IDs in the mutation.
+func (m *ImportRunMutation) PriceObservationsIDs() (ids []int) {
+	for id := range m.price_observations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPriceObservations resets all changes to the "price_observations" edge.
+func (m *ImportRunMutation) ResetPriceObservations() {
+	m.price_observations = nil
+	m.clearedprice_observations = false
+	m.removedprice_observations = nil
+}
+
+// Where appends a list predicates to the ImportRunMutation builder.
+func (m *ImportRunMutation) Where(ps ...predicate.ImportRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImportRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImportRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImportRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImportRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImportRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImportRun).
+func (m *ImportRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImportRunMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.file_name != nil {
+		fields = append(fields, importrun.FieldFileName)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, importrun.FieldSha256)
+	}
+	if m.format != nil {
+		fields = append(fields, importrun.FieldFormat)
+	}
+	if m.status != nil {
+		fields = append(fields, importrun.FieldStatus)
+	}
+	if m.started_at != nil {
+		fields = append(fields, importrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, importrun.FieldFinishedAt)
+	}
+	if m.created_count != nil {
+		fields = append(fields, importrun.FieldCreatedCount)
+	}
+	if m.updated_count != nil {
+		fields = append(fields, importrun.FieldUpdatedCount)
+	}
+	if m.delisted_count != nil {
+		fields = append(fields, importrun.FieldDelistedCount)
+	}
+	if m.failed_count != nil {
+		fields = append(fields, importrun.FieldFailedCount)
+	}
+	if m.error != nil {
+		fields = append(fields, importrun.FieldError)
+	}
+	if m.rejections != nil {
+		fields = append(fields, importrun.FieldRejections)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImportRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case importrun.FieldFileName:
+		return m.FileName()
+	case importrun.FieldSha256:
+		return m.Sha256()
+	case importrun.FieldFormat:
+		return m.Format()
+	case importrun.FieldStatus:
+		return m.Status()
+	case importrun.FieldStartedAt:
+		return m.StartedAt()
+	case importrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case importrun.FieldCreatedCount:
+		return m.CreatedCount()
+	case importrun.FieldUpdatedCount:
+		return m.UpdatedCount()
+	case importrun.FieldDelistedCount:
+		return m.DelistedCount()
+	case importrun.FieldFailedCount:
+		return m.FailedCount()
+	case importrun.FieldError:
+		return m.Error()
+	case importrun.FieldRejections:
+		return m.Rejections()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImportRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case importrun.FieldFileName:
+		return m.OldFileName(ctx)
+	case importrun.FieldSha256:
+		return m.OldSha256(ctx)
+	case importrun.FieldFormat:
+		return m.OldFormat(ctx)
+	case importrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case importrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case importrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case importrun.FieldCreatedCount:
+		return m.OldCreatedCount(ctx)
+	case importrun.FieldUpdatedCount:
+		return m.OldUpdatedCount(ctx)
+	case importrun.FieldDelistedCount:
+		return m.OldDelistedCount(ctx)
+	case importrun.FieldFailedCount:
+		return m.OldFailedCount(ctx)
+	case importrun.FieldError:
+		return m.OldError(ctx)
+	case importrun.FieldRejections:
+		return m.OldRejections(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImportRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case importrun.FieldFileName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileName(v)
+		return nil
+	case importrun.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
+	case importrun.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case importrun.FieldStatus:
+		v, ok := value.(importrun.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case importrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case importrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case importrun.FieldCreatedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedCount(v)
+		return nil
+	case importrun.FieldUpdatedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedCount(v)
+		return nil
+	case importrun.FieldDelistedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelistedCount(v)
+		return nil
+	case importrun.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedCount(v)
+		return nil
+	case importrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case importrun.FieldRejections:
+		v, ok := value.([]schema.Rejection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRejections(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImportRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addcreated_count != nil {
+		fields = append(fields, importrun.FieldCreatedCount)
+	}
+	if m.addupdated_count != nil {
+		fields = append(fields, importrun.FieldUpdatedCount)
+	}
+	if m.adddelisted_count != nil {
+		fields = append(fields, importrun.FieldDelistedCount)
+	}
+	if m.addfailed_count != nil {
+		fields = append(fields, importrun.FieldFailedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImportRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case importrun.FieldCreatedCount:
+		return m.AddedCreatedCount()
+	case importrun.FieldUpdatedCount:
+		return m.AddedUpdatedCount()
+	case importrun.FieldDelistedCount:
+		return m.AddedDelistedCount()
+	case importrun.FieldFailedCount:
+		return m.AddedFailedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImportRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case importrun.FieldCreatedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedCount(v)
+		return nil
+	case importrun.FieldUpdatedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUpdatedCount(v)
+		return nil
+	case importrun.FieldDelistedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDelistedCount(v)
+		return nil
+	case importrun.FieldFailedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImportRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImportRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(importrun.FieldFormat) {
+		fields = append(fields, importrun.FieldFormat)
+	}
+	if m.FieldCleared(importrun.FieldFinishedAt) {
+		fields = append(fields, importrun.FieldFinishedAt)
+	}
+	if m.FieldCleared(importrun.FieldError) {
+		fields = append(fields, importrun.FieldError)
+	}
+	if m.FieldCleared(importrun.FieldRejections) {
+		fields = append(fields, importrun.FieldRejections)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImportRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImportRunMutation) ClearField(name string) error {
+	switch name {
+	case importrun.FieldFormat:
+		m.ClearFormat()
+		return nil
+	case importrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case importrun.FieldError:
+		m.ClearError()
+		return nil
+	case importrun.FieldRejections:
+		m.ClearRejections()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImportRunMutation) ResetField(name string) error {
+	switch name {
+	case importrun.FieldFileName:
+		m.ResetFileName()
+		return nil
+	case importrun.FieldSha256:
+		m.ResetSha256()
+		return nil
+	case importrun.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case importrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case importrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case importrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case importrun.FieldCreatedCount:
+		m.ResetCreatedCount()
+		return nil
+	case importrun.FieldUpdatedCount:
+		m.ResetUpdatedCount()
+		return nil
+	case importrun.FieldDelistedCount:
+		m.ResetDelistedCount()
+		return nil
+	case importrun.FieldFailedCount:
+		m.ResetFailedCount()
+		return nil
+	case importrun.FieldError:
+		m.ResetError()
+		return nil
+	case importrun.FieldRejections:
+		m.ResetRejections()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImportRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.store != nil {
+		edges = append(edges, importrun.EdgeStore)
+	}
+	if m.price_observations != nil {
+		edges = append(edges, importrun.EdgePriceObservations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImportRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case importrun.EdgeStore:
+		if id := m.store; id != nil {
+			return []ent.Value{*id}
+		}
+	case importrun.EdgePriceObservations:
+		ids := make([]ent.Value, 0, len(m.price_observations))
+		for id := range m.price_observations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImportRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedprice_observations != nil {
+		edges = append(edges, importrun.EdgePriceObservations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImportRunMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case importrun.EdgePriceObservations:
+		ids := make([]ent.Value, 0, len(m.removedprice_observations))
+		for id := range m.removedprice_observations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImportRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedstore {
+		edges = append(edges, importrun.EdgeStore)
+	}
+	if m.clearedprice_observations {
+		edges = append(edges, importrun.EdgePriceObservations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImportRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case importrun.EdgeStore:
+		return m.clearedstore
+	case importrun.EdgePriceObservations:
+		return m.clearedprice_observations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImportRunMutation) ClearEdge(name string) error {
+	switch name {
+	case importrun.EdgeStore:
+		m.ClearStore()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImportRunMutation) ResetEdge(name string) error {
+	switch name {
+	case importrun.EdgeStore:
+		m.ResetStore()
+		return nil
+	case importrun.EdgePriceObservations:
+		m.ResetPriceObservations()
+		return nil
+	}
+	return fmt.Errorf("unknown ImportRun edge %s", name)
+}
+
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	create_time               *time.Time
+	update_time               *time.Time
+	name                      *string
+	brand                     *string
+	price_amount              *int64
+	addprice_amount           *int64
+	currency                  *string
+	external_id               *string
+	aisle                     *string
+	unit                      *string
+	size_quantity             *float64
+	addsize_quantity          *float64
+	size_unit                 *item.SizeUnit
+	unit_price_micros         *int64
+	addunit_price_micros      *int64
+	product_pinned            *bool
+	attributes_pinned         *bool
+	available                 *bool
+	delisted_at               *time.Time
+	last_seen_at              *time.Time
+	clearedFields             map[string]struct{}
+	store                     *int
+	clearedstore              bool
+	lists                     map[int]struct{}
+	removedlists              map[int]struct{}
+	clearedlists              bool
+	price_observations        map[int]struct{}
+	removedprice_observations map[int]struct{}
+	clearedprice_observations bool
+	category                  *int
+	clearedcategory           bool
+	product                   *int
+	clearedproduct            bool
+	attributes                map[int]struct{}
+	removedattributes         map[int]struct{}
+	clearedattributes         bool
+	done                      bool
+	oldValue                  func(context.Context) (*Item, error)
+	predicates                []predicate.Item
+}
+
+var _ ent.Mutation = (*ItemMutation)(nil)
+
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
+
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemID sets the ID field of the mutation.
+func withItemID(id int) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ItemMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ItemMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ItemMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ItemMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ItemMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ItemMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ItemMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ItemMutation) ResetName() {
+	m.name = nil
+}
+
+// SetBrand sets the "brand" field.
+func (m *ItemMutation) SetBrand(s string) {
+	m.brand = &s
+}
+
+// Brand returns the value of the "brand" field in the mutation.
+func (m *ItemMutation) Brand() (r string, exists bool) {
+	v := m.brand
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrand returns the old "brand" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldBrand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+	}
+	return oldValue.Brand, nil
+}
+
+// ResetBrand resets all changes to the "brand" field.
+func (m *ItemMutation) ResetBrand() {
+	m.brand = nil
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (m *ItemMutation) SetPriceAmount(i int64) {
+	m.price_amount = &i
+	m.addprice_amount = nil
+}
+
+// PriceAmount returns the value of the "price_amount" field in the mutation.
+func (m *ItemMutation) PriceAmount() (r int64, exists bool) {
+	v := m.price_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceAmount returns the old "price_amount" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldPriceAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceAmount: %w", err)
+	}
+	return oldValue.PriceAmount, nil
+}
+
+// AddPriceAmount adds i to the "price_amount" field.
+func (m *ItemMutation) AddPriceAmount(i int64) {
+	if m.addprice_amount != nil {
+		*m.addprice_amount += i
+	} else {
+		m.addprice_amount = &i
+	}
+}
+
+// AddedPriceAmount returns the value that was added to the "price_amount" field in this mutation.
+func (m *ItemMutation) AddedPriceAmount() (r int64, exists bool) {
+	v := m.addprice_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriceAmount resets all changes to the "price_amount" field.
+func (m *ItemMutation) ResetPriceAmount() {
+	m.price_amount = nil
+	m.addprice_amount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *ItemMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ItemMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ItemMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *ItemMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *ItemMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldExternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ClearExternalID clears the value of the "external_id" field.
+func (m *ItemMutation) ClearExternalID() {
+	m.external_id = nil
+	m.clearedFields[item.FieldExternalID] = struct{}{}
+}
+
+// ExternalIDCleared returns if the "external_id" field was cleared in this mutation.
+func (m *ItemMutation) ExternalIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldExternalID]
+	return ok
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *ItemMutation) ResetExternalID() {
+	m.external_id = nil
+	delete(m.clearedFields, item.FieldExternalID)
+}
+
+// SetAisle sets the "aisle" field.
+func (m *ItemMutation) SetAisle(s string) {
+	m.aisle = &s
+}
+
+// Aisle returns the value of the "aisle" field in the mutation.
+func (m *ItemMutation) Aisle() (r string, exists bool) {
+	v := m.aisle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAisle returns the old "aisle" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAisle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAisle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAisle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAisle: %w", err)
+	}
+	return oldValue.Aisle, nil
+}
+
+// ClearAisle clears the value of the "aisle" field.
+func (m *ItemMutation) ClearAisle() {
+	m.aisle = nil
+	m.clearedFields[item.FieldAisle] = struct{}{}
+}
+
+// AisleCleared returns if the "aisle" field was cleared in this mutation.
+func (m *ItemMutation) AisleCleared() bool {
+	_, ok := m.clearedFields[item.FieldAisle]
+	return ok
+}
+
+// ResetAisle resets all changes to the "aisle" field.
+func (m *ItemMutation) ResetAisle() {
+	m.aisle = nil
+	delete(m.clearedFields, item.FieldAisle)
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *ItemMutation) SetCategoryID(i int) {
+	m.category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *ItemMutation) CategoryID() (r int, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *ItemMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[item.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *ItemMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *ItemMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, item.FieldCategoryID)
+}
+
+// SetUnit sets the "unit" field.
+func (m *ItemMutation) SetUnit(s string) {
+	m.unit = &s
+}
+
+// Unit returns the value of the "unit" field in the mutation.
+func (m *ItemMutation) Unit() (r string, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnit returns the old "unit" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUnit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+	}
+	return oldValue.Unit, nil
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (m *ItemMutation) ClearUnit() {
+	m.unit = nil
+	m.clearedFields[item.FieldUnit] = struct{}{}
+}
+
+// UnitCleared returns if the "unit" field was cleared in this mutation.
+func (m *ItemMutation) UnitCleared() bool {
+	_, ok := m.clearedFields[item.FieldUnit]
+	return ok
+}
+
+// ResetUnit resets all changes to the "unit" field.
+func (m *ItemMutation) ResetUnit() {
+	m.unit = nil
+	delete(m.clearedFields, item.FieldUnit)
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (m *ItemMutation) SetSizeQuantity(f float64) {
+	m.size_quantity = &f
+	m.addsize_quantity = nil
+}
+
+// SizeQuantity returns the value of the "size_quantity" field in the mutation.
+func (m *ItemMutation) SizeQuantity() (r float64, exists bool) {
+	v := m.size_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeQuantity returns the old "size_quantity" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSizeQuantity(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeQuantity: %w", err)
+	}
+	return oldValue.SizeQuantity, nil
+}
+
+// AddSizeQuantity adds f to the "size_quantity" field.
+func (m *ItemMutation) AddSizeQuantity(f float64) {
+	if m.addsize_quantity != nil {
+		*m.addsize_quantity += f
+	} else {
+		m.addsize_quantity = &f
+	}
+}
+
+// AddedSizeQuantity returns the value that was added to the "size_quantity" field in this mutation.
+func (m *ItemMutation) AddedSizeQuantity() (r float64, exists bool) {
+	v := m.addsize_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (m *ItemMutation) ClearSizeQuantity() {
+	m.size_quantity = nil
+	m.addsize_quantity = nil
+	m.clearedFields[item.FieldSizeQuantity] = struct{}{}
+}
+
+// SizeQuantityCleared returns if the "size_quantity" field was cleared in this mutation.
+func (m *ItemMutation) SizeQuantityCleared() bool {
+	_, ok := m.clearedFields[item.FieldSizeQuantity]
+	return ok
+}
+
+// ResetSizeQuantity resets all changes to the "size_quantity" field.
+func (m *ItemMutation) ResetSizeQuantity() {
+	m.size_quantity = nil
+	m.addsize_quantity = nil
+	delete(m.clearedFields, item.FieldSizeQuantity)
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (m *ItemMutation) SetSizeUnit(iu item.SizeUnit) {
+	m.size_unit = &iu
+}
+
+// SizeUnit returns the value of the "size_unit" field in the mutation.
+func (m *ItemMutation) SizeUnit() (r item.SizeUnit, exists bool) {
+	v := m.size_unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeUnit returns the old "size_unit" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSizeUnit(ctx context.Context) (v *item.SizeUnit, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeUnit: %w", err)
+	}
+	return oldValue.SizeUnit, nil
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (m *ItemMutation) ClearSizeUnit() {
+	m.size_unit = nil
+	m.clearedFields[item.FieldSizeUnit] = struct{}{}
+}
+
+// SizeUnitCleared returns if the "size_unit" field was cleared in this mutation.
+func (m *ItemMutation) SizeUnitCleared() bool {
+	_, ok := m.clearedFields[item.FieldSizeUnit]
+	return ok
+}
+
+// ResetSizeUnit resets all changes to the "size_unit" field.
+func (m *ItemMutation) ResetSizeUnit() {
+	m.size_unit = nil
+	delete(m.clearedFields, item.FieldSizeUnit)
+}
+
+// SetUnitPriceMicros sets the "unit_price_micros" field.
+func (m *ItemMutation) SetUnitPriceMicros(i int64) {
+	m.unit_price_micros = &i
+	m.addunit_price_micros = nil
+}
+
+// UnitPriceMicros returns the value of the "unit_price_micros" field in the mutation.
+func (m *ItemMutation) UnitPriceMicros() (r int64, exists bool) {
+	v := m.unit_price_micros
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnitPriceMicros returns the old "unit_price_micros" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUnitPriceMicros(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnitPriceMicros is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnitPriceMicros requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnitPriceMicros: %w", err)
+	}
+	return oldValue.UnitPriceMicros, nil
+}
+
+// AddUnitPriceMicros adds i to the "unit_price_micros" field.
+func (m *ItemMutation) AddUnitPriceMicros(i int64) {
+	if m.addunit_price_micros != nil {
+		*m.addunit_price_micros += i
+	} else {
+		m.addunit_price_micros = &i
+	}
+}
+
+// AddedUnitPriceMicros returns the value that was added to the "unit_price_micros" field in this mutation.
+func (m *ItemMutation) AddedUnitPriceMicros() (r int64, exists bool) {
+	v := m.addunit_price_micros
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUnitPriceMicros clears the value of the "unit_price_micros" field.
+func (m *ItemMutation) ClearUnitPriceMicros() {
+	m.unit_price_micros = nil
+	m.addunit_price_micros = nil
+	m.clearedFields[item.FieldUnitPriceMicros] = struct{}{}
+}
+
+// UnitPriceMicrosCleared returns if the "unit_price_micros" field was cleared in this mutation.
+func (m *ItemMutation) UnitPriceMicrosCleared() bool {
+	_, ok := m.clearedFields[item.FieldUnitPriceMicros]
+	return ok
+}
+
+// ResetUnitPriceMicros resets all changes to the "unit_price_micros" field.
+func (m *ItemMutation) ResetUnitPriceMicros() {
+	m.unit_price_micros = nil
+	m.addunit_price_micros = nil
+	delete(m.clearedFields, item.FieldUnitPriceMicros)
+}
+
+// SetProductID sets the "product_id" field.
+func (m *ItemMutation) SetProductID(i int) {
+	m.product = &i
+}
+
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *ItemMutation) ProductID() (r int, exists bool) {
+	v := m.product
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductID returns the old "product_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldProductID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+	}
+	return oldValue.ProductID, nil
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (m *ItemMutation) ClearProductID() {
+	m.product = nil
+	m.clearedFields[item.FieldProductID] = struct{}{}
+}
+
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *ItemMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldProductID]
+	return ok
+}
+
+// ResetProductID resets all changes to the "product_id" field.
+func (m *ItemMutation) ResetProductID() {
+	m.product = nil
+	delete(m.clearedFields, item.FieldProductID)
+}
+
+// SetProductPinned sets the "product_pinned" field.
+func (m *ItemMutation) SetProductPinned(b bool) {
+	m.product_pinned = &b
+}
+
+// ProductPinned returns the value of the "product_pinned" field in the mutation.
+func (m *ItemMutation) ProductPinned() (r bool, exists bool) {
+	v := m.product_pinned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductPinned returns the old "product_pinned" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldProductPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductPinned: %w", err)
+	}
+	return oldValue.ProductPinned, nil
+}
+
+// ResetProductPinned resets all changes to the "product_pinned" field.
+func (m *ItemMutation) ResetProductPinned() {
+	m.product_pinned = nil
+}
+
+// SetAttributesPinned sets the "attributes_pinned" field.
+func (m *ItemMutation) SetAttributesPinned(b bool) {
+	m.attributes_pinned = &b
+}
+
+// AttributesPinned returns the value of the "attributes_pinned" field in the mutation.
+func (m *ItemMutation) AttributesPinned() (r bool, exists bool) {
+	v := m.attributes_pinned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributesPinned returns the old "attributes_pinned" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAttributesPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributesPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributesPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributesPinned: %w", err)
+	}
+	return oldValue.AttributesPinned, nil
+}
+
+// ResetAttributesPinned resets all changes to the "attributes_pinned" field.
+func (m *ItemMutation) ResetAttributesPinned() {
+	m.attributes_pinned = nil
+}
+
+// SetAvailable sets the "available" field.
+func (m *ItemMutation) SetAvailable(b bool) {
+	m.available = &b
+}
+
+// Available returns the value of the "available" field in the mutation.
+func (m *ItemMutation) Available() (r bool, exists bool) {
+	v := m.available
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailable returns the old "available" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAvailable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailable is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailable requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailable: %w", err)
+	}
+	return oldValue.Available, nil
+}
+
+// ResetAvailable resets all changes to the "available" field.
+func (m *ItemMutation) ResetAvailable() {
+	m.available = nil
+}
+
+// SetDelistedAt sets the "delisted_at" field.
+func (m *ItemMutation) SetDelistedAt(t time.Time) {
+	m.delisted_at = &t
+}
+
+// DelistedAt returns the value of the "delisted_at" field in the mutation.
+func (m *ItemMutation) DelistedAt() (r time.Time, exists bool) {
+	v := m.delisted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDelistedAt returns the old "delisted_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldDelistedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDelistedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDelistedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDelistedAt: %w", err)
+	}
+	return oldValue.DelistedAt, nil
+}
+
+// ClearDelistedAt clears the value of the "delisted_at" field.
+func (m *ItemMutation) ClearDelistedAt() {
+	m.delisted_at = nil
+	m.clearedFields[item.FieldDelistedAt] = struct{}{}
+}
+
+// DelistedAtCleared returns if the "delisted_at" field was cleared in this mutation.
+func (m *ItemMutation) DelistedAtCleared() bool {
+	_, ok := m.clearedFields[item.FieldDelistedAt]
+	return ok
+}
+
+// ResetDelistedAt resets all changes to the "delisted_at" field.
+func (m *ItemMutation) ResetDelistedAt() {
+	m.delisted_at = nil
+	delete(m.clearedFields, item.FieldDelistedAt)
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *ItemMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *ItemMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *ItemMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[item.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *ItemMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[item.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *ItemMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, item.FieldLastSeenAt)
+}
+
+// SetStoreID sets the "store" edge to the Store entity by id.
+func (m *ItemMutation) SetStoreID(id int) {
+	m.store = &id
+}
+
+// ClearStore clears the "store" edge to the Store entity.
+func (m *ItemMutation) ClearStore() {
+	m.clearedstore = true
+}
+
+// StoreCleared reports if the "store" edge to the Store entity was cleared.
+func (m *ItemMutation) StoreCleared() bool {
+	return m.clearedstore
+}
+
+// StoreID returns the "store" edge ID in the mutation.
+func (m *ItemMutation) StoreID() (id int, exists bool) {
+	if m.store != nil {
+		return *m.store, true
+	}
+	return
+}
+
+// StoreIDs returns the "store" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StoreID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) StoreIDs() (ids []int) {
+	if id := m.store; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStore resets all changes to the "store" edge.
+func (m *ItemMutation) ResetStore() {
+	m.store = nil
+	m.clearedstore = false
+}
+
+// AddListIDs adds the "lists" edge to the List entity by ids.
+func (m *ItemMutation) AddListIDs(ids ...int) {
+	if m.lists == nil {
+		m.lists = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.lists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLists clears the "lists" edge to the List entity.
+func (m *ItemMutation) ClearLists() {
+	m.clearedlists = true
+}
+
+// ListsCleared reports if the "lists" edge to the List entity was cleared.
+func (m *ItemMutation) ListsCleared() bool {
+	return m.clearedlists
+}
+
+// RemoveListIDs removes the "lists" edge to the List entity by IDs.
+func (m *ItemMutation) RemoveListIDs(ids ...int) {
+	if m.removedlists == nil {
+		m.removedlists = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.lists, ids[i])
+		m.removedlists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLists returns the removed IDs of the "lists" edge to the List entity.
+func (m *ItemMutation) RemovedListsIDs() (ids []int) {
+	for id := range m.removedlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ListsIDs returns the "lists" edge IDs in the mutation.
+func (m *ItemMutation) ListsIDs() (ids []int) {
+	for id := range m.lists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLists resets all changes to the "lists" edge.
+func (m *ItemMutation) ResetLists() {
+	m.lists = nil
+	m.clearedlists = false
+	m.removedlists = nil
+}
+
+// AddPriceObservationIDs adds the "price_observations" edge to the PriceObservation entity by ids.
+func (m *ItemMutation) AddPriceObservationIDs(ids ...int) {
+	if m.price_observations == nil {
+		m.price_observations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.price_observations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPriceObservations clears the "price_observations" edge to the PriceObservation entity.
+func (m *ItemMutation) ClearPriceObservations() {
+	m.clearedprice_observations = true
+}
+
+// PriceObservationsCleared reports if the "price_observations" edge to the PriceObservation entity was cleared.
+func (m *ItemMutation) PriceObservationsCleared() bool {
+	return m.clearedprice_observations
+}
+
+// RemovePriceObservationIDs removes the "price_observations" edge to the PriceObservation entity by IDs.
+func (m *ItemMutation) RemovePriceObservationIDs(ids ...int) {
+	if m.removedprice_observations == nil {
+		m.removedprice_observations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.price_observations, ids[i])
+		m.removedprice_observations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPriceObservations returns the removed IDs of the "price_observations" edge to the PriceObservation entity.
+func (m *ItemMutation) RemovedPriceObservationsIDs() (ids []int) {
+	for id := range m.removedprice_observations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PriceObservationsIDs returns the "price_observations" edge IDs in the mutation.
+func (m *ItemMutation) PriceObservationsIDs() (ids []int) {
+	for id := range m.price_observations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPriceObservations resets all changes to the "price_observations" edge.
+func (m *ItemMutation) ResetPriceObservations() {
+	m.price_observations = nil
+	m.clearedprice_observations = false
+	m.removedprice_observations = nil
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *ItemMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[item.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *ItemMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *ItemMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (m *ItemMutation) ClearProduct() {
+	m.clearedproduct = true
+	m.clearedFields[item.FieldProductID] = struct{}{}
+}
+
+// ProductCleared reports if the "product" edge to the Product entity was cleared.
+func (m *ItemMutation) ProductCleared() bool {
+	return m.ProductIDCleared() || m.clearedproduct
+}
+
+// ProductIDs returns the "product" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProductID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) ProductIDs() (ids []int) {
+	if id := m.product; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProduct resets all changes to the "product" edge.
+func (m *ItemMutation) ResetProduct() {
+	m.product = nil
+	m.clearedproduct = false
+}
+
+// AddAttributeIDs adds the "attributes" edge to the Attribute entity by ids.
+func (m *ItemMutation) AddAttributeIDs(ids ...int) {
+	if m.attributes == nil {
+		m.attributes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.attributes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttributes clears the "attributes" edge to the Attribute entity.
+func (m *ItemMutation) ClearAttributes() {
+	m.clearedattributes = true
+}
+
+// AttributesCleared reports if the "attributes" edge to the Attribute entity was cleared.
+func (m *ItemMutation) AttributesCleared() bool {
+	return m.clearedattributes
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to the Attribute entity by IDs.
+func (m *ItemMutation) RemoveAttributeIDs(ids ...int) {
+	if m.removedattributes == nil {
+		m.removedattributes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.attributes, ids[i])
+		m.removedattributes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttributes returns the removed IDs of the "attributes" edge to the Attribute entity.
+func (m *ItemMutation) RemovedAttributesIDs() (ids []int) {
+	for id := range m.removedattributes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttributesIDs returns the "attributes" edge IDs in the mutation.
+func (m *ItemMutation) AttributesIDs() (ids []int) {
+	for id := range m.attributes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttributes resets all changes to the "attributes" edge.
+func (m *ItemMutation) ResetAttributes() {
+	m.attributes = nil
+	m.clearedattributes = false
+	m.removedattributes = nil
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.create_time != nil {
+		fields = append(fields, item.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, item.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, item.FieldName)
+	}
+	if m.brand != nil {
+		fields = append(fields, item.FieldBrand)
+	}
+	if m.price_amount != nil {
+		fields = append(fields, item.FieldPriceAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, item.FieldCurrency)
+	}
+	if m.external_id != nil {
+		fields = append(fields, item.FieldExternalID)
+	}
+	if m.aisle != nil {
+		fields = append(fields, item.FieldAisle)
+	}
+	if m.category != nil {
+		fields = append(fields, item.FieldCategoryID)
+	}
+	if m.unit != nil {
+		fields = append(fields, item.FieldUnit)
+	}
+	if m.size_quantity != nil {
+		fields = append(fields, item.FieldSizeQuantity)
+	}
+	if m.size_unit != nil {
+		fields = append(fields, item.FieldSizeUnit)
+	}
+	if m.unit_price_micros != nil {
+		fields = append(fields, item.FieldUnitPriceMicros)
+	}
+	if m.product != nil {
+		fields = append(fields, item.FieldProductID)
+	}
+	if m.product_pinned != nil {
+		fields = append(fields, item.FieldProductPinned)
+	}
+	if m.attributes_pinned != nil {
+		fields = append(fields, item.FieldAttributesPinned)
+	}
+	if m.available != nil {
+		fields = append(fields, item.FieldAvailable)
+	}
+	if m.delisted_at != nil {
+		fields = append(fields, item.FieldDelistedAt)
+	}
+	if m.last_seen_at != nil {
+		fields = append(fields, item.FieldLastSeenAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldCreateTime:
+		return m.CreateTime()
+	case item.FieldUpdateTime:
+		return m.UpdateTime()
+	case item.FieldName:
+		return m.Name()
+	case item.FieldBrand:
+		return m.Brand()
+	case item.FieldPriceAmount:
+		return m.PriceAmount()
+	case item.FieldCurrency:
+		return m.Currency()
+	case item.FieldExternalID:
+		return m.ExternalID()
+	case item.FieldAisle:
+		return m.Aisle()
+	case item.FieldCategoryID:
+		return m.CategoryID()
+	case item.FieldUnit:
+		return m.Unit()
+	case item.FieldSizeQuantity:
+		return m.SizeQuantity()
+	case item.FieldSizeUnit:
+		return m.SizeUnit()
+	case item.FieldUnitPriceMicros:
+		return m.UnitPriceMicros()
+	case item.FieldProductID:
+		return m.ProductID()
+	case item.FieldProductPinned:
+		return m.ProductPinned()
+	case item.FieldAttributesPinned:
+		return m.AttributesPinned()
+	case item.FieldAvailable:
+		return m.Available()
+	case item.FieldDelistedAt:
+		return m.DelistedAt()
+	case item.FieldLastSeenAt:
+		return m.LastSeenAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case item.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case item.FieldName:
+		return m.OldName(ctx)
+	case item.FieldBrand:
+		return m.OldBrand(ctx)
+	case item.FieldPriceAmount:
+		return m.OldPriceAmount(ctx)
+	case item.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case item.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case item.FieldAisle:
+		return m.OldAisle(ctx)
+	case item.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case item.FieldUnit:
+		return m.OldUnit(ctx)
+	case item.FieldSizeQuantity:
+		return m.OldSizeQuantity(ctx)
+	case item.FieldSizeUnit:
+		return m.OldSizeUnit(ctx)
+	case item.FieldUnitPriceMicros:
+		return m.OldUnitPriceMicros(ctx)
+	case item.FieldProductID:
+		return m.OldProductID(ctx)
+	case item.FieldProductPinned:
+		return m.OldProductPinned(ctx)
+	case item.FieldAttributesPinned:
+		return m.OldAttributesPinned(ctx)
+	case item.FieldAvailable:
+		return m.OldAvailable(ctx)
+	case item.FieldDelistedAt:
+		return m.OldDelistedAt(ctx)
+	case item.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case item.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case item.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case item.FieldBrand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrand(v)
+		return nil
+	case item.FieldPriceAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceAmount(v)
+		return nil
+	case item.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case item.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case item.FieldAisle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAisle(v)
+		return nil
+	case item.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	case item.FieldUnit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnit(v)
+		return nil
+	case item.FieldSizeQuantity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeQuantity(v)
+		return nil
+	case item.FieldSizeUnit:
+		v, ok := value.(item.SizeUnit)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeUnit(v)
+		return nil
+	case item.FieldUnitPriceMicros:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnitPriceMicros(v)
+		return nil
+	case item.FieldProductID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case item.FieldProductPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductPinned(v)
+		return nil
+	case item.FieldAttributesPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributesPinned(v)
+		return nil
+	case item.FieldAvailable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailable(v)
+		return nil
+	case item.FieldDelistedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelistedAt(v)
+		return nil
+	case item.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice_amount != nil {
+		fields = append(fields, item.FieldPriceAmount)
+	}
+	if m.addsize_quantity != nil {
+		fields = append(fields, item.FieldSizeQuantity)
+	}
+	if m.addunit_price_micros != nil {
+		fields = append(fields, item.FieldUnitPriceMicros)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldPriceAmount:
+		return m.AddedPriceAmount()
+	case item.FieldSizeQuantity:
+		return m.AddedSizeQuantity()
+	case item.FieldUnitPriceMicros:
+		return m.AddedUnitPriceMicros()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldPriceAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriceAmount(v)
+		return nil
+	case item.FieldSizeQuantity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeQuantity(v)
+		return nil
+	case item.FieldUnitPriceMicros:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnitPriceMicros(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldExternalID) {
+		fields = append(fields, item.FieldExternalID)
+	}
+	if m.FieldCleared(item.FieldAisle) {
+		fields = append(fields, item.FieldAisle)
+	}
+	if m.FieldCleared(item.FieldCategoryID) {
+		fields = append(fields, item.FieldCategoryID)
+	}
+	if m.FieldCleared(item.FieldUnit) {
+		fields = append(fields, item.FieldUnit)
+	}
+	if m.FieldCleared(item.FieldSizeQuantity) {
+		fields = append(fields, item.FieldSizeQuantity)
+	}
+	if m.FieldCleared(item.FieldSizeUnit) {
+		fields = append(fields, item.FieldSizeUnit)
+	}
+	if m.FieldCleared(item.FieldUnitPriceMicros) {
+		fields = append(fields, item.FieldUnitPriceMicros)
+	}
+	if m.FieldCleared(item.FieldProductID) {
+		fields = append(fields, item.FieldProductID)
+	}
+	if m.FieldCleared(item.FieldDelistedAt) {
+		fields = append(fields, item.FieldDelistedAt)
+	}
+	if m.FieldCleared(item.FieldLastSeenAt) {
+		fields = append(fields, item.FieldLastSeenAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldExternalID:
+		m.ClearExternalID()
+		return nil
+	case item.FieldAisle:
+		m.ClearAisle()
+		return nil
+	case item.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
+	case item.FieldUnit:
+		m.ClearUnit()
+		return nil
+	case item.FieldSizeQuantity:
+		m.ClearSizeQuantity()
+		return nil
+	case item.FieldSizeUnit:
+		m.ClearSizeUnit()
+		return nil
+	case item.FieldUnitPriceMicros:
+		m.ClearUnitPriceMicros()
+		return nil
+	case item.FieldProductID:
+		m.ClearProductID()
+		return nil
+	case item.FieldDelistedAt:
+		m.ClearDelistedAt()
+		return nil
+	case item.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case item.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case item.FieldName:
+		m.ResetName()
+		return nil
+	case item.FieldBrand:
+		m.ResetBrand()
+		return nil
+	case item.FieldPriceAmount:
+		m.ResetPriceAmount()
+		return nil
+	case item.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case item.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case item.FieldAisle:
+		m.ResetAisle()
+		return nil
+	case item.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case item.FieldUnit:
+		m.ResetUnit()
+		return nil
+	case item.FieldSizeQuantity:
+		m.ResetSizeQuantity()
+		return nil
+	case item.FieldSizeUnit:
+		m.ResetSizeUnit()
+		return nil
+	case item.FieldUnitPriceMicros:
+		m.ResetUnitPriceMicros()
+		return nil
+	case item.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case item.FieldProductPinned:
+		m.ResetProductPinned()
+		return nil
+	case item.FieldAttributesPinned:
+		m.ResetAttributesPinned()
+		return nil
+	case item.FieldAvailable:
+		m.ResetAvailable()
+		return nil
+	case item.FieldDelistedAt:
+		m.ResetDelistedAt()
+		return nil
+	case item.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.store != nil {
+		edges = append(edges, item.EdgeStore)
+	}
+	if m.lists != nil {
+		edges = append(edges, item.EdgeLists)
+	}
+	if m.price_observations != nil {
+		edges = append(edges, item.EdgePriceObservations)
+	}
+	if m.category != nil {
+		edges = append(edges, item.EdgeCategory)
+	}
+	if m.product != nil {
+		edges = append(edges, item.EdgeProduct)
+	}
+	if m.attributes != nil {
+		edges = append(edges, item.EdgeAttributes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeStore:
+		if id := m.store; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeLists:
+		ids := make([]ent.Value, 0, len(m.lists))
+		for id := range m.lists {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgePriceObservations:
+		ids := make([]ent.Value, 0, len(m.price_observations))
+		for id := range m.price_observations {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeProduct:
+		if id := m.product; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeAttributes:
+		ids := make([]ent.Value, 0, len(m.attributes))
+		for id := range m.attributes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedlists != nil {
+		edges = append(edges, item.EdgeLists)
+	}
+	if m.removedprice_observations != nil {
+		edges = append(edges, item.EdgePriceObservations)
+	}
+	if m.removedattributes != nil {
+		edges = append(edges, item.EdgeAttributes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeLists:
+		ids := make([]ent.Value, 0, len(m.removedlists))
+		for id := range m.removedlists {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgePriceObservations:
+		ids := make([]ent.Value, 0, len(m.removedprice_observations))
+		for id := range m.removedprice_observations {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeAttributes:
+		ids := make([]ent.Value, 0, len(m.removedattributes))
+		for id := range m.removedattributes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedstore {
+		edges = append(edges, item.EdgeStore)
+	}
+	if m.clearedlists {
+		edges = append(edges, item.EdgeLists)
+	}
+	if m.clearedprice_observations {
+		edges = append(edges, item.EdgePriceObservations)
+	}
+	if m.clearedcategory {
+		edges = append(edges, item.EdgeCategory)
+	}
+	if m.clearedproduct {
+		edges = append(edges, item.EdgeProduct)
+	}
+	if m.clearedattributes {
+		edges = append(edges, item.EdgeAttributes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeStore:
+		return m.clearedstore
+	case item.EdgeLists:
+		return m.clearedlists
+	case item.EdgePriceObservations:
+		return m.clearedprice_observations
+	case item.EdgeCategory:
+		return m.clearedcategory
+	case item.EdgeProduct:
+		return m.clearedproduct
+	case item.EdgeAttributes:
+		return m.clearedattributes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgeStore:
+		m.ClearStore()
+		return nil
+	case item.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	case item.EdgeProduct:
+		m.ClearProduct()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeStore:
+		m.ResetStore()
+		return nil
+	case item.EdgeLists:
+		m.ResetLists()
+		return nil
+	case item.EdgePriceObservations:
+		m.ResetPriceObservations()
+		return nil
+	case item.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case item.EdgeProduct:
+		m.ResetProduct()
+		return nil
+	case item.EdgeAttributes:
+		m.ResetAttributes()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// ListMutation represents an operation that mutates the List nodes in the graph.
+type ListMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	items         map[int]struct{}
+	removeditems  map[int]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*List, error)
+	predicates    []predicate.List
+}
+
+var _ ent.Mutation = (*ListMutation)(nil)
+
+// listOption allows management of the mutation configuration using functional options.
+type listOption func(*ListMutation)
+
+// newListMutation creates new mutation for the List entity.
+func newListMutation(c config, op Op, opts ...listOption) *ListMutation {
+	m := &ListMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeList,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListID sets the ID field of the mutation.
+func withListID(id int) listOption {
+	return func(m *ListMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *List
+		)
+		m.oldValue = func(ctx context.Context) (*List, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().List.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withList sets the old List of the mutation.
+func withList(node *List) listOption {
+	return func(m *ListMutation) {
+		m.oldValue = func(context.Context) (*List, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().List.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ListMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ListMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the List entity.
+// If the List object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ListMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ListMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ListMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the List entity.
+// If the List object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ListMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ListMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ListMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the List entity.
+// If the List object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ListMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ListMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ListMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ListMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *ListMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ListMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ListMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *ListMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *ListMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *ListMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *ListMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *ListMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *ListMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *ListMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the ListMutation builder.
+func (m *ListMutation) Where(ps ...predicate.List) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.List, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (List).
+func (m *ListMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.create_time != nil {
+		fields = append(fields, list.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, list.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, list.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case list.FieldCreateTime:
+		return m.CreateTime()
+	case list.FieldUpdateTime:
+		return m.UpdateTime()
+	case list.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case list.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case list.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case list.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown List field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case list.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case list.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case list.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown List field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown List numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown List nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListMutation) ResetField(name string) error {
+	switch name {
+	case list.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case list.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case list.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown List field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, list.EdgeUser)
+	}
+	if m.items != nil {
+		edges = append(edges, list.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case list.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case list.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, list.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case list.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, list.EdgeUser)
+	}
+	if m.cleareditems {
+		edges = append(edges, list.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListMutation) EdgeCleared(name string) bool {
+	switch name {
+	case list.EdgeUser:
+		return m.cleareduser
+	case list.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListMutation) ClearEdge(name string) error {
+	switch name {
+	case list.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown List unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListMutation) ResetEdge(name string) error {
+	switch name {
+	case list.EdgeUser:
+		m.ResetUser()
+		return nil
+	case list.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown List edge %s", name)
+}
 
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+// PriceObservationMutation represents an operation that mutates the PriceObservation nodes in the graph.
+type PriceObservationMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	price_amount      *int64
+	addprice_amount   *int64
+	currency          *string
+	observed_at       *time.Time
+	clearedFields     map[string]struct{}
+	item              *int
+	cleareditem       bool
+	import_run        *int
+	clearedimport_run bool
+	done              bool
+	oldValue          func(context.Context) (*PriceObservation, error)
+	predicates        []predicate.PriceObservation
+}
+
+var _ ent.Mutation = (*PriceObservationMutation)(nil)
+
+// priceobservationOption allows management of the mutation configuration using functional options.
+type priceobservationOption func(*PriceObservationMutation)
+
+// newPriceObservationMutation creates new mutation for the PriceObservation entity.
+func newPriceObservationMutation(c config, op Op, opts ...priceobservationOption) *PriceObservationMutation {
+	m := &PriceObservationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypePriceObservation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +7405,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id int) itemOption {
-	return func(m *ItemMutation) {
+// withPriceObservationID sets the ID field of the mutation.
+func withPriceObservationID(id int) priceobservationOption {
+	return func(m *PriceObservationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *PriceObservation
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*PriceObservation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().PriceObservation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +7427,10 @@ func withItemID(id int) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withPriceObservation sets the old PriceObservation of the mutation.
+func withPriceObservation(node *PriceObservation) priceobservationOption {
+	return func(m *PriceObservationMutation) {
+		m.oldValue = func(context.Context) (*PriceObservation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +7439,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m PriceObservationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +7447,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m PriceObservationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -128,7 +7458,7 @@ func (m ItemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id int, exists bool) {
+func (m *PriceObservationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -139,7 +7469,7 @@ func (m *ItemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PriceObservationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -148,314 +7478,227 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PriceObservation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *ItemMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// SetPriceAmount sets the "price_amount" field.
+func (m *PriceObservationMutation) SetPriceAmount(i int64) {
+	m.price_amount = &i
+	m.addprice_amount = nil
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ItemMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// PriceAmount returns the value of the "price_amount" field in the mutation.
+func (m *PriceObservationMutation) PriceAmount() (r int64, exists bool) {
+	v := m.price_amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldPriceAmount returns the old "price_amount" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *PriceObservationMutation) OldPriceAmount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriceAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldPriceAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *ItemMutation) ResetCreateTime() {
-	m.create_time = nil
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (m *ItemMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
-}
-
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ItemMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPriceAmount: %w", err)
 	}
-	return *v, true
+	return oldValue.PriceAmount, nil
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+// AddPriceAmount adds i to the "price_amount" field.
+func (m *PriceObservationMutation) AddPriceAmount(i int64) {
+	if m.addprice_amount != nil {
+		*m.addprice_amount += i
+	} else {
+		m.addprice_amount = &i
 	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ItemMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetName sets the "name" field.
-func (m *ItemMutation) SetName(s string) {
-	m.name = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ItemMutation) Name() (r string, exists bool) {
-	v := m.name
+// AddedPriceAmount returns the value that was added to the "price_amount" field in this mutation.
+func (m *PriceObservationMutation) AddedPriceAmount() (r int64, exists bool) {
+	v := m.addprice_amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *ItemMutation) ResetName() {
-	m.name = nil
+// ResetPriceAmount resets all changes to the "price_amount" field.
+func (m *PriceObservationMutation) ResetPriceAmount() {
+	m.price_amount = nil
+	m.addprice_amount = nil
 }
 
-// SetBrand sets the "brand" field.
-func (m *ItemMutation) SetBrand(s string) {
-	m.brand = &s
+// SetCurrency sets the "currency" field.
+func (m *PriceObservationMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// Brand returns the value of the "brand" field in the mutation.
-func (m *ItemMutation) Brand() (r string, exists bool) {
-	v := m.brand
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PriceObservationMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBrand returns the old "brand" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldBrand(ctx context.Context) (v string, err error) {
+func (m *PriceObservationMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBrand requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.Brand, nil
+	return oldValue.Currency, nil
 }
 
-// ResetBrand resets all changes to the "brand" field.
-func (m *ItemMutation) ResetBrand() {
-	m.brand = nil
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PriceObservationMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *ItemMutation) SetPrice(f float64) {
-	m.price = &f
-	m.addprice = nil
+// SetObservedAt sets the "observed_at" field.
+func (m *PriceObservationMutation) SetObservedAt(t time.Time) {
+	m.observed_at = &t
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *ItemMutation) Price() (r float64, exists bool) {
-	v := m.price
+// ObservedAt returns the value of the "observed_at" field in the mutation.
+func (m *PriceObservationMutation) ObservedAt() (r time.Time, exists bool) {
+	v := m.observed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldObservedAt returns the old "observed_at" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPrice(ctx context.Context) (v float64, err error) {
+func (m *PriceObservationMutation) OldObservedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldObservedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldObservedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
-	}
-	return oldValue.Price, nil
-}
-
-// AddPrice adds f to the "price" field.
-func (m *ItemMutation) AddPrice(f float64) {
-	if m.addprice != nil {
-		*m.addprice += f
-	} else {
-		m.addprice = &f
-	}
-}
-
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *ItemMutation) AddedPrice() (r float64, exists bool) {
-	v := m.addprice
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldObservedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.ObservedAt, nil
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *ItemMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
+// ResetObservedAt resets all changes to the "observed_at" field.
+func (m *PriceObservationMutation) ResetObservedAt() {
+	m.observed_at = nil
 }
 
-// SetStoreID sets the "store" edge to the Store entity by id.
-func (m *ItemMutation) SetStoreID(id int) {
-	m.store = &id
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *PriceObservationMutation) SetItemID(id int) {
+	m.item = &id
 }
 
-// ClearStore clears the "store" edge to the Store entity.
-func (m *ItemMutation) ClearStore() {
-	m.clearedstore = true
+// ClearItem clears the "item" edge to the Item entity.
+func (m *PriceObservationMutation) ClearItem() {
+	m.cleareditem = true
 }
 
-// StoreCleared reports if the "store" edge to the Store entity was cleared.
-func (m *ItemMutation) StoreCleared() bool {
-	return m.clearedstore
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *PriceObservationMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// StoreID returns the "store" edge ID in the mutation.
-func (m *ItemMutation) StoreID() (id int, exists bool) {
-	if m.store != nil {
-		return *m.store, true
+// ItemID returns the "item" edge ID in the mutation.
+func (m *PriceObservationMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
 	}
 	return
 }
 
-// StoreIDs returns the "store" edge IDs in the mutation.
+// ItemIDs returns the "item" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StoreID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) StoreIDs() (ids []int) {
-	if id := m.store; id != nil {
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *PriceObservationMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetStore resets all changes to the "store" edge.
-func (m *ItemMutation) ResetStore() {
-	m.store = nil
-	m.clearedstore = false
-}
-
-// AddListIDs adds the "lists" edge to the List entity by ids.
-func (m *ItemMutation) AddListIDs(ids ...int) {
-	if m.lists == nil {
-		m.lists = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.lists[ids[i]] = struct{}{}
-	}
+// ResetItem resets all changes to the "item" edge.
+func (m *PriceObservationMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// ClearLists clears the "lists" edge to the List entity.
-func (m *ItemMutation) ClearLists() {
-	m.clearedlists = true
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by id.
+func (m *PriceObservationMutation) SetImportRunID(id int) {
+	m.import_run = &id
 }
 
-// ListsCleared reports if the "lists" edge to the List entity was cleared.
-func (m *ItemMutation) ListsCleared() bool {
-	return m.clearedlists
+// ClearImportRun clears the "import_run" edge to the ImportRun entity.
+func (m *PriceObservationMutation) ClearImportRun() {
+	m.clearedimport_run = true
 }
 
-// RemoveListIDs removes the "lists" edge to the List entity by IDs.
-func (m *ItemMutation) RemoveListIDs(ids ...int) {
-	if m.removedlists == nil {
-		m.removedlists = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.lists, ids[i])
-		m.removedlists[ids[i]] = struct{}{}
-	}
+// ImportRunCleared reports if the "import_run" edge to the ImportRun entity was cleared.
+func (m *PriceObservationMutation) ImportRunCleared() bool {
+	return m.clearedimport_run
 }
 
-// RemovedLists returns the removed IDs of the "lists" edge to the List entity.
-func (m *ItemMutation) RemovedListsIDs() (ids []int) {
-	for id := range m.removedlists {
-		ids = append(ids, id)
+// ImportRunID returns the "import_run" edge ID in the mutation.
+func (m *PriceObservationMutation) ImportRunID() (id int, exists bool) {
+	if m.import_run != nil {
+		return *m.import_run, true
 	}
 	return
 }
 
-// ListsIDs returns the "lists" edge IDs in the mutation.
-func (m *ItemMutation) ListsIDs() (ids []int) {
-	for id := range m.lists {
-		ids = append(ids, id)
+// ImportRunIDs returns the "import_run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ImportRunID instead. It exists only for internal usage by the builders.
+func (m *PriceObservationMutation) ImportRunIDs() (ids []int) {
+	if id := m.import_run; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetLists resets all changes to the "lists" edge.
-func (m *ItemMutation) ResetLists() {
-	m.lists = nil
-	m.clearedlists = false
-	m.removedlists = nil
+// ResetImportRun resets all changes to the "import_run" edge.
+func (m *PriceObservationMutation) ResetImportRun() {
+	m.import_run = nil
+	m.clearedimport_run = false
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
+// Where appends a list predicates to the PriceObservationMutation builder.
+func (m *PriceObservationMutation) Where(ps ...predicate.PriceObservation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PriceObservationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
+func (m *PriceObservationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PriceObservation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -463,39 +7706,33 @@ func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemMutation) Op() Op {
+func (m *PriceObservationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
+func (m *PriceObservationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
+// Type returns the node type of this mutation (PriceObservation).
+func (m *PriceObservationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.create_time != nil {
-		fields = append(fields, item.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, item.FieldUpdateTime)
-	}
-	if m.name != nil {
-		fields = append(fields, item.FieldName)
+func (m *PriceObservationMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.price_amount != nil {
+		fields = append(fields, priceobservation.FieldPriceAmount)
 	}
-	if m.brand != nil {
-		fields = append(fields, item.FieldBrand)
+	if m.currency != nil {
+		fields = append(fields, priceobservation.FieldCurrency)
 	}
-	if m.price != nil {
-		fields = append(fields, item.FieldPrice)
+	if m.observed_at != nil {
+		fields = append(fields, priceobservation.FieldObservedAt)
 	}
 	return fields
 }
@@ -503,18 +7740,14 @@ func (m *ItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+func (m *PriceObservationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldCreateTime:
-		return m.CreateTime()
-	case item.FieldUpdateTime:
-		return m.UpdateTime()
-	case item.FieldName:
-		return m.Name()
-	case item.FieldBrand:
-		return m.Brand()
-	case item.FieldPrice:
-		return m.Price()
+	case priceobservation.FieldPriceAmount:
+		return m.PriceAmount()
+	case priceobservation.FieldCurrency:
+		return m.Currency()
+	case priceobservation.FieldObservedAt:
+		return m.ObservedAt()
 	}
 	return nil, false
 }
@@ -522,72 +7755,54 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PriceObservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case item.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case item.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case item.FieldName:
-		return m.OldName(ctx)
-	case item.FieldBrand:
-		return m.OldBrand(ctx)
-	case item.FieldPrice:
-		return m.OldPrice(ctx)
+	case priceobservation.FieldPriceAmount:
+		return m.OldPriceAmount(ctx)
+	case priceobservation.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case priceobservation.FieldObservedAt:
+		return m.OldObservedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case item.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case item.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case item.FieldName:
-		v, ok := value.(string)
+	return nil, fmt.Errorf("unknown PriceObservation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PriceObservationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case priceobservation.FieldPriceAmount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPriceAmount(v)
 		return nil
-	case item.FieldBrand:
+	case priceobservation.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBrand(v)
+		m.SetCurrency(v)
 		return nil
-	case item.FieldPrice:
-		v, ok := value.(float64)
+	case priceobservation.FieldObservedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetObservedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown PriceObservation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemMutation) AddedFields() []string {
+func (m *PriceObservationMutation) AddedFields() []string {
 	var fields []string
-	if m.addprice != nil {
-		fields = append(fields, item.FieldPrice)
+	if m.addprice_amount != nil {
+		fields = append(fields, priceobservation.FieldPriceAmount)
 	}
 	return fields
 }
@@ -595,10 +7810,10 @@ func (m *ItemMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PriceObservationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldPrice:
-		return m.AddedPrice()
+	case priceobservation.FieldPriceAmount:
+		return m.AddedPriceAmount()
 	}
 	return nil, false
 }
@@ -606,194 +7821,181 @@ func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
+func (m *PriceObservationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldPrice:
-		v, ok := value.(float64)
+	case priceobservation.FieldPriceAmount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPrice(v)
+		m.AddPriceAmount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
+	return fmt.Errorf("unknown PriceObservation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemMutation) ClearedFields() []string {
+func (m *PriceObservationMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
+func (m *PriceObservationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Item nullable field %s", name)
+func (m *PriceObservationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PriceObservation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *PriceObservationMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldCreateTime:
-		m.ResetCreateTime()
+	case priceobservation.FieldPriceAmount:
+		m.ResetPriceAmount()
 		return nil
-	case item.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case item.FieldName:
-		m.ResetName()
-		return nil
-	case item.FieldBrand:
-		m.ResetBrand()
+	case priceobservation.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case item.FieldPrice:
-		m.ResetPrice()
+	case priceobservation.FieldObservedAt:
+		m.ResetObservedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown PriceObservation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
+func (m *PriceObservationMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.store != nil {
-		edges = append(edges, item.EdgeStore)
+	if m.item != nil {
+		edges = append(edges, priceobservation.EdgeItem)
 	}
-	if m.lists != nil {
-		edges = append(edges, item.EdgeLists)
+	if m.import_run != nil {
+		edges = append(edges, priceobservation.EdgeImportRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *PriceObservationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgeStore:
-		if id := m.store; id != nil {
+	case priceobservation.EdgeItem:
+		if id := m.item; id != nil {
 			return []ent.Value{*id}
 		}
-	case item.EdgeLists:
-		ids := make([]ent.Value, 0, len(m.lists))
-		for id := range m.lists {
-			ids = append(ids, id)
+	case priceobservation.EdgeImportRun:
+		if id := m.import_run; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
+func (m *PriceObservationMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedlists != nil {
-		edges = append(edges, item.EdgeLists)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeLists:
-		ids := make([]ent.Value, 0, len(m.removedlists))
-		for id := range m.removedlists {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PriceObservationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
+func (m *PriceObservationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedstore {
-		edges = append(edges, item.EdgeStore)
+	if m.cleareditem {
+		edges = append(edges, priceobservation.EdgeItem)
 	}
-	if m.clearedlists {
-		edges = append(edges, item.EdgeLists)
+	if m.clearedimport_run {
+		edges = append(edges, priceobservation.EdgeImportRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
+func (m *PriceObservationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case item.EdgeStore:
-		return m.clearedstore
-	case item.EdgeLists:
-		return m.clearedlists
+	case priceobservation.EdgeItem:
+		return m.cleareditem
+	case priceobservation.EdgeImportRun:
+		return m.clearedimport_run
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *PriceObservationMutation) ClearEdge(name string) error {
 	switch name {
-	case item.EdgeStore:
-		m.ClearStore()
+	case priceobservation.EdgeItem:
+		m.ClearItem()
+		return nil
+	case priceobservation.EdgeImportRun:
+		m.ClearImportRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown PriceObservation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *PriceObservationMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgeStore:
-		m.ResetStore()
+	case priceobservation.EdgeItem:
+		m.ResetItem()
 		return nil
-	case item.EdgeLists:
-		m.ResetLists()
+	case priceobservation.EdgeImportRun:
+		m.ResetImportRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown PriceObservation edge %s", name)
 }
 
-// ListMutation represents an operation that mutates the List nodes in the graph.
-type ListMutation struct {
+// ProductMutation represents an operation that mutates the Product nodes in the graph.
+type ProductMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	name          *string
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	items         map[int]struct{}
-	removeditems  map[int]struct{}
-	cleareditems  bool
-	done          bool
-	oldValue      func(context.Context) (*List, error)
-	predicates    []predicate.List
-}
-
-var _ ent.Mutation = (*ListMutation)(nil)
-
-// listOption allows management of the mutation configuration using functional options.
-type listOption func(*ListMutation)
-
-// newListMutation creates new mutation for the List entity.
-func newListMutation(c config, op Op, opts ...listOption) *ListMutation {
-	m := &ListMutation{
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	name             *string
+	brand            *string
+	size_quantity    *float64
+	addsize_quantity *float64
+	size_unit        *product.SizeUnit
+	match_key        *string
+	clearedFields    map[string]struct{}
+	items            map[int]struct{}
+	removeditems     map[int]struct{}
+	cleareditems     bool
+	done             bool
+	oldValue         func(context.Context) (*Product, error)
+	predicates       []predicate.Product
+}
+
+var _ ent.Mutation = (*ProductMutation)(nil)
+
+// productOption allows management of the mutation configuration using functional options.
+type productOption func(*ProductMutation)
+
+// newProductMutation creates new mutation for the Product entity.
+func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation {
+	m := &ProductMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeList,
+		typ:           TypeProduct,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -802,20 +8004,20 @@ func newListMutation(c config, op Op, opts ...listOption) *ListMutation {
 	return m
 }
 
-// withListID sets the ID field of the mutation.
-func withListID(id int) listOption {
-	return func(m *ListMutation) {
+// withProductID sets the ID field of the mutation.
+func withProductID(id int) productOption {
+	return func(m *ProductMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *List
+			value *Product
 		)
-		m.oldValue = func(ctx context.Context) (*List, error) {
+		m.oldValue = func(ctx context.Context) (*Product, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().List.Get(ctx, id)
+					value, err = m.Client().Product.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -824,10 +8026,10 @@ func withListID(id int) listOption {
 	}
 }
 
-// withList sets the old List of the mutation.
-func withList(node *List) listOption {
-	return func(m *ListMutation) {
-		m.oldValue = func(context.Context) (*List, error) {
+// withProduct sets the old Product of the mutation.
+func withProduct(node *Product) productOption {
+	return func(m *ProductMutation) {
+		m.oldValue = func(context.Context) (*Product, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -836,7 +8038,7 @@ func withList(node *List) listOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ListMutation) Client() *Client {
+func (m ProductMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -844,7 +8046,7 @@ func (m ListMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ListMutation) Tx() (*Tx, error) {
+func (m ProductMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -855,7 +8057,7 @@ func (m ListMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ListMutation) ID() (id int, exists bool) {
+func (m *ProductMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -866,7 +8068,7 @@ func (m *ListMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ListMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ProductMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -875,19 +8077,19 @@ func (m *ListMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().List.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Product.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ListMutation) SetCreateTime(t time.Time) {
+func (m *ProductMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ListMutation) CreateTime() (r time.Time, exists bool) {
+func (m *ProductMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -895,10 +8097,10 @@ func (m *ListMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the List entity.
-// If the List object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ProductMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -913,17 +8115,17 @@ func (m *ListMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ListMutation) ResetCreateTime() {
+func (m *ProductMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ListMutation) SetUpdateTime(t time.Time) {
+func (m *ProductMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ListMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *ProductMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -931,10 +8133,10 @@ func (m *ListMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the List entity.
-// If the List object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *ProductMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -949,17 +8151,17 @@ func (m *ListMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ListMutation) ResetUpdateTime() {
+func (m *ProductMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetName sets the "name" field.
-func (m *ListMutation) SetName(s string) {
+func (m *ProductMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ListMutation) Name() (r string, exists bool) {
+func (m *ProductMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -967,10 +8169,10 @@ func (m *ListMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the List entity.
-// If the List object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ProductMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -985,51 +8187,216 @@ func (m *ListMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ListMutation) ResetName() {
+func (m *ProductMutation) ResetName() {
 	m.name = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *ListMutation) SetUserID(id int) {
-	m.user = &id
+// SetBrand sets the "brand" field.
+func (m *ProductMutation) SetBrand(s string) {
+	m.brand = &s
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *ListMutation) ClearUser() {
-	m.cleareduser = true
+// Brand returns the value of the "brand" field in the mutation.
+func (m *ProductMutation) Brand() (r string, exists bool) {
+	v := m.brand
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ListMutation) UserCleared() bool {
-	return m.cleareduser
+// OldBrand returns the old "brand" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldBrand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrand is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrand requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrand: %w", err)
+	}
+	return oldValue.Brand, nil
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *ListMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// ResetBrand resets all changes to the "brand" field.
+func (m *ProductMutation) ResetBrand() {
+	m.brand = nil
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (m *ProductMutation) SetSizeQuantity(f float64) {
+	m.size_quantity = &f
+	m.addsize_quantity = nil
+}
+
+// SizeQuantity returns the value of the "size_quantity" field in the mutation.
+func (m *ProductMutation) SizeQuantity() (r float64, exists bool) {
+	v := m.size_quantity
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
+}
+
+// OldSizeQuantity returns the old "size_quantity" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSizeQuantity(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeQuantity: %w", err)
+	}
+	return oldValue.SizeQuantity, nil
+}
+
+// AddSizeQuantity adds f to the "size_quantity" field.
+func (m *ProductMutation) AddSizeQuantity(f float64) {
+	if m.addsize_quantity != nil {
+		*m.addsize_quantity += f
+	} else {
+		m.addsize_quantity = &f
+	}
+}
+
+// AddedSizeQuantity returns the value that was added to the "size_quantity" field in this mutation.
+func (m *ProductMutation) AddedSizeQuantity() (r float64, exists bool) {
+	v := m.addsize_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (m *ProductMutation) ClearSizeQuantity() {
+	m.size_quantity = nil
+	m.addsize_quantity = nil
+	m.clearedFields[product.FieldSizeQuantity] = struct{}{}
+}
+
+// SizeQuantityCleared returns if the "size_quantity" field was cleared in this mutation.
+func (m *ProductMutation) SizeQuantityCleared() bool {
+	_, ok := m.clearedFields[product.FieldSizeQuantity]
+	return ok
+}
+
+// ResetSizeQuantity resets all changes to the "size_quantity" field.
+func (m *ProductMutation) ResetSizeQuantity() {
+	m.size_quantity = nil
+	m.addsize_quantity = nil
+	delete(m.clearedFields, product.FieldSizeQuantity)
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (m *ProductMutation) SetSizeUnit(pu product.SizeUnit) {
+	m.size_unit = &pu
+}
+
+// SizeUnit returns the value of the "size_unit" field in the mutation.
+func (m *ProductMutation) SizeUnit() (r product.SizeUnit, exists bool) {
+	v := m.size_unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeUnit returns the old "size_unit" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSizeUnit(ctx context.Context) (v *product.SizeUnit, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeUnit: %w", err)
+	}
+	return oldValue.SizeUnit, nil
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (m *ProductMutation) ClearSizeUnit() {
+	m.size_unit = nil
+	m.clearedFields[product.FieldSizeUnit] = struct{}{}
+}
+
+// SizeUnitCleared returns if the "size_unit" field was cleared in this mutation.
+func (m *ProductMutation) SizeUnitCleared() bool {
+	_, ok := m.clearedFields[product.FieldSizeUnit]
+	return ok
+}
+
+// ResetSizeUnit resets all changes to the "size_unit" field.
+func (m *ProductMutation) ResetSizeUnit() {
+	m.size_unit = nil
+	delete(m.clearedFields, product.FieldSizeUnit)
+}
+
+// SetMatchKey sets the "match_key" field.
+func (m *ProductMutation) SetMatchKey(s string) {
+	m.match_key = &s
+}
+
+// MatchKey returns the value of the "match_key" field in the mutation.
+func (m *ProductMutation) MatchKey() (r string, exists bool) {
+	v := m.match_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMatchKey returns the old "match_key" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldMatchKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatchKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatchKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatchKey: %w", err)
+	}
+	return oldValue.MatchKey, nil
+}
+
+// ClearMatchKey clears the value of the "match_key" field.
+func (m *ProductMutation) ClearMatchKey() {
+	m.match_key = nil
+	m.clearedFields[product.FieldMatchKey] = struct{}{}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ListMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// MatchKeyCleared returns if the "match_key" field was cleared in this mutation.
+func (m *ProductMutation) MatchKeyCleared() bool {
+	_, ok := m.clearedFields[product.FieldMatchKey]
+	return ok
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ListMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetMatchKey resets all changes to the "match_key" field.
+func (m *ProductMutation) ResetMatchKey() {
+	m.match_key = nil
+	delete(m.clearedFields, product.FieldMatchKey)
 }
 
 // AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *ListMutation) AddItemIDs(ids ...int) {
+func (m *ProductMutation) AddItemIDs(ids ...int) {
 	if m.items == nil {
 		m.items = make(map[int]struct{})
 	}
@@ -1039,17 +8406,17 @@ func (m *ListMutation) AddItemIDs(ids ...int) {
 }
 
 // ClearItems clears the "items" edge to the Item entity.
-func (m *ListMutation) ClearItems() {
+func (m *ProductMutation) ClearItems() {
 	m.cleareditems = true
 }
 
 // ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *ListMutation) ItemsCleared() bool {
+func (m *ProductMutation) ItemsCleared() bool {
 	return m.cleareditems
 }
 
 // RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *ListMutation) RemoveItemIDs(ids ...int) {
+func (m *ProductMutation) RemoveItemIDs(ids ...int) {
 	if m.removeditems == nil {
 		m.removeditems = make(map[int]struct{})
 	}
@@ -1060,7 +8427,7 @@ func (m *ListMutation) RemoveItemIDs(ids ...int) {
 }
 
 // RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *ListMutation) RemovedItemsIDs() (ids []int) {
+func (m *ProductMutation) RemovedItemsIDs() (ids []int) {
 	for id := range m.removeditems {
 		ids = append(ids, id)
 	}
@@ -1068,7 +8435,7 @@ func (m *ListMutation) RemovedItemsIDs() (ids []int) {
 }
 
 // ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *ListMutation) ItemsIDs() (ids []int) {
+func (m *ProductMutation) ItemsIDs() (ids []int) {
 	for id := range m.items {
 		ids = append(ids, id)
 	}
@@ -1076,21 +8443,21 @@ func (m *ListMutation) ItemsIDs() (ids []int) {
 }
 
 // ResetItems resets all changes to the "items" edge.
-func (m *ListMutation) ResetItems() {
+func (m *ProductMutation) ResetItems() {
 	m.items = nil
 	m.cleareditems = false
 	m.removeditems = nil
 }
 
-// Where appends a list predicates to the ListMutation builder.
-func (m *ListMutation) Where(ps ...predicate.List) {
+// Where appends a list predicates to the ProductMutation builder.
+func (m *ProductMutation) Where(ps ...predicate.Product) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ListMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProductMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ListMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.List, len(ps))
+func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Product, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1098,33 +8465,45 @@ func (m *ListMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ListMutation) Op() Op {
+func (m *ProductMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ListMutation) SetOp(op Op) {
+func (m *ProductMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (List).
-func (m *ListMutation) Type() string {
+// Type returns the node type of this mutation (Product).
+func (m *ProductMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ListMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *ProductMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.create_time != nil {
-		fields = append(fields, list.FieldCreateTime)
+		fields = append(fields, product.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, list.FieldUpdateTime)
+		fields = append(fields, product.FieldUpdateTime)
 	}
 	if m.name != nil {
-		fields = append(fields, list.FieldName)
+		fields = append(fields, product.FieldName)
+	}
+	if m.brand != nil {
+		fields = append(fields, product.FieldBrand)
+	}
+	if m.size_quantity != nil {
+		fields = append(fields, product.FieldSizeQuantity)
+	}
+	if m.size_unit != nil {
+		fields = append(fields, product.FieldSizeUnit)
+	}
+	if m.match_key != nil {
+		fields = append(fields, product.FieldMatchKey)
 	}
 	return fields
 }
@@ -1132,14 +8511,22 @@ func (m *ListMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ListMutation) Field(name string) (ent.Value, bool) {
+func (m *ProductMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case list.FieldCreateTime:
+	case product.FieldCreateTime:
 		return m.CreateTime()
-	case list.FieldUpdateTime:
+	case product.FieldUpdateTime:
 		return m.UpdateTime()
-	case list.FieldName:
+	case product.FieldName:
 		return m.Name()
+	case product.FieldBrand:
+		return m.Brand()
+	case product.FieldSizeQuantity:
+		return m.SizeQuantity()
+	case product.FieldSizeUnit:
+		return m.SizeUnit()
+	case product.FieldMatchKey:
+		return m.MatchKey()
 	}
 	return nil, false
 }
@@ -1147,127 +8534,204 @@ func (m *ListMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ListMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProductMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case list.FieldCreateTime:
+	case product.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case list.FieldUpdateTime:
+	case product.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case list.FieldName:
+	case product.FieldName:
 		return m.OldName(ctx)
+	case product.FieldBrand:
+		return m.OldBrand(ctx)
+	case product.FieldSizeQuantity:
+		return m.OldSizeQuantity(ctx)
+	case product.FieldSizeUnit:
+		return m.OldSizeUnit(ctx)
+	case product.FieldMatchKey:
+		return m.OldMatchKey(ctx)
 	}
-	return nil, fmt.Errorf("unknown List field %s", name)
+	return nil, fmt.Errorf("unknown Product field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ListMutation) SetField(name string, value ent.Value) error {
+func (m *ProductMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case list.FieldCreateTime:
+	case product.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case list.FieldUpdateTime:
+	case product.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case list.FieldName:
+	case product.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case product.FieldBrand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrand(v)
+		return nil
+	case product.FieldSizeQuantity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeQuantity(v)
+		return nil
+	case product.FieldSizeUnit:
+		v, ok := value.(product.SizeUnit)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeUnit(v)
+		return nil
+	case product.FieldMatchKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchKey(v)
+		return nil
 	}
-	return fmt.Errorf("unknown List field %s", name)
+	return fmt.Errorf("unknown Product field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ListMutation) AddedFields() []string {
-	return nil
+func (m *ProductMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_quantity != nil {
+		fields = append(fields, product.FieldSizeQuantity)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ListMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case product.FieldSizeQuantity:
+		return m.AddedSizeQuantity()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ListMutation) AddField(name string, value ent.Value) error {
+func (m *ProductMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case product.FieldSizeQuantity:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeQuantity(v)
+		return nil
 	}
-	return fmt.Errorf("unknown List numeric field %s", name)
+	return fmt.Errorf("unknown Product numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ListMutation) ClearedFields() []string {
-	return nil
+func (m *ProductMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(product.FieldSizeQuantity) {
+		fields = append(fields, product.FieldSizeQuantity)
+	}
+	if m.FieldCleared(product.FieldSizeUnit) {
+		fields = append(fields, product.FieldSizeUnit)
+	}
+	if m.FieldCleared(product.FieldMatchKey) {
+		fields = append(fields, product.FieldMatchKey)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ListMutation) FieldCleared(name string) bool {
+func (m *ProductMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ListMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown List nullable field %s", name)
+func (m *ProductMutation) ClearField(name string) error {
+	switch name {
+	case product.FieldSizeQuantity:
+		m.ClearSizeQuantity()
+		return nil
+	case product.FieldSizeUnit:
+		m.ClearSizeUnit()
+		return nil
+	case product.FieldMatchKey:
+		m.ClearMatchKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Product nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ListMutation) ResetField(name string) error {
+func (m *ProductMutation) ResetField(name string) error {
 	switch name {
-	case list.FieldCreateTime:
+	case product.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case list.FieldUpdateTime:
+	case product.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case list.FieldName:
+	case product.FieldName:
 		m.ResetName()
 		return nil
+	case product.FieldBrand:
+		m.ResetBrand()
+		return nil
+	case product.FieldSizeQuantity:
+		m.ResetSizeQuantity()
+		return nil
+	case product.FieldSizeUnit:
+		m.ResetSizeUnit()
+		return nil
+	case product.FieldMatchKey:
+		m.ResetMatchKey()
+		return nil
 	}
-	return fmt.Errorf("unknown List field %s", name)
+	return fmt.Errorf("unknown Product field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ListMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, list.EdgeUser)
-	}
+func (m *ProductMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.items != nil {
-		edges = append(edges, list.EdgeItems)
+		edges = append(edges, product.EdgeItems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ListMutation) AddedIDs(name string) []ent.Value {
+func (m *ProductMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case list.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case list.EdgeItems:
+	case product.EdgeItems:
 		ids := make([]ent.Value, 0, len(m.items))
 		for id := range m.items {
 			ids = append(ids, id)
@@ -1278,19 +8742,19 @@ func (m *ListMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ListMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ProductMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.removeditems != nil {
-		edges = append(edges, list.EdgeItems)
+		edges = append(edges, product.EdgeItems)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ListMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case list.EdgeItems:
+	case product.EdgeItems:
 		ids := make([]ent.Value, 0, len(m.removeditems))
 		for id := range m.removeditems {
 			ids = append(ids, id)
@@ -1301,24 +8765,19 @@ func (m *ListMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ListMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, list.EdgeUser)
-	}
+func (m *ProductMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareditems {
-		edges = append(edges, list.EdgeItems)
+		edges = append(edges, product.EdgeItems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ListMutation) EdgeCleared(name string) bool {
+func (m *ProductMutation) EdgeCleared(name string) bool {
 	switch name {
-	case list.EdgeUser:
-		return m.cleareduser
-	case list.EdgeItems:
+	case product.EdgeItems:
 		return m.cleareditems
 	}
 	return false
@@ -1326,44 +8785,41 @@ func (m *ListMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ListMutation) ClearEdge(name string) error {
+func (m *ProductMutation) ClearEdge(name string) error {
 	switch name {
-	case list.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown List unique edge %s", name)
+	return fmt.Errorf("unknown Product unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ListMutation) ResetEdge(name string) error {
+func (m *ProductMutation) ResetEdge(name string) error {
 	switch name {
-	case list.EdgeUser:
-		m.ResetUser()
-		return nil
-	case list.EdgeItems:
+	case product.EdgeItems:
 		m.ResetItems()
 		return nil
 	}
-	return fmt.Errorf("unknown List edge %s", name)
+	return fmt.Errorf("unknown Product edge %s", name)
 }
 
 // StoreMutation represents an operation that mutates the Store nodes in the graph.
 type StoreMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	store_id      *string
-	grocer        *store.Grocer
-	clearedFields map[string]struct{}
-	items         map[int]struct{}
-	removeditems  map[int]struct{}
-	cleareditems  bool
-	done          bool
-	oldValue      func(context.Context) (*Store, error)
-	predicates    []predicate.Store
+	op                 Op
+	typ                string
+	id                 *int
+	store_id           *string
+	grocer             *store.Grocer
+	clearedFields      map[string]struct{}
+	items              map[int]struct{}
+	removeditems       map[int]struct{}
+	cleareditems       bool
+	import_runs        map[int]struct{}
+	removedimport_runs map[int]struct{}
+	clearedimport_runs bool
+	done               bool
+	oldValue           func(context.Context) (*Store, error)
+	predicates         []predicate.Store
 }
 
 var _ ent.Mutation = (*StoreMutation)(nil)
@@ -1590,6 +9046,60 @@ func (m *StoreMutation) ResetItems() {
 	m.removeditems = nil
 }
 
+// AddImportRunIDs adds the "import_runs" edge to the ImportRun entity by ids.
+func (m *StoreMutation) AddImportRunIDs(ids ...int) {
+	if m.import_runs == nil {
+		m.import_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.import_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImportRuns clears the "import_runs" edge to the ImportRun entity.
+func (m *StoreMutation) ClearImportRuns() {
+	m.clearedimport_runs = true
+}
+
+// ImportRunsCleared reports if the "import_runs" edge to the ImportRun entity was cleared.
+func (m *StoreMutation) ImportRunsCleared() bool {
+	return m.clearedimport_runs
+}
+
+// RemoveImportRunIDs removes the "import_runs" edge to the ImportRun entity by IDs.
+func (m *StoreMutation) RemoveImportRunIDs(ids ...int) {
+	if m.removedimport_runs == nil {
+		m.removedimport_runs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.import_runs, ids[i])
+		m.removedimport_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImportRuns returns the removed IDs of the "import_runs" edge to the ImportRun entity.
+func (m *StoreMutation) RemovedImportRunsIDs() (ids []int) {
+	for id := range m.removedimport_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ImportRunsIDs returns the "import_runs" edge IDs in the mutation.
+func (m *StoreMutation) ImportRunsIDs() (ids []int) {
+	for id := range m.import_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetImportRuns resets all changes to the "import_runs" edge.
+func (m *StoreMutation) ResetImportRuns() {
+	m.import_runs = nil
+	m.clearedimport_runs = false
+	m.removedimport_runs = nil
+}
+
 // Where appends a list predicates to the StoreMutation builder.
 func (m *StoreMutation) Where(ps ...predicate.Store) {
 	m.predicates = append(m.predicates, ps...)
@@ -1740,10 +9250,13 @@ func (m *StoreMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *StoreMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.items != nil {
 		edges = append(edges, store.EdgeItems)
 	}
+	if m.import_runs != nil {
+		edges = append(edges, store.EdgeImportRuns)
+	}
 	return edges
 }
 
@@ -1757,16 +9270,25 @@ func (m *StoreMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case store.EdgeImportRuns:
+		ids := make([]ent.Value, 0, len(m.import_runs))
+		for id := range m.import_runs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *StoreMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.removeditems != nil {
 		edges = append(edges, store.EdgeItems)
 	}
+	if m.removedimport_runs != nil {
+		edges = append(edges, store.EdgeImportRuns)
+	}
 	return edges
 }
 
@@ -1780,16 +9302,25 @@ func (m *StoreMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case store.EdgeImportRuns:
+		ids := make([]ent.Value, 0, len(m.removedimport_runs))
+		for id := range m.removedimport_runs {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *StoreMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.cleareditems {
 		edges = append(edges, store.EdgeItems)
 	}
+	if m.clearedimport_runs {
+		edges = append(edges, store.EdgeImportRuns)
+	}
 	return edges
 }
 
@@ -1799,6 +9330,8 @@ func (m *StoreMutation) EdgeCleared(name string) bool {
 	switch name {
 	case store.EdgeItems:
 		return m.cleareditems
+	case store.EdgeImportRuns:
+		return m.clearedimport_runs
 	}
 	return false
 }
@@ -1818,6 +9351,9 @@ func (m *StoreMutation) ResetEdge(name string) error {
 	case store.EdgeItems:
 		m.ResetItems()
 		return nil
+	case store.EdgeImportRuns:
+		m.ResetImportRuns()
+		return nil
 	}
 	return fmt.Errorf("unknown Store edge %s", name)
 }
@@ -1825,20 +9361,21 @@ func (m *StoreMutation) ResetEdge(name string) error {
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	create_time   *time.Time
-	update_time   *time.Time
-	email         *string
-	name          *string
-	clearedFields map[string]struct{}
-	lists         map[int]struct{}
-	removedlists  map[int]struct{}
-	clearedlists  bool
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op               Op
+	typ              string
+	id               *int
+	create_time      *time.Time
+	update_time      *time.Time
+	email            *string
+	name             *string
+	display_currency *string
+	clearedFields    map[string]struct{}
+	lists            map[int]struct{}
+	removedlists     map[int]struct{}
+	clearedlists     bool
+	done             bool
+	oldValue         func(context.Context) (*User, error)
+	predicates       []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -2083,6 +9620,55 @@ func (m *UserMutation) ResetName() {
 	m.name = nil
 }
 
+// SetDisplayCurrency sets the "display_currency" field.
+func (m *UserMutation) SetDisplayCurrency(s string) {
+	m.display_currency = &s
+}
+
+// DisplayCurrency returns the value of the "display_currency" field in the mutation.
+func (m *UserMutation) DisplayCurrency() (r string, exists bool) {
+	v := m.display_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayCurrency returns the old "display_currency" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDisplayCurrency(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayCurrency: %w", err)
+	}
+	return oldValue.DisplayCurrency, nil
+}
+
+// ClearDisplayCurrency clears the value of the "display_currency" field.
+func (m *UserMutation) ClearDisplayCurrency() {
+	m.display_currency = nil
+	m.clearedFields[user.FieldDisplayCurrency] = struct{}{}
+}
+
+// DisplayCurrencyCleared returns if the "display_currency" field was cleared in this mutation.
+func (m *UserMutation) DisplayCurrencyCleared() bool {
+	_, ok := m.clearedFields[user.FieldDisplayCurrency]
+	return ok
+}
+
+// ResetDisplayCurrency resets all changes to the "display_currency" field.
+func (m *UserMutation) ResetDisplayCurrency() {
+	m.display_currency = nil
+	delete(m.clearedFields, user.FieldDisplayCurrency)
+}
+
 // AddListIDs adds the "lists" edge to the List entity by ids.
 func (m *UserMutation) AddListIDs(ids ...int) {
 	if m.lists == nil {
@@ -2171,7 +9757,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
 		fields = append(fields, user.FieldCreateTime)
 	}
@@ -2184,6 +9770,9 @@ func (m *UserMutation) Fields() []string {
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
+	if m.display_currency != nil {
+		fields = append(fields, user.FieldDisplayCurrency)
+	}
 	return fields
 }
 
@@ -2200,6 +9789,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Email()
 	case user.FieldName:
 		return m.Name()
+	case user.FieldDisplayCurrency:
+		return m.DisplayCurrency()
 	}
 	return nil, false
 }
@@ -2217,6 +9808,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldEmail(ctx)
 	case user.FieldName:
 		return m.OldName(ctx)
+	case user.FieldDisplayCurrency:
+		return m.OldDisplayCurrency(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -2254,6 +9847,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case user.FieldDisplayCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayCurrency(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -2283,7 +9883,11 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldDisplayCurrency) {
+		fields = append(fields, user.FieldDisplayCurrency)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -2296,6 +9900,11 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldDisplayCurrency:
+		m.ClearDisplayCurrency()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
@@ -2315,6 +9924,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldName:
 		m.ResetName()
 		return nil
+	case user.FieldDisplayCurrency:
+		m.ResetDisplayCurrency()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
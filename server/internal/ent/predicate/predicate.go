@@ -6,12 +6,36 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Attribute is the predicate function for attribute builders.
+type Attribute func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// CategoryMapping is the predicate function for categorymapping builders.
+type CategoryMapping func(*sql.Selector)
+
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
+// ImportJob is the predicate function for importjob builders.
+type ImportJob func(*sql.Selector)
+
+// ImportRun is the predicate function for importrun builders.
+type ImportRun func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
 // List is the predicate function for list builders.
 type List func(*sql.Selector)
 
+// PriceObservation is the predicate function for priceobservation builders.
+type PriceObservation func(*sql.Selector)
+
+// Product is the predicate function for product builders.
+type Product func(*sql.Selector)
+
 // Store is the predicate function for store builders.
 type Store func(*sql.Selector)
 
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PriceObservation is the model entity for the PriceObservation schema.
+type PriceObservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The price in minor units of currency, e.g. cents.
+	PriceAmount int64 `json:"price_amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ObservedAt holds the value of the "observed_at" field.
+	ObservedAt time.Time `json:"observed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PriceObservationQuery when eager-loading is set.
+	Edges                         PriceObservationEdges `json:"edges"`
+	import_run_price_observations *int
+	item_price_observations       *int
+	selectValues                  sql.SelectValues
+}
+
+// PriceObservationEdges holds the relations/edges for other nodes in the graph.
+type PriceObservationEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// ImportRun holds the value of the import_run edge.
+	ImportRun *ImportRun `json:"import_run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PriceObservationEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// ImportRunOrErr returns the ImportRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PriceObservationEdges) ImportRunOrErr() (*ImportRun, error) {
+	if e.ImportRun != nil {
+		return e.ImportRun, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: importrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "import_run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceObservation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case priceobservation.FieldID, priceobservation.FieldPriceAmount:
+			values[i] = new(sql.NullInt64)
+		case priceobservation.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case priceobservation.FieldObservedAt:
+			values[i] = new(sql.NullTime)
+		case priceobservation.ForeignKeys[0]: // import_run_price_observations
+			values[i] = new(sql.NullInt64)
+		case priceobservation.ForeignKeys[1]: // item_price_observations
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceObservation fields.
+func (_m *PriceObservation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case priceobservation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case priceobservation.FieldPriceAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field price_amount", values[i])
+			} else if value.Valid {
+				_m.PriceAmount = value.Int64
+			}
+		case priceobservation.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case priceobservation.FieldObservedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field observed_at", values[i])
+			} else if value.Valid {
+				_m.ObservedAt = value.Time
+			}
+		case priceobservation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field import_run_price_observations", value)
+			} else if value.Valid {
+				_m.import_run_price_observations = new(int)
+				*_m.import_run_price_observations = int(value.Int64)
+			}
+		case priceobservation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field item_price_observations", value)
+			} else if value.Valid {
+				_m.item_price_observations = new(int)
+				*_m.item_price_observations = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceObservation.
+// This includes values selected through modifiers, order, etc.
+func (_m *PriceObservation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the PriceObservation entity.
+func (_m *PriceObservation) QueryItem() *ItemQuery {
+	return NewPriceObservationClient(_m.config).QueryItem(_m)
+}
+
+// QueryImportRun queries the "import_run" edge of the PriceObservation entity.
+func (_m *PriceObservation) QueryImportRun() *ImportRunQuery {
+	return NewPriceObservationClient(_m.config).QueryImportRun(_m)
+}
+
+// Update returns a builder for updating this PriceObservation.
+// Note that you need to call PriceObservation.Unwrap() before calling this method if this PriceObservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PriceObservation) Update() *PriceObservationUpdateOne {
+	return NewPriceObservationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PriceObservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PriceObservation) Unwrap() *PriceObservation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceObservation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PriceObservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceObservation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("price_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PriceAmount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("observed_at=")
+	builder.WriteString(_m.ObservedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceObservations is a parsable slice of PriceObservation.
+type PriceObservations []*PriceObservation
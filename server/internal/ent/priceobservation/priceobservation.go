@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package priceobservation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the priceobservation type in the database.
+	Label = "price_observation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPriceAmount holds the string denoting the price_amount field in the database.
+	FieldPriceAmount = "price_amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldObservedAt holds the string denoting the observed_at field in the database.
+	FieldObservedAt = "observed_at"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// EdgeImportRun holds the string denoting the import_run edge name in mutations.
+	EdgeImportRun = "import_run"
+	// Table holds the table name of the priceobservation in the database.
+	Table = "price_observations"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "price_observations"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "item_price_observations"
+	// ImportRunTable is the table that holds the import_run relation/edge.
+	ImportRunTable = "price_observations"
+	// ImportRunInverseTable is the table name for the ImportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "importrun" package.
+	ImportRunInverseTable = "import_runs"
+	// ImportRunColumn is the table column denoting the import_run relation/edge.
+	ImportRunColumn = "import_run_price_observations"
+)
+
+// Columns holds all SQL columns for priceobservation fields.
+var Columns = []string{
+	FieldID,
+	FieldPriceAmount,
+	FieldCurrency,
+	FieldObservedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "price_observations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"import_run_price_observations",
+	"item_price_observations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PriceAmountValidator is a validator for the "price_amount" field. It is called by the builders before save.
+	PriceAmountValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultObservedAt holds the default value on creation for the "observed_at" field.
+	DefaultObservedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PriceObservation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPriceAmount orders the results by the price_amount field.
+func ByPriceAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriceAmount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByObservedAt orders the results by the observed_at field.
+func ByObservedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObservedAt, opts...).ToFunc()
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByImportRunField orders the results by import_run field.
+func ByImportRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+	)
+}
+func newImportRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ImportRunTable, ImportRunColumn),
+	)
+}
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package priceobservation
+
+import (
+	"offgrocery-assessment/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldID, id))
+}
+
+// PriceAmount applies equality check predicate on the "price_amount" field. It's identical to PriceAmountEQ.
+func PriceAmount(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldPriceAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ObservedAt applies equality check predicate on the "observed_at" field. It's identical to ObservedAtEQ.
+func ObservedAt(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldObservedAt, v))
+}
+
+// PriceAmountEQ applies the EQ predicate on the "price_amount" field.
+func PriceAmountEQ(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldPriceAmount, v))
+}
+
+// PriceAmountNEQ applies the NEQ predicate on the "price_amount" field.
+func PriceAmountNEQ(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldPriceAmount, v))
+}
+
+// PriceAmountIn applies the In predicate on the "price_amount" field.
+func PriceAmountIn(vs ...int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldPriceAmount, vs...))
+}
+
+// PriceAmountNotIn applies the NotIn predicate on the "price_amount" field.
+func PriceAmountNotIn(vs ...int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldPriceAmount, vs...))
+}
+
+// PriceAmountGT applies the GT predicate on the "price_amount" field.
+func PriceAmountGT(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldPriceAmount, v))
+}
+
+// PriceAmountGTE applies the GTE predicate on the "price_amount" field.
+func PriceAmountGTE(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldPriceAmount, v))
+}
+
+// PriceAmountLT applies the LT predicate on the "price_amount" field.
+func PriceAmountLT(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldPriceAmount, v))
+}
+
+// PriceAmountLTE applies the LTE predicate on the "price_amount" field.
+func PriceAmountLTE(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldPriceAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ObservedAtEQ applies the EQ predicate on the "observed_at" field.
+func ObservedAtEQ(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldObservedAt, v))
+}
+
+// ObservedAtNEQ applies the NEQ predicate on the "observed_at" field.
+func ObservedAtNEQ(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldObservedAt, v))
+}
+
+// ObservedAtIn applies the In predicate on the "observed_at" field.
+func ObservedAtIn(vs ...time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldObservedAt, vs...))
+}
+
+// ObservedAtNotIn applies the NotIn predicate on the "observed_at" field.
+func ObservedAtNotIn(vs ...time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldObservedAt, vs...))
+}
+
+// ObservedAtGT applies the GT predicate on the "observed_at" field.
+func ObservedAtGT(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldObservedAt, v))
+}
+
+// ObservedAtGTE applies the GTE predicate on the "observed_at" field.
+func ObservedAtGTE(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldObservedAt, v))
+}
+
+// ObservedAtLT applies the LT predicate on the "observed_at" field.
+func ObservedAtLT(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldObservedAt, v))
+}
+
+// ObservedAtLTE applies the LTE predicate on the "observed_at" field.
+func ObservedAtLTE(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldObservedAt, v))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.PriceObservation {
+	return predicate.PriceObservation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.PriceObservation {
+	return predicate.PriceObservation(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImportRun applies the HasEdge predicate on the "import_run" edge.
+func HasImportRun() predicate.PriceObservation {
+	return predicate.PriceObservation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ImportRunTable, ImportRunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportRunWith applies the HasEdge predicate on the "import_run" edge with a given conditions (other predicates).
+func HasImportRunWith(preds ...predicate.ImportRun) predicate.PriceObservation {
+	return predicate.PriceObservation(func(s *sql.Selector) {
+		step := newImportRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceObservation) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceObservation) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceObservation) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.NotPredicates(p))
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceObservationCreate is the builder for creating a PriceObservation entity.
+type PriceObservationCreate struct {
+	config
+	mutation *PriceObservationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_c *PriceObservationCreate) SetPriceAmount(v int64) *PriceObservationCreate {
+	_c.mutation.SetPriceAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PriceObservationCreate) SetCurrency(v string) *PriceObservationCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableCurrency(v *string) *PriceObservationCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetObservedAt sets the "observed_at" field.
+func (_c *PriceObservationCreate) SetObservedAt(v time.Time) *PriceObservationCreate {
+	_c.mutation.SetObservedAt(v)
+	return _c
+}
+
+// SetNillableObservedAt sets the "observed_at" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableObservedAt(v *time.Time) *PriceObservationCreate {
+	if v != nil {
+		_c.SetObservedAt(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *PriceObservationCreate) SetItemID(id int) *PriceObservationCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *PriceObservationCreate) SetItem(v *Item) *PriceObservationCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by ID.
+func (_c *PriceObservationCreate) SetImportRunID(id int) *PriceObservationCreate {
+	_c.mutation.SetImportRunID(id)
+	return _c
+}
+
+// SetNillableImportRunID sets the "import_run" edge to the ImportRun entity by ID if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableImportRunID(id *int) *PriceObservationCreate {
+	if id != nil {
+		_c = _c.SetImportRunID(*id)
+	}
+	return _c
+}
+
+// SetImportRun sets the "import_run" edge to the ImportRun entity.
+func (_c *PriceObservationCreate) SetImportRun(v *ImportRun) *PriceObservationCreate {
+	return _c.SetImportRunID(v.ID)
+}
+
+// Mutation returns the PriceObservationMutation object of the builder.
+func (_c *PriceObservationCreate) Mutation() *PriceObservationMutation {
+	return _c.mutation
+}
+
+// Save creates the PriceObservation in the database.
+func (_c *PriceObservationCreate) Save(ctx context.Context) (*PriceObservation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PriceObservationCreate) SaveX(ctx context.Context) *PriceObservation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PriceObservationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PriceObservationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PriceObservationCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := priceobservation.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ObservedAt(); !ok {
+		v := priceobservation.DefaultObservedAt()
+		_c.mutation.SetObservedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PriceObservationCreate) check() error {
+	if _, ok := _c.mutation.PriceAmount(); !ok {
+		return &ValidationError{Name: "price_amount", err: errors.New(`ent: missing required field "PriceObservation.price_amount"`)}
+	}
+	if v, ok := _c.mutation.PriceAmount(); ok {
+		if err := priceobservation.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.price_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PriceObservation.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := priceobservation.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ObservedAt(); !ok {
+		return &ValidationError{Name: "observed_at", err: errors.New(`ent: missing required field "PriceObservation.observed_at"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "PriceObservation.item"`)}
+	}
+	return nil
+}
+
+func (_c *PriceObservationCreate) sqlSave(ctx context.Context) (*PriceObservation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PriceObservationCreate) createSpec() (*PriceObservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceObservation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(priceobservation.Table, sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.PriceAmount(); ok {
+		_spec.SetField(priceobservation.FieldPriceAmount, field.TypeInt64, value)
+		_node.PriceAmount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(priceobservation.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ObservedAt(); ok {
+		_spec.SetField(priceobservation.FieldObservedAt, field.TypeTime, value)
+		_node.ObservedAt = value
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ItemTable,
+			Columns: []string{priceobservation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.item_price_observations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ImportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ImportRunTable,
+			Columns: []string{priceobservation.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.import_run_price_observations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PriceObservation.Create().
+//		SetPriceAmount(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PriceObservationUpsert) {
+//			SetPriceAmount(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PriceObservationCreate) OnConflict(opts ...sql.ConflictOption) *PriceObservationUpsertOne {
+	_c.conflict = opts
+	return &PriceObservationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PriceObservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PriceObservationCreate) OnConflictColumns(columns ...string) *PriceObservationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PriceObservationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PriceObservationUpsertOne is the builder for "upsert"-ing
+	//  one PriceObservation node.
+	PriceObservationUpsertOne struct {
+		create *PriceObservationCreate
+	}
+
+	// PriceObservationUpsert is the "OnConflict" setter.
+	PriceObservationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *PriceObservationUpsert) SetPriceAmount(v int64) *PriceObservationUpsert {
+	u.Set(priceobservation.FieldPriceAmount, v)
+	return u
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *PriceObservationUpsert) UpdatePriceAmount() *PriceObservationUpsert {
+	u.SetExcluded(priceobservation.FieldPriceAmount)
+	return u
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *PriceObservationUpsert) AddPriceAmount(v int64) *PriceObservationUpsert {
+	u.Add(priceobservation.FieldPriceAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PriceObservationUpsert) SetCurrency(v string) *PriceObservationUpsert {
+	u.Set(priceobservation.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PriceObservationUpsert) UpdateCurrency() *PriceObservationUpsert {
+	u.SetExcluded(priceobservation.FieldCurrency)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PriceObservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PriceObservationUpsertOne) UpdateNewValues() *PriceObservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ObservedAt(); exists {
+			s.SetIgnore(priceobservation.FieldObservedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PriceObservation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PriceObservationUpsertOne) Ignore() *PriceObservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PriceObservationUpsertOne) DoNothing() *PriceObservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PriceObservationCreate.OnConflict
+// documentation for more info.
+func (u *PriceObservationUpsertOne) Update(set func(*PriceObservationUpsert)) *PriceObservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PriceObservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *PriceObservationUpsertOne) SetPriceAmount(v int64) *PriceObservationUpsertOne {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.SetPriceAmount(v)
+	})
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *PriceObservationUpsertOne) AddPriceAmount(v int64) *PriceObservationUpsertOne {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.AddPriceAmount(v)
+	})
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *PriceObservationUpsertOne) UpdatePriceAmount() *PriceObservationUpsertOne {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.UpdatePriceAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PriceObservationUpsertOne) SetCurrency(v string) *PriceObservationUpsertOne {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PriceObservationUpsertOne) UpdateCurrency() *PriceObservationUpsertOne {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// Exec executes the query.
+func (u *PriceObservationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PriceObservationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PriceObservationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PriceObservationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PriceObservationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PriceObservationCreateBulk is the builder for creating many PriceObservation entities in bulk.
+type PriceObservationCreateBulk struct {
+	config
+	err      error
+	builders []*PriceObservationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PriceObservation entities in the database.
+func (_c *PriceObservationCreateBulk) Save(ctx context.Context) ([]*PriceObservation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PriceObservation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceObservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PriceObservationCreateBulk) SaveX(ctx context.Context) []*PriceObservation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PriceObservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PriceObservationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PriceObservation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PriceObservationUpsert) {
+//			SetPriceAmount(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PriceObservationCreateBulk) OnConflict(opts ...sql.ConflictOption) *PriceObservationUpsertBulk {
+	_c.conflict = opts
+	return &PriceObservationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PriceObservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PriceObservationCreateBulk) OnConflictColumns(columns ...string) *PriceObservationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PriceObservationUpsertBulk{
+		create: _c,
+	}
+}
+
+// PriceObservationUpsertBulk is the builder for "upsert"-ing
+// a bulk of PriceObservation nodes.
+type PriceObservationUpsertBulk struct {
+	create *PriceObservationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PriceObservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PriceObservationUpsertBulk) UpdateNewValues() *PriceObservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ObservedAt(); exists {
+				s.SetIgnore(priceobservation.FieldObservedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PriceObservation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PriceObservationUpsertBulk) Ignore() *PriceObservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PriceObservationUpsertBulk) DoNothing() *PriceObservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PriceObservationCreateBulk.OnConflict
+// documentation for more info.
+func (u *PriceObservationUpsertBulk) Update(set func(*PriceObservationUpsert)) *PriceObservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PriceObservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (u *PriceObservationUpsertBulk) SetPriceAmount(v int64) *PriceObservationUpsertBulk {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.SetPriceAmount(v)
+	})
+}
+
+// AddPriceAmount adds v to the "price_amount" field.
+func (u *PriceObservationUpsertBulk) AddPriceAmount(v int64) *PriceObservationUpsertBulk {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.AddPriceAmount(v)
+	})
+}
+
+// UpdatePriceAmount sets the "price_amount" field to the value that was provided on create.
+func (u *PriceObservationUpsertBulk) UpdatePriceAmount() *PriceObservationUpsertBulk {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.UpdatePriceAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *PriceObservationUpsertBulk) SetCurrency(v string) *PriceObservationUpsertBulk {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *PriceObservationUpsertBulk) UpdateCurrency() *PriceObservationUpsertBulk {
+	return u.Update(func(s *PriceObservationUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// Exec executes the query.
+func (u *PriceObservationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PriceObservationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PriceObservationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PriceObservationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
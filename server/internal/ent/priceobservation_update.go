@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/predicate"
+	"offgrocery-assessment/internal/ent/priceobservation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceObservationUpdate is the builder for updating PriceObservation entities.
+type PriceObservationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PriceObservationMutation
+}
+
+// Where appends a list predicates to the PriceObservationUpdate builder.
+func (_u *PriceObservationUpdate) Where(ps ...predicate.PriceObservation) *PriceObservationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_u *PriceObservationUpdate) SetPriceAmount(v int64) *PriceObservationUpdate {
+	_u.mutation.ResetPriceAmount()
+	_u.mutation.SetPriceAmount(v)
+	return _u
+}
+
+// SetNillablePriceAmount sets the "price_amount" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillablePriceAmount(v *int64) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetPriceAmount(*v)
+	}
+	return _u
+}
+
+// AddPriceAmount adds value to the "price_amount" field.
+func (_u *PriceObservationUpdate) AddPriceAmount(v int64) *PriceObservationUpdate {
+	_u.mutation.AddPriceAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PriceObservationUpdate) SetCurrency(v string) *PriceObservationUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableCurrency(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *PriceObservationUpdate) SetItemID(id int) *PriceObservationUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *PriceObservationUpdate) SetItem(v *Item) *PriceObservationUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by ID.
+func (_u *PriceObservationUpdate) SetImportRunID(id int) *PriceObservationUpdate {
+	_u.mutation.SetImportRunID(id)
+	return _u
+}
+
+// SetNillableImportRunID sets the "import_run" edge to the ImportRun entity by ID if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableImportRunID(id *int) *PriceObservationUpdate {
+	if id != nil {
+		_u = _u.SetImportRunID(*id)
+	}
+	return _u
+}
+
+// SetImportRun sets the "import_run" edge to the ImportRun entity.
+func (_u *PriceObservationUpdate) SetImportRun(v *ImportRun) *PriceObservationUpdate {
+	return _u.SetImportRunID(v.ID)
+}
+
+// Mutation returns the PriceObservationMutation object of the builder.
+func (_u *PriceObservationUpdate) Mutation() *PriceObservationMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *PriceObservationUpdate) ClearItem() *PriceObservationUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearImportRun clears the "import_run" edge to the ImportRun entity.
+func (_u *PriceObservationUpdate) ClearImportRun() *PriceObservationUpdate {
+	_u.mutation.ClearImportRun()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PriceObservationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PriceObservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PriceObservationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PriceObservationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PriceObservationUpdate) check() error {
+	if v, ok := _u.mutation.PriceAmount(); ok {
+		if err := priceobservation.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.price_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := priceobservation.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceObservation.item"`)
+	}
+	return nil
+}
+
+func (_u *PriceObservationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(priceobservation.Table, priceobservation.Columns, sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PriceAmount(); ok {
+		_spec.SetField(priceobservation.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceAmount(); ok {
+		_spec.AddField(priceobservation.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(priceobservation.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ItemTable,
+			Columns: []string{priceobservation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ItemTable,
+			Columns: []string{priceobservation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ImportRunTable,
+			Columns: []string{priceobservation.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ImportRunTable,
+			Columns: []string{priceobservation.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{priceobservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PriceObservationUpdateOne is the builder for updating a single PriceObservation entity.
+type PriceObservationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PriceObservationMutation
+}
+
+// SetPriceAmount sets the "price_amount" field.
+func (_u *PriceObservationUpdateOne) SetPriceAmount(v int64) *PriceObservationUpdateOne {
+	_u.mutation.ResetPriceAmount()
+	_u.mutation.SetPriceAmount(v)
+	return _u
+}
+
+// SetNillablePriceAmount sets the "price_amount" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillablePriceAmount(v *int64) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetPriceAmount(*v)
+	}
+	return _u
+}
+
+// AddPriceAmount adds value to the "price_amount" field.
+func (_u *PriceObservationUpdateOne) AddPriceAmount(v int64) *PriceObservationUpdateOne {
+	_u.mutation.AddPriceAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PriceObservationUpdateOne) SetCurrency(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableCurrency(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *PriceObservationUpdateOne) SetItemID(id int) *PriceObservationUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *PriceObservationUpdateOne) SetItem(v *Item) *PriceObservationUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetImportRunID sets the "import_run" edge to the ImportRun entity by ID.
+func (_u *PriceObservationUpdateOne) SetImportRunID(id int) *PriceObservationUpdateOne {
+	_u.mutation.SetImportRunID(id)
+	return _u
+}
+
+// SetNillableImportRunID sets the "import_run" edge to the ImportRun entity by ID if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableImportRunID(id *int) *PriceObservationUpdateOne {
+	if id != nil {
+		_u = _u.SetImportRunID(*id)
+	}
+	return _u
+}
+
+// SetImportRun sets the "import_run" edge to the ImportRun entity.
+func (_u *PriceObservationUpdateOne) SetImportRun(v *ImportRun) *PriceObservationUpdateOne {
+	return _u.SetImportRunID(v.ID)
+}
+
+// Mutation returns the PriceObservationMutation object of the builder.
+func (_u *PriceObservationUpdateOne) Mutation() *PriceObservationMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *PriceObservationUpdateOne) ClearItem() *PriceObservationUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearImportRun clears the "import_run" edge to the ImportRun entity.
+func (_u *PriceObservationUpdateOne) ClearImportRun() *PriceObservationUpdateOne {
+	_u.mutation.ClearImportRun()
+	return _u
+}
+
+// Where appends a list predicates to the PriceObservationUpdate builder.
+func (_u *PriceObservationUpdateOne) Where(ps ...predicate.PriceObservation) *PriceObservationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PriceObservationUpdateOne) Select(field string, fields ...string) *PriceObservationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PriceObservation entity.
+func (_u *PriceObservationUpdateOne) Save(ctx context.Context) (*PriceObservation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PriceObservationUpdateOne) SaveX(ctx context.Context) *PriceObservation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PriceObservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PriceObservationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PriceObservationUpdateOne) check() error {
+	if v, ok := _u.mutation.PriceAmount(); ok {
+		if err := priceobservation.PriceAmountValidator(v); err != nil {
+			return &ValidationError{Name: "price_amount", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.price_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := priceobservation.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PriceObservation.item"`)
+	}
+	return nil
+}
+
+func (_u *PriceObservationUpdateOne) sqlSave(ctx context.Context) (_node *PriceObservation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(priceobservation.Table, priceobservation.Columns, sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceObservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, priceobservation.FieldID)
+		for _, f := range fields {
+			if !priceobservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != priceobservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PriceAmount(); ok {
+		_spec.SetField(priceobservation.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPriceAmount(); ok {
+		_spec.AddField(priceobservation.FieldPriceAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(priceobservation.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ItemTable,
+			Columns: []string{priceobservation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ItemTable,
+			Columns: []string{priceobservation.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ImportRunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ImportRunTable,
+			Columns: []string{priceobservation.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   priceobservation.ImportRunTable,
+			Columns: []string{priceobservation.ImportRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PriceObservation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{priceobservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package product
+
+import (
+	"offgrocery-assessment/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldName, v))
+}
+
+// Brand applies equality check predicate on the "brand" field. It's identical to BrandEQ.
+func Brand(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldBrand, v))
+}
+
+// SizeQuantity applies equality check predicate on the "size_quantity" field. It's identical to SizeQuantityEQ.
+func SizeQuantity(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSizeQuantity, v))
+}
+
+// MatchKey applies equality check predicate on the "match_key" field. It's identical to MatchKeyEQ.
+func MatchKey(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldMatchKey, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldName, v))
+}
+
+// BrandEQ applies the EQ predicate on the "brand" field.
+func BrandEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldBrand, v))
+}
+
+// BrandNEQ applies the NEQ predicate on the "brand" field.
+func BrandNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldBrand, v))
+}
+
+// BrandIn applies the In predicate on the "brand" field.
+func BrandIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldBrand, vs...))
+}
+
+// BrandNotIn applies the NotIn predicate on the "brand" field.
+func BrandNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldBrand, vs...))
+}
+
+// BrandGT applies the GT predicate on the "brand" field.
+func BrandGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldBrand, v))
+}
+
+// BrandGTE applies the GTE predicate on the "brand" field.
+func BrandGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldBrand, v))
+}
+
+// BrandLT applies the LT predicate on the "brand" field.
+func BrandLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldBrand, v))
+}
+
+// BrandLTE applies the LTE predicate on the "brand" field.
+func BrandLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldBrand, v))
+}
+
+// BrandContains applies the Contains predicate on the "brand" field.
+func BrandContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldBrand, v))
+}
+
+// BrandHasPrefix applies the HasPrefix predicate on the "brand" field.
+func BrandHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldBrand, v))
+}
+
+// BrandHasSuffix applies the HasSuffix predicate on the "brand" field.
+func BrandHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldBrand, v))
+}
+
+// BrandEqualFold applies the EqualFold predicate on the "brand" field.
+func BrandEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldBrand, v))
+}
+
+// BrandContainsFold applies the ContainsFold predicate on the "brand" field.
+func BrandContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldBrand, v))
+}
+
+// SizeQuantityEQ applies the EQ predicate on the "size_quantity" field.
+func SizeQuantityEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSizeQuantity, v))
+}
+
+// SizeQuantityNEQ applies the NEQ predicate on the "size_quantity" field.
+func SizeQuantityNEQ(v float64) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSizeQuantity, v))
+}
+
+// SizeQuantityIn applies the In predicate on the "size_quantity" field.
+func SizeQuantityIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldSizeQuantity, vs...))
+}
+
+// SizeQuantityNotIn applies the NotIn predicate on the "size_quantity" field.
+func SizeQuantityNotIn(vs ...float64) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldSizeQuantity, vs...))
+}
+
+// SizeQuantityGT applies the GT predicate on the "size_quantity" field.
+func SizeQuantityGT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldSizeQuantity, v))
+}
+
+// SizeQuantityGTE applies the GTE predicate on the "size_quantity" field.
+func SizeQuantityGTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldSizeQuantity, v))
+}
+
+// SizeQuantityLT applies the LT predicate on the "size_quantity" field.
+func SizeQuantityLT(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldSizeQuantity, v))
+}
+
+// SizeQuantityLTE applies the LTE predicate on the "size_quantity" field.
+func SizeQuantityLTE(v float64) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldSizeQuantity, v))
+}
+
+// SizeQuantityIsNil applies the IsNil predicate on the "size_quantity" field.
+func SizeQuantityIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldSizeQuantity))
+}
+
+// SizeQuantityNotNil applies the NotNil predicate on the "size_quantity" field.
+func SizeQuantityNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldSizeQuantity))
+}
+
+// SizeUnitEQ applies the EQ predicate on the "size_unit" field.
+func SizeUnitEQ(v SizeUnit) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldSizeUnit, v))
+}
+
+// SizeUnitNEQ applies the NEQ predicate on the "size_unit" field.
+func SizeUnitNEQ(v SizeUnit) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldSizeUnit, v))
+}
+
+// SizeUnitIn applies the In predicate on the "size_unit" field.
+func SizeUnitIn(vs ...SizeUnit) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldSizeUnit, vs...))
+}
+
+// SizeUnitNotIn applies the NotIn predicate on the "size_unit" field.
+func SizeUnitNotIn(vs ...SizeUnit) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldSizeUnit, vs...))
+}
+
+// SizeUnitIsNil applies the IsNil predicate on the "size_unit" field.
+func SizeUnitIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldSizeUnit))
+}
+
+// SizeUnitNotNil applies the NotNil predicate on the "size_unit" field.
+func SizeUnitNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldSizeUnit))
+}
+
+// MatchKeyEQ applies the EQ predicate on the "match_key" field.
+func MatchKeyEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldMatchKey, v))
+}
+
+// MatchKeyNEQ applies the NEQ predicate on the "match_key" field.
+func MatchKeyNEQ(v string) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldMatchKey, v))
+}
+
+// MatchKeyIn applies the In predicate on the "match_key" field.
+func MatchKeyIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldMatchKey, vs...))
+}
+
+// MatchKeyNotIn applies the NotIn predicate on the "match_key" field.
+func MatchKeyNotIn(vs ...string) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldMatchKey, vs...))
+}
+
+// MatchKeyGT applies the GT predicate on the "match_key" field.
+func MatchKeyGT(v string) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldMatchKey, v))
+}
+
+// MatchKeyGTE applies the GTE predicate on the "match_key" field.
+func MatchKeyGTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldMatchKey, v))
+}
+
+// MatchKeyLT applies the LT predicate on the "match_key" field.
+func MatchKeyLT(v string) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldMatchKey, v))
+}
+
+// MatchKeyLTE applies the LTE predicate on the "match_key" field.
+func MatchKeyLTE(v string) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldMatchKey, v))
+}
+
+// MatchKeyContains applies the Contains predicate on the "match_key" field.
+func MatchKeyContains(v string) predicate.Product {
+	return predicate.Product(sql.FieldContains(FieldMatchKey, v))
+}
+
+// MatchKeyHasPrefix applies the HasPrefix predicate on the "match_key" field.
+func MatchKeyHasPrefix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasPrefix(FieldMatchKey, v))
+}
+
+// MatchKeyHasSuffix applies the HasSuffix predicate on the "match_key" field.
+func MatchKeyHasSuffix(v string) predicate.Product {
+	return predicate.Product(sql.FieldHasSuffix(FieldMatchKey, v))
+}
+
+// MatchKeyIsNil applies the IsNil predicate on the "match_key" field.
+func MatchKeyIsNil() predicate.Product {
+	return predicate.Product(sql.FieldIsNull(FieldMatchKey))
+}
+
+// MatchKeyNotNil applies the NotNil predicate on the "match_key" field.
+func MatchKeyNotNil() predicate.Product {
+	return predicate.Product(sql.FieldNotNull(FieldMatchKey))
+}
+
+// MatchKeyEqualFold applies the EqualFold predicate on the "match_key" field.
+func MatchKeyEqualFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldEqualFold(FieldMatchKey, v))
+}
+
+// MatchKeyContainsFold applies the ContainsFold predicate on the "match_key" field.
+func MatchKeyContainsFold(v string) predicate.Product {
+	return predicate.Product(sql.FieldContainsFold(FieldMatchKey, v))
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.Item) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Product) predicate.Product {
+	return predicate.Product(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Product) predicate.Product {
+	return predicate.Product(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Product) predicate.Product {
+	return predicate.Product(sql.NotPredicates(p))
+}
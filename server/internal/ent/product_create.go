@@ -0,0 +1,917 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/product"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductCreate is the builder for creating a Product entity.
+type ProductCreate struct {
+	config
+	mutation *ProductMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ProductCreate) SetCreateTime(v time.Time) *ProductCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableCreateTime(v *time.Time) *ProductCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ProductCreate) SetUpdateTime(v time.Time) *ProductCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableUpdateTime(v *time.Time) *ProductCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ProductCreate) SetName(v string) *ProductCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetBrand sets the "brand" field.
+func (_c *ProductCreate) SetBrand(v string) *ProductCreate {
+	_c.mutation.SetBrand(v)
+	return _c
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (_c *ProductCreate) SetSizeQuantity(v float64) *ProductCreate {
+	_c.mutation.SetSizeQuantity(v)
+	return _c
+}
+
+// SetNillableSizeQuantity sets the "size_quantity" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableSizeQuantity(v *float64) *ProductCreate {
+	if v != nil {
+		_c.SetSizeQuantity(*v)
+	}
+	return _c
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (_c *ProductCreate) SetSizeUnit(v product.SizeUnit) *ProductCreate {
+	_c.mutation.SetSizeUnit(v)
+	return _c
+}
+
+// SetNillableSizeUnit sets the "size_unit" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableSizeUnit(v *product.SizeUnit) *ProductCreate {
+	if v != nil {
+		_c.SetSizeUnit(*v)
+	}
+	return _c
+}
+
+// SetMatchKey sets the "match_key" field.
+func (_c *ProductCreate) SetMatchKey(v string) *ProductCreate {
+	_c.mutation.SetMatchKey(v)
+	return _c
+}
+
+// SetNillableMatchKey sets the "match_key" field if the given value is not nil.
+func (_c *ProductCreate) SetNillableMatchKey(v *string) *ProductCreate {
+	if v != nil {
+		_c.SetMatchKey(*v)
+	}
+	return _c
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_c *ProductCreate) AddItemIDs(ids ...int) *ProductCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (_c *ProductCreate) AddItems(v ...*Item) *ProductCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the ProductMutation object of the builder.
+func (_c *ProductCreate) Mutation() *ProductMutation {
+	return _c.mutation
+}
+
+// Save creates the Product in the database.
+func (_c *ProductCreate) Save(ctx context.Context) (*Product, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProductCreate) SaveX(ctx context.Context) *Product {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProductCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProductCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProductCreate) defaults() {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		v := product.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		v := product.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProductCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Product.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Product.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Product.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := product.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Brand(); !ok {
+		return &ValidationError{Name: "brand", err: errors.New(`ent: missing required field "Product.brand"`)}
+	}
+	if v, ok := _c.mutation.Brand(); ok {
+		if err := product.BrandValidator(v); err != nil {
+			return &ValidationError{Name: "brand", err: fmt.Errorf(`ent: validator failed for field "Product.brand": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SizeUnit(); ok {
+		if err := product.SizeUnitValidator(v); err != nil {
+			return &ValidationError{Name: "size_unit", err: fmt.Errorf(`ent: validator failed for field "Product.size_unit": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ProductCreate) sqlSave(ctx context.Context) (*Product, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Product{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(product.Table, sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(product.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(product.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(product.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Brand(); ok {
+		_spec.SetField(product.FieldBrand, field.TypeString, value)
+		_node.Brand = value
+	}
+	if value, ok := _c.mutation.SizeQuantity(); ok {
+		_spec.SetField(product.FieldSizeQuantity, field.TypeFloat64, value)
+		_node.SizeQuantity = &value
+	}
+	if value, ok := _c.mutation.SizeUnit(); ok {
+		_spec.SetField(product.FieldSizeUnit, field.TypeEnum, value)
+		_node.SizeUnit = &value
+	}
+	if value, ok := _c.mutation.MatchKey(); ok {
+		_spec.SetField(product.FieldMatchKey, field.TypeString, value)
+		_node.MatchKey = &value
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Product.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProductCreate) OnConflict(opts ...sql.ConflictOption) *ProductUpsertOne {
+	_c.conflict = opts
+	return &ProductUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Product.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProductCreate) OnConflictColumns(columns ...string) *ProductUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProductUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProductUpsertOne is the builder for "upsert"-ing
+	//  one Product node.
+	ProductUpsertOne struct {
+		create *ProductCreate
+	}
+
+	// ProductUpsert is the "OnConflict" setter.
+	ProductUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProductUpsert) SetUpdateTime(v time.Time) *ProductUpsert {
+	u.Set(product.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateUpdateTime() *ProductUpsert {
+	u.SetExcluded(product.FieldUpdateTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ProductUpsert) SetName(v string) *ProductUpsert {
+	u.Set(product.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateName() *ProductUpsert {
+	u.SetExcluded(product.FieldName)
+	return u
+}
+
+// SetBrand sets the "brand" field.
+func (u *ProductUpsert) SetBrand(v string) *ProductUpsert {
+	u.Set(product.FieldBrand, v)
+	return u
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateBrand() *ProductUpsert {
+	u.SetExcluded(product.FieldBrand)
+	return u
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (u *ProductUpsert) SetSizeQuantity(v float64) *ProductUpsert {
+	u.Set(product.FieldSizeQuantity, v)
+	return u
+}
+
+// UpdateSizeQuantity sets the "size_quantity" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateSizeQuantity() *ProductUpsert {
+	u.SetExcluded(product.FieldSizeQuantity)
+	return u
+}
+
+// AddSizeQuantity adds v to the "size_quantity" field.
+func (u *ProductUpsert) AddSizeQuantity(v float64) *ProductUpsert {
+	u.Add(product.FieldSizeQuantity, v)
+	return u
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (u *ProductUpsert) ClearSizeQuantity() *ProductUpsert {
+	u.SetNull(product.FieldSizeQuantity)
+	return u
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (u *ProductUpsert) SetSizeUnit(v product.SizeUnit) *ProductUpsert {
+	u.Set(product.FieldSizeUnit, v)
+	return u
+}
+
+// UpdateSizeUnit sets the "size_unit" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateSizeUnit() *ProductUpsert {
+	u.SetExcluded(product.FieldSizeUnit)
+	return u
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (u *ProductUpsert) ClearSizeUnit() *ProductUpsert {
+	u.SetNull(product.FieldSizeUnit)
+	return u
+}
+
+// SetMatchKey sets the "match_key" field.
+func (u *ProductUpsert) SetMatchKey(v string) *ProductUpsert {
+	u.Set(product.FieldMatchKey, v)
+	return u
+}
+
+// UpdateMatchKey sets the "match_key" field to the value that was provided on create.
+func (u *ProductUpsert) UpdateMatchKey() *ProductUpsert {
+	u.SetExcluded(product.FieldMatchKey)
+	return u
+}
+
+// ClearMatchKey clears the value of the "match_key" field.
+func (u *ProductUpsert) ClearMatchKey() *ProductUpsert {
+	u.SetNull(product.FieldMatchKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Product.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProductUpsertOne) UpdateNewValues() *ProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(product.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Product.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProductUpsertOne) Ignore() *ProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductUpsertOne) DoNothing() *ProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductCreate.OnConflict
+// documentation for more info.
+func (u *ProductUpsertOne) Update(set func(*ProductUpsert)) *ProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProductUpsertOne) SetUpdateTime(v time.Time) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateUpdateTime() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ProductUpsertOne) SetName(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateName() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBrand sets the "brand" field.
+func (u *ProductUpsertOne) SetBrand(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetBrand(v)
+	})
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateBrand() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateBrand()
+	})
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (u *ProductUpsertOne) SetSizeQuantity(v float64) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSizeQuantity(v)
+	})
+}
+
+// AddSizeQuantity adds v to the "size_quantity" field.
+func (u *ProductUpsertOne) AddSizeQuantity(v float64) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.AddSizeQuantity(v)
+	})
+}
+
+// UpdateSizeQuantity sets the "size_quantity" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateSizeQuantity() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSizeQuantity()
+	})
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (u *ProductUpsertOne) ClearSizeQuantity() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearSizeQuantity()
+	})
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (u *ProductUpsertOne) SetSizeUnit(v product.SizeUnit) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSizeUnit(v)
+	})
+}
+
+// UpdateSizeUnit sets the "size_unit" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateSizeUnit() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSizeUnit()
+	})
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (u *ProductUpsertOne) ClearSizeUnit() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearSizeUnit()
+	})
+}
+
+// SetMatchKey sets the "match_key" field.
+func (u *ProductUpsertOne) SetMatchKey(v string) *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetMatchKey(v)
+	})
+}
+
+// UpdateMatchKey sets the "match_key" field to the value that was provided on create.
+func (u *ProductUpsertOne) UpdateMatchKey() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateMatchKey()
+	})
+}
+
+// ClearMatchKey clears the value of the "match_key" field.
+func (u *ProductUpsertOne) ClearMatchKey() *ProductUpsertOne {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearMatchKey()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProductUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProductUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProductCreateBulk is the builder for creating many Product entities in bulk.
+type ProductCreateBulk struct {
+	config
+	err      error
+	builders []*ProductCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Product entities in the database.
+func (_c *ProductCreateBulk) Save(ctx context.Context) ([]*Product, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Product, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProductCreateBulk) SaveX(ctx context.Context) []*Product {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProductCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProductCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Product.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProductUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProductCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProductUpsertBulk {
+	_c.conflict = opts
+	return &ProductUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Product.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProductCreateBulk) OnConflictColumns(columns ...string) *ProductUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProductUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProductUpsertBulk is the builder for "upsert"-ing
+// a bulk of Product nodes.
+type ProductUpsertBulk struct {
+	create *ProductCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Product.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ProductUpsertBulk) UpdateNewValues() *ProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(product.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Product.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProductUpsertBulk) Ignore() *ProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProductUpsertBulk) DoNothing() *ProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProductCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProductUpsertBulk) Update(set func(*ProductUpsert)) *ProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProductUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProductUpsertBulk) SetUpdateTime(v time.Time) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateUpdateTime() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ProductUpsertBulk) SetName(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateName() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetBrand sets the "brand" field.
+func (u *ProductUpsertBulk) SetBrand(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetBrand(v)
+	})
+}
+
+// UpdateBrand sets the "brand" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateBrand() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateBrand()
+	})
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (u *ProductUpsertBulk) SetSizeQuantity(v float64) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSizeQuantity(v)
+	})
+}
+
+// AddSizeQuantity adds v to the "size_quantity" field.
+func (u *ProductUpsertBulk) AddSizeQuantity(v float64) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.AddSizeQuantity(v)
+	})
+}
+
+// UpdateSizeQuantity sets the "size_quantity" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateSizeQuantity() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSizeQuantity()
+	})
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (u *ProductUpsertBulk) ClearSizeQuantity() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearSizeQuantity()
+	})
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (u *ProductUpsertBulk) SetSizeUnit(v product.SizeUnit) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetSizeUnit(v)
+	})
+}
+
+// UpdateSizeUnit sets the "size_unit" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateSizeUnit() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateSizeUnit()
+	})
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (u *ProductUpsertBulk) ClearSizeUnit() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearSizeUnit()
+	})
+}
+
+// SetMatchKey sets the "match_key" field.
+func (u *ProductUpsertBulk) SetMatchKey(v string) *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.SetMatchKey(v)
+	})
+}
+
+// UpdateMatchKey sets the "match_key" field to the value that was provided on create.
+func (u *ProductUpsertBulk) UpdateMatchKey() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.UpdateMatchKey()
+	})
+}
+
+// ClearMatchKey clears the value of the "match_key" field.
+func (u *ProductUpsertBulk) ClearMatchKey() *ProductUpsertBulk {
+	return u.Update(func(s *ProductUpsert) {
+		s.ClearMatchKey()
+	})
+}
+
+// Exec executes the query.
+func (u *ProductUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProductCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProductCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProductUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/predicate"
+	"offgrocery-assessment/internal/ent/product"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProductUpdate is the builder for updating Product entities.
+type ProductUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductMutation
+}
+
+// Where appends a list predicates to the ProductUpdate builder.
+func (_u *ProductUpdate) Where(ps ...predicate.Product) *ProductUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ProductUpdate) SetUpdateTime(v time.Time) *ProductUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProductUpdate) SetName(v string) *ProductUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableName(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBrand sets the "brand" field.
+func (_u *ProductUpdate) SetBrand(v string) *ProductUpdate {
+	_u.mutation.SetBrand(v)
+	return _u
+}
+
+// SetNillableBrand sets the "brand" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableBrand(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetBrand(*v)
+	}
+	return _u
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (_u *ProductUpdate) SetSizeQuantity(v float64) *ProductUpdate {
+	_u.mutation.ResetSizeQuantity()
+	_u.mutation.SetSizeQuantity(v)
+	return _u
+}
+
+// SetNillableSizeQuantity sets the "size_quantity" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableSizeQuantity(v *float64) *ProductUpdate {
+	if v != nil {
+		_u.SetSizeQuantity(*v)
+	}
+	return _u
+}
+
+// AddSizeQuantity adds value to the "size_quantity" field.
+func (_u *ProductUpdate) AddSizeQuantity(v float64) *ProductUpdate {
+	_u.mutation.AddSizeQuantity(v)
+	return _u
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (_u *ProductUpdate) ClearSizeQuantity() *ProductUpdate {
+	_u.mutation.ClearSizeQuantity()
+	return _u
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (_u *ProductUpdate) SetSizeUnit(v product.SizeUnit) *ProductUpdate {
+	_u.mutation.SetSizeUnit(v)
+	return _u
+}
+
+// SetNillableSizeUnit sets the "size_unit" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableSizeUnit(v *product.SizeUnit) *ProductUpdate {
+	if v != nil {
+		_u.SetSizeUnit(*v)
+	}
+	return _u
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (_u *ProductUpdate) ClearSizeUnit() *ProductUpdate {
+	_u.mutation.ClearSizeUnit()
+	return _u
+}
+
+// SetMatchKey sets the "match_key" field.
+func (_u *ProductUpdate) SetMatchKey(v string) *ProductUpdate {
+	_u.mutation.SetMatchKey(v)
+	return _u
+}
+
+// SetNillableMatchKey sets the "match_key" field if the given value is not nil.
+func (_u *ProductUpdate) SetNillableMatchKey(v *string) *ProductUpdate {
+	if v != nil {
+		_u.SetMatchKey(*v)
+	}
+	return _u
+}
+
+// ClearMatchKey clears the value of the "match_key" field.
+func (_u *ProductUpdate) ClearMatchKey() *ProductUpdate {
+	_u.mutation.ClearMatchKey()
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_u *ProductUpdate) AddItemIDs(ids ...int) *ProductUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (_u *ProductUpdate) AddItems(v ...*Item) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the ProductMutation object of the builder.
+func (_u *ProductUpdate) Mutation() *ProductMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the Item entity.
+func (_u *ProductUpdate) ClearItems() *ProductUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (_u *ProductUpdate) RemoveItemIDs(ids ...int) *ProductUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (_u *ProductUpdate) RemoveItems(v ...*Item) *ProductUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProductUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProductUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProductUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProductUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProductUpdate) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := product.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProductUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := product.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Brand(); ok {
+		if err := product.BrandValidator(v); err != nil {
+			return &ValidationError{Name: "brand", err: fmt.Errorf(`ent: validator failed for field "Product.brand": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeUnit(); ok {
+		if err := product.SizeUnitValidator(v); err != nil {
+			return &ValidationError{Name: "size_unit", err: fmt.Errorf(`ent: validator failed for field "Product.size_unit": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProductUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(product.Table, product.Columns, sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(product.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(product.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Brand(); ok {
+		_spec.SetField(product.FieldBrand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeQuantity(); ok {
+		_spec.SetField(product.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeQuantity(); ok {
+		_spec.AddField(product.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.SizeQuantityCleared() {
+		_spec.ClearField(product.FieldSizeQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SizeUnit(); ok {
+		_spec.SetField(product.FieldSizeUnit, field.TypeEnum, value)
+	}
+	if _u.mutation.SizeUnitCleared() {
+		_spec.ClearField(product.FieldSizeUnit, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MatchKey(); ok {
+		_spec.SetField(product.FieldMatchKey, field.TypeString, value)
+	}
+	if _u.mutation.MatchKeyCleared() {
+		_spec.ClearField(product.FieldMatchKey, field.TypeString)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{product.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProductUpdateOne is the builder for updating a single Product entity.
+type ProductUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ProductUpdateOne) SetUpdateTime(v time.Time) *ProductUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProductUpdateOne) SetName(v string) *ProductUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableName(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetBrand sets the "brand" field.
+func (_u *ProductUpdateOne) SetBrand(v string) *ProductUpdateOne {
+	_u.mutation.SetBrand(v)
+	return _u
+}
+
+// SetNillableBrand sets the "brand" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableBrand(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetBrand(*v)
+	}
+	return _u
+}
+
+// SetSizeQuantity sets the "size_quantity" field.
+func (_u *ProductUpdateOne) SetSizeQuantity(v float64) *ProductUpdateOne {
+	_u.mutation.ResetSizeQuantity()
+	_u.mutation.SetSizeQuantity(v)
+	return _u
+}
+
+// SetNillableSizeQuantity sets the "size_quantity" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableSizeQuantity(v *float64) *ProductUpdateOne {
+	if v != nil {
+		_u.SetSizeQuantity(*v)
+	}
+	return _u
+}
+
+// AddSizeQuantity adds value to the "size_quantity" field.
+func (_u *ProductUpdateOne) AddSizeQuantity(v float64) *ProductUpdateOne {
+	_u.mutation.AddSizeQuantity(v)
+	return _u
+}
+
+// ClearSizeQuantity clears the value of the "size_quantity" field.
+func (_u *ProductUpdateOne) ClearSizeQuantity() *ProductUpdateOne {
+	_u.mutation.ClearSizeQuantity()
+	return _u
+}
+
+// SetSizeUnit sets the "size_unit" field.
+func (_u *ProductUpdateOne) SetSizeUnit(v product.SizeUnit) *ProductUpdateOne {
+	_u.mutation.SetSizeUnit(v)
+	return _u
+}
+
+// SetNillableSizeUnit sets the "size_unit" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableSizeUnit(v *product.SizeUnit) *ProductUpdateOne {
+	if v != nil {
+		_u.SetSizeUnit(*v)
+	}
+	return _u
+}
+
+// ClearSizeUnit clears the value of the "size_unit" field.
+func (_u *ProductUpdateOne) ClearSizeUnit() *ProductUpdateOne {
+	_u.mutation.ClearSizeUnit()
+	return _u
+}
+
+// SetMatchKey sets the "match_key" field.
+func (_u *ProductUpdateOne) SetMatchKey(v string) *ProductUpdateOne {
+	_u.mutation.SetMatchKey(v)
+	return _u
+}
+
+// SetNillableMatchKey sets the "match_key" field if the given value is not nil.
+func (_u *ProductUpdateOne) SetNillableMatchKey(v *string) *ProductUpdateOne {
+	if v != nil {
+		_u.SetMatchKey(*v)
+	}
+	return _u
+}
+
+// ClearMatchKey clears the value of the "match_key" field.
+func (_u *ProductUpdateOne) ClearMatchKey() *ProductUpdateOne {
+	_u.mutation.ClearMatchKey()
+	return _u
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by IDs.
+func (_u *ProductUpdateOne) AddItemIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the Item entity.
+func (_u *ProductUpdateOne) AddItems(v ...*Item) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the ProductMutation object of the builder.
+func (_u *ProductUpdateOne) Mutation() *ProductMutation {
+	return _u.mutation
+}
+
+// ClearItems clears all "items" edges to the Item entity.
+func (_u *ProductUpdateOne) ClearItems() *ProductUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to Item entities by IDs.
+func (_u *ProductUpdateOne) RemoveItemIDs(ids ...int) *ProductUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to Item entities.
+func (_u *ProductUpdateOne) RemoveItems(v ...*Item) *ProductUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ProductUpdate builder.
+func (_u *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProductUpdateOne) Select(field string, fields ...string) *ProductUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Product entity.
+func (_u *ProductUpdateOne) Save(ctx context.Context) (*Product, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProductUpdateOne) SaveX(ctx context.Context) *Product {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProductUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProductUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProductUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		v := product.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProductUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := product.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Product.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Brand(); ok {
+		if err := product.BrandValidator(v); err != nil {
+			return &ValidationError{Name: "brand", err: fmt.Errorf(`ent: validator failed for field "Product.brand": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeUnit(); ok {
+		if err := product.SizeUnitValidator(v); err != nil {
+			return &ValidationError{Name: "size_unit", err: fmt.Errorf(`ent: validator failed for field "Product.size_unit": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(product.Table, product.Columns, sqlgraph.NewFieldSpec(product.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Product.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, product.FieldID)
+		for _, f := range fields {
+			if !product.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != product.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(product.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(product.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Brand(); ok {
+		_spec.SetField(product.FieldBrand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeQuantity(); ok {
+		_spec.SetField(product.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeQuantity(); ok {
+		_spec.AddField(product.FieldSizeQuantity, field.TypeFloat64, value)
+	}
+	if _u.mutation.SizeQuantityCleared() {
+		_spec.ClearField(product.FieldSizeQuantity, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SizeUnit(); ok {
+		_spec.SetField(product.FieldSizeUnit, field.TypeEnum, value)
+	}
+	if _u.mutation.SizeUnitCleared() {
+		_spec.ClearField(product.FieldSizeUnit, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MatchKey(); ok {
+		_spec.SetField(product.FieldMatchKey, field.TypeString, value)
+	}
+	if _u.mutation.MatchKeyCleared() {
+		_spec.ClearField(product.FieldMatchKey, field.TypeString)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.ItemsTable,
+			Columns: []string{product.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Product{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{product.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,8 +3,16 @@
 package ent
 
 import (
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/ent/exchangerate"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/list"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	"offgrocery-assessment/internal/ent/product"
 	"offgrocery-assessment/internal/ent/schema"
 	"offgrocery-assessment/internal/ent/user"
 	"time"
@@ -14,6 +22,156 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	attributeFields := schema.Attribute{}.Fields()
+	_ = attributeFields
+	// attributeDescSlug is the schema descriptor for slug field.
+	attributeDescSlug := attributeFields[0].Descriptor()
+	// attribute.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	attribute.SlugValidator = attributeDescSlug.Validators[0].(func(string) error)
+	// attributeDescName is the schema descriptor for name field.
+	attributeDescName := attributeFields[1].Descriptor()
+	// attribute.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	attribute.NameValidator = attributeDescName.Validators[0].(func(string) error)
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[0].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	// categoryDescName is the schema descriptor for name field.
+	categoryDescName := categoryFields[1].Descriptor()
+	// category.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	category.NameValidator = categoryDescName.Validators[0].(func(string) error)
+	categorymappingFields := schema.CategoryMapping{}.Fields()
+	_ = categorymappingFields
+	// categorymappingDescRawValue is the schema descriptor for raw_value field.
+	categorymappingDescRawValue := categorymappingFields[1].Descriptor()
+	// categorymapping.RawValueValidator is a validator for the "raw_value" field. It is called by the builders before save.
+	categorymapping.RawValueValidator = categorymappingDescRawValue.Validators[0].(func(string) error)
+	exchangerateMixin := schema.ExchangeRate{}.Mixin()
+	exchangerateMixinFields0 := exchangerateMixin[0].Fields()
+	_ = exchangerateMixinFields0
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescCreateTime is the schema descriptor for create_time field.
+	exchangerateDescCreateTime := exchangerateMixinFields0[0].Descriptor()
+	// exchangerate.DefaultCreateTime holds the default value on creation for the create_time field.
+	exchangerate.DefaultCreateTime = exchangerateDescCreateTime.Default.(func() time.Time)
+	// exchangerateDescUpdateTime is the schema descriptor for update_time field.
+	exchangerateDescUpdateTime := exchangerateMixinFields0[1].Descriptor()
+	// exchangerate.DefaultUpdateTime holds the default value on creation for the update_time field.
+	exchangerate.DefaultUpdateTime = exchangerateDescUpdateTime.Default.(func() time.Time)
+	// exchangerate.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	exchangerate.UpdateDefaultUpdateTime = exchangerateDescUpdateTime.UpdateDefault.(func() time.Time)
+	// exchangerateDescBase is the schema descriptor for base field.
+	exchangerateDescBase := exchangerateFields[0].Descriptor()
+	// exchangerate.BaseValidator is a validator for the "base" field. It is called by the builders before save.
+	exchangerate.BaseValidator = func() func(string) error {
+		validators := exchangerateDescBase.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(base string) error {
+			for _, fn := range fns {
+				if err := fn(base); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exchangerateDescCurrency is the schema descriptor for currency field.
+	exchangerateDescCurrency := exchangerateFields[1].Descriptor()
+	// exchangerate.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	exchangerate.CurrencyValidator = func() func(string) error {
+		validators := exchangerateDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exchangerateDescRate is the schema descriptor for rate field.
+	exchangerateDescRate := exchangerateFields[2].Descriptor()
+	// exchangerate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	exchangerate.RateValidator = exchangerateDescRate.Validators[0].(func(float64) error)
+	importjobMixin := schema.ImportJob{}.Mixin()
+	importjobMixinFields0 := importjobMixin[0].Fields()
+	_ = importjobMixinFields0
+	importjobFields := schema.ImportJob{}.Fields()
+	_ = importjobFields
+	// importjobDescCreateTime is the schema descriptor for create_time field.
+	importjobDescCreateTime := importjobMixinFields0[0].Descriptor()
+	// importjob.DefaultCreateTime holds the default value on creation for the create_time field.
+	importjob.DefaultCreateTime = importjobDescCreateTime.Default.(func() time.Time)
+	// importjobDescUpdateTime is the schema descriptor for update_time field.
+	importjobDescUpdateTime := importjobMixinFields0[1].Descriptor()
+	// importjob.DefaultUpdateTime holds the default value on creation for the update_time field.
+	importjob.DefaultUpdateTime = importjobDescUpdateTime.Default.(func() time.Time)
+	// importjob.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	importjob.UpdateDefaultUpdateTime = importjobDescUpdateTime.UpdateDefault.(func() time.Time)
+	// importjobDescFileName is the schema descriptor for file_name field.
+	importjobDescFileName := importjobFields[0].Descriptor()
+	// importjob.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	importjob.FileNameValidator = importjobDescFileName.Validators[0].(func(string) error)
+	// importjobDescFilePath is the schema descriptor for file_path field.
+	importjobDescFilePath := importjobFields[1].Descriptor()
+	// importjob.FilePathValidator is a validator for the "file_path" field. It is called by the builders before save.
+	importjob.FilePathValidator = importjobDescFilePath.Validators[0].(func(string) error)
+	// importjobDescForce is the schema descriptor for force field.
+	importjobDescForce := importjobFields[3].Descriptor()
+	// importjob.DefaultForce holds the default value on creation for the force field.
+	importjob.DefaultForce = importjobDescForce.Default.(bool)
+	// importjobDescMaxErrors is the schema descriptor for max_errors field.
+	importjobDescMaxErrors := importjobFields[4].Descriptor()
+	// importjob.DefaultMaxErrors holds the default value on creation for the max_errors field.
+	importjob.DefaultMaxErrors = importjobDescMaxErrors.Default.(int)
+	// importjobDescRowsTotal is the schema descriptor for rows_total field.
+	importjobDescRowsTotal := importjobFields[6].Descriptor()
+	// importjob.DefaultRowsTotal holds the default value on creation for the rows_total field.
+	importjob.DefaultRowsTotal = importjobDescRowsTotal.Default.(int)
+	// importjobDescRowsProcessed is the schema descriptor for rows_processed field.
+	importjobDescRowsProcessed := importjobFields[7].Descriptor()
+	// importjob.DefaultRowsProcessed holds the default value on creation for the rows_processed field.
+	importjob.DefaultRowsProcessed = importjobDescRowsProcessed.Default.(int)
+	importrunFields := schema.ImportRun{}.Fields()
+	_ = importrunFields
+	// importrunDescFileName is the schema descriptor for file_name field.
+	importrunDescFileName := importrunFields[0].Descriptor()
+	// importrun.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	importrun.FileNameValidator = importrunDescFileName.Validators[0].(func(string) error)
+	// importrunDescSha256 is the schema descriptor for sha256 field.
+	importrunDescSha256 := importrunFields[1].Descriptor()
+	// importrun.Sha256Validator is a validator for the "sha256" field. It is called by the builders before save.
+	importrun.Sha256Validator = importrunDescSha256.Validators[0].(func(string) error)
+	// importrunDescStartedAt is the schema descriptor for started_at field.
+	importrunDescStartedAt := importrunFields[4].Descriptor()
+	// importrun.DefaultStartedAt holds the default value on creation for the started_at field.
+	importrun.DefaultStartedAt = importrunDescStartedAt.Default.(func() time.Time)
+	// importrunDescCreatedCount is the schema descriptor for created_count field.
+	importrunDescCreatedCount := importrunFields[6].Descriptor()
+	// importrun.DefaultCreatedCount holds the default value on creation for the created_count field.
+	importrun.DefaultCreatedCount = importrunDescCreatedCount.Default.(int)
+	// importrunDescUpdatedCount is the schema descriptor for updated_count field.
+	importrunDescUpdatedCount := importrunFields[7].Descriptor()
+	// importrun.DefaultUpdatedCount holds the default value on creation for the updated_count field.
+	importrun.DefaultUpdatedCount = importrunDescUpdatedCount.Default.(int)
+	// importrunDescDelistedCount is the schema descriptor for delisted_count field.
+	importrunDescDelistedCount := importrunFields[8].Descriptor()
+	// importrun.DefaultDelistedCount holds the default value on creation for the delisted_count field.
+	importrun.DefaultDelistedCount = importrunDescDelistedCount.Default.(int)
+	// importrunDescFailedCount is the schema descriptor for failed_count field.
+	importrunDescFailedCount := importrunFields[9].Descriptor()
+	// importrun.DefaultFailedCount holds the default value on creation for the failed_count field.
+	importrun.DefaultFailedCount = importrunDescFailedCount.Default.(int)
 	itemMixin := schema.Item{}.Mixin()
 	itemMixinFields0 := itemMixin[0].Fields()
 	_ = itemMixinFields0
@@ -37,10 +195,28 @@ func init() {
 	itemDescBrand := itemFields[1].Descriptor()
 	// item.BrandValidator is a validator for the "brand" field. It is called by the builders before save.
 	item.BrandValidator = itemDescBrand.Validators[0].(func(string) error)
-	// itemDescPrice is the schema descriptor for price field.
-	itemDescPrice := itemFields[2].Descriptor()
-	// item.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	item.PriceValidator = itemDescPrice.Validators[0].(func(float64) error)
+	// itemDescPriceAmount is the schema descriptor for price_amount field.
+	itemDescPriceAmount := itemFields[2].Descriptor()
+	// item.PriceAmountValidator is a validator for the "price_amount" field. It is called by the builders before save.
+	item.PriceAmountValidator = itemDescPriceAmount.Validators[0].(func(int64) error)
+	// itemDescCurrency is the schema descriptor for currency field.
+	itemDescCurrency := itemFields[3].Descriptor()
+	// item.DefaultCurrency holds the default value on creation for the currency field.
+	item.DefaultCurrency = itemDescCurrency.Default.(string)
+	// item.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	item.CurrencyValidator = itemDescCurrency.Validators[0].(func(string) error)
+	// itemDescProductPinned is the schema descriptor for product_pinned field.
+	itemDescProductPinned := itemFields[12].Descriptor()
+	// item.DefaultProductPinned holds the default value on creation for the product_pinned field.
+	item.DefaultProductPinned = itemDescProductPinned.Default.(bool)
+	// itemDescAttributesPinned is the schema descriptor for attributes_pinned field.
+	itemDescAttributesPinned := itemFields[13].Descriptor()
+	// item.DefaultAttributesPinned holds the default value on creation for the attributes_pinned field.
+	item.DefaultAttributesPinned = itemDescAttributesPinned.Default.(bool)
+	// itemDescAvailable is the schema descriptor for available field.
+	itemDescAvailable := itemFields[14].Descriptor()
+	// item.DefaultAvailable holds the default value on creation for the available field.
+	item.DefaultAvailable = itemDescAvailable.Default.(bool)
 	listMixin := schema.List{}.Mixin()
 	listMixinFields0 := listMixin[0].Fields()
 	_ = listMixinFields0
@@ -62,6 +238,45 @@ func init() {
 	list.DefaultName = listDescName.Default.(string)
 	// list.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	list.NameValidator = listDescName.Validators[0].(func(string) error)
+	priceobservationFields := schema.PriceObservation{}.Fields()
+	_ = priceobservationFields
+	// priceobservationDescPriceAmount is the schema descriptor for price_amount field.
+	priceobservationDescPriceAmount := priceobservationFields[0].Descriptor()
+	// priceobservation.PriceAmountValidator is a validator for the "price_amount" field. It is called by the builders before save.
+	priceobservation.PriceAmountValidator = priceobservationDescPriceAmount.Validators[0].(func(int64) error)
+	// priceobservationDescCurrency is the schema descriptor for currency field.
+	priceobservationDescCurrency := priceobservationFields[1].Descriptor()
+	// priceobservation.DefaultCurrency holds the default value on creation for the currency field.
+	priceobservation.DefaultCurrency = priceobservationDescCurrency.Default.(string)
+	// priceobservation.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	priceobservation.CurrencyValidator = priceobservationDescCurrency.Validators[0].(func(string) error)
+	// priceobservationDescObservedAt is the schema descriptor for observed_at field.
+	priceobservationDescObservedAt := priceobservationFields[2].Descriptor()
+	// priceobservation.DefaultObservedAt holds the default value on creation for the observed_at field.
+	priceobservation.DefaultObservedAt = priceobservationDescObservedAt.Default.(func() time.Time)
+	productMixin := schema.Product{}.Mixin()
+	productMixinFields0 := productMixin[0].Fields()
+	_ = productMixinFields0
+	productFields := schema.Product{}.Fields()
+	_ = productFields
+	// productDescCreateTime is the schema descriptor for create_time field.
+	productDescCreateTime := productMixinFields0[0].Descriptor()
+	// product.DefaultCreateTime holds the default value on creation for the create_time field.
+	product.DefaultCreateTime = productDescCreateTime.Default.(func() time.Time)
+	// productDescUpdateTime is the schema descriptor for update_time field.
+	productDescUpdateTime := productMixinFields0[1].Descriptor()
+	// product.DefaultUpdateTime holds the default value on creation for the update_time field.
+	product.DefaultUpdateTime = productDescUpdateTime.Default.(func() time.Time)
+	// product.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	product.UpdateDefaultUpdateTime = productDescUpdateTime.UpdateDefault.(func() time.Time)
+	// productDescName is the schema descriptor for name field.
+	productDescName := productFields[0].Descriptor()
+	// product.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	product.NameValidator = productDescName.Validators[0].(func(string) error)
+	// productDescBrand is the schema descriptor for brand field.
+	productDescBrand := productFields[1].Descriptor()
+	// product.BrandValidator is a validator for the "brand" field. It is called by the builders before save.
+	product.BrandValidator = productDescBrand.Validators[0].(func(string) error)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -85,4 +300,8 @@ func init() {
 	userDescName := userFields[1].Descriptor()
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescDisplayCurrency is the schema descriptor for display_currency field.
+	userDescDisplayCurrency := userFields[2].Descriptor()
+	// user.DisplayCurrencyValidator is a validator for the "display_currency" field. It is called by the builders before save.
+	user.DisplayCurrencyValidator = userDescDisplayCurrency.Validators[0].(func(string) error)
 }
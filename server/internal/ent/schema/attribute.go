@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Attribute holds the schema definition for the Attribute entity, a dietary
+// or sourcing tag such as organic or gluten-free that items can carry.
+type Attribute struct {
+	ent.Schema
+}
+
+// Fields of the Attribute.
+func (Attribute) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").
+			Unique().
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+	}
+}
+
+// Edges of the Attribute.
+func (Attribute) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("items", Item.Type).
+			Ref("attributes"),
+	}
+}
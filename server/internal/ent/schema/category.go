@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Category holds the schema definition for the Category entity, a node of
+// the taxonomy shared by every grocer.
+type Category struct {
+	ent.Schema
+}
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").
+			Unique().
+			NotEmpty(),
+		field.String("name").
+			NotEmpty(),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", Item.Type),
+		edge.To("mappings", CategoryMapping.Type),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CategoryMapping holds the schema definition for the CategoryMapping entity,
+// which maps a grocer's raw category or aisle label to a shared Category.
+type CategoryMapping struct {
+	ent.Schema
+}
+
+// Fields of the CategoryMapping.
+func (CategoryMapping) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("grocer").Values(
+			"store_a",
+			"store_b",
+			"store_c",
+		),
+		field.String("raw_value").
+			NotEmpty().
+			Comment("The grocer's label, lower-cased and trimmed."),
+	}
+}
+
+// Edges of the CategoryMapping.
+func (CategoryMapping) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("category", Category.Type).
+			Ref("mappings").
+			Unique().
+			Required(),
+	}
+}
+
+func (CategoryMapping) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("grocer", "raw_value").
+			Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity. Every
+// rate is quoted against the same base currency, which is replaced wholesale
+// when a new rate table is loaded.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("base").
+			MaxLen(3).
+			NotEmpty(),
+		field.String("currency").
+			MaxLen(3).
+			NotEmpty(),
+		field.Float("rate").
+			Positive().
+			Comment("Units of currency one unit of base buys."),
+	}
+}
+
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("base", "currency").
+			Unique(),
+	}
+}
+
+func (ExchangeRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ImportJob holds the schema definition for the ImportJob entity, a feed
+// uploaded over HTTP and waiting for, or going through, a background import.
+// Progress is tracked here rather than on the ImportRun because the run's row
+// is locked by the import transaction while it is written.
+type ImportJob struct {
+	ent.Schema
+}
+
+// Fields of the ImportJob.
+func (ImportJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("file_name").
+			NotEmpty().
+			Comment("The name the feed was uploaded under."),
+		field.String("file_path").
+			NotEmpty().
+			Sensitive().
+			Comment("Where the uploaded feed is spooled on the server until it is imported."),
+		field.String("format").
+			Optional().
+			Comment("The format hint given with the upload, empty to detect it."),
+		field.Bool("force").
+			Default(false),
+		field.Int("max_errors").
+			Default(10),
+		field.Enum("status").
+			Values(
+				"queued",
+				"running",
+				"succeeded",
+				"skipped",
+				"failed",
+			).
+			Default("queued").
+			Comment("skipped means identical contents were already imported."),
+		field.Int("rows_total").
+			Default(0),
+		field.Int("rows_processed").
+			Default(0),
+		field.Text("error").
+			Optional(),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.String("owner").
+			Optional().
+			Comment("The queue running the job, set when it is claimed."),
+		field.Time("lease_expires_at").
+			Optional().
+			Nillable().
+			Comment("When a running job may be claimed by another queue, unless its owner renews the lease first."),
+	}
+}
+
+// Edges of the ImportJob.
+func (ImportJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("import_run", ImportRun.Type).
+			Unique(),
+	}
+}
+
+func (ImportJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
+
+func (ImportJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Rejection is a feed row an import run skipped because it failed validation.
+type Rejection struct {
+	Index  int    `json:"index"`
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// ImportRun holds the schema definition for the ImportRun entity.
+type ImportRun struct {
+	ent.Schema
+}
+
+// Fields of the ImportRun.
+func (ImportRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("file_name").
+			NotEmpty(),
+		field.String("sha256").
+			NotEmpty().
+			Comment("Hex encoded SHA-256 of the imported file."),
+		field.String("format").
+			Optional(),
+		field.Enum("status").
+			Values(
+				"running",
+				"succeeded",
+				"failed",
+				"interrupted",
+			).
+			Default("running").
+			Comment("interrupted means the import was cancelled, by a shutdown or a lost job lease, and rolled back."),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Int("created_count").
+			Default(0),
+		field.Int("updated_count").
+			Default(0),
+		field.Int("delisted_count").
+			Default(0),
+		field.Int("failed_count").
+			Default(0),
+		field.Text("error").
+			Optional(),
+		field.JSON("rejections", []Rejection{}).
+			Optional().
+			Comment("The first rows skipped because they failed validation; failed_count counts them all."),
+	}
+}
+
+// Edges of the ImportRun.
+func (ImportRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("store", Store.Type).
+			Ref("import_runs").
+			Unique(),
+		edge.To("price_observations", PriceObservation.Type),
+	}
+}
+
+func (ImportRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sha256", "status"),
+	}
+}
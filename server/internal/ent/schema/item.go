@@ -21,8 +21,55 @@ func (Item) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("brand").
 			NotEmpty(),
-		field.Float("price").
-			Positive(),
+		field.Int64("price_amount").
+			Positive().
+			Comment("The price in minor units of currency, e.g. cents."),
+		field.String("currency").
+			MaxLen(3).
+			Default("CAD"),
+		field.String("external_id").
+			Optional().
+			Nillable().
+			Comment("The grocer's own SKU, barcode or product id for the item."),
+		field.String("aisle").
+			Optional().
+			Comment("The grocer's own category or aisle label, as shipped in the feed."),
+		field.Int("category_id").
+			Optional().
+			Nillable(),
+		field.String("unit").
+			Optional(),
+		field.Float("size_quantity").
+			Optional().
+			Nillable().
+			Comment("The package size, in size_unit."),
+		field.Enum("size_unit").
+			Values("g", "ml", "count").
+			Optional().
+			Nillable(),
+		field.Int64("unit_price_micros").
+			Optional().
+			Nillable().
+			Comment("The price per 100 g, per 100 ml or each, depending on size_unit, in millionths of the currency's major unit."),
+		field.Int("product_id").
+			Optional().
+			Nillable(),
+		field.Bool("product_pinned").
+			Default(false).
+			Comment("True once the item was assigned to its product by hand; the matcher leaves it alone."),
+		field.Bool("attributes_pinned").
+			Default(false).
+			Comment("True once the item's attributes were edited by hand; imports leave them alone."),
+		field.Bool("available").
+			Default(true).
+			Comment("False once the item is missing from its grocer's latest feed."),
+		field.Time("delisted_at").
+			Optional().
+			Nillable(),
+		field.Time("last_seen_at").
+			Optional().
+			Nillable().
+			Comment("When the item last appeared in an imported feed."),
 	}
 }
 
@@ -35,6 +82,16 @@ func (Item) Edges() []ent.Edge {
 			Required(),
 		edge.From("lists", List.Type).
 			Ref("items"),
+		edge.To("price_observations", PriceObservation.Type),
+		edge.From("category", Category.Type).
+			Ref("items").
+			Field("category_id").
+			Unique(),
+		edge.From("product", Product.Type).
+			Ref("items").
+			Field("product_id").
+			Unique(),
+		edge.To("attributes", Attribute.Type),
 	}
 }
 
@@ -44,6 +101,9 @@ func (Item) Indexes() []ent.Index {
 			Annotations(
 				entsql.IndexType("FULLTEXT"),
 			),
+		index.Fields("external_id").
+			Edges("store").
+			Unique(),
 	}
 }
 
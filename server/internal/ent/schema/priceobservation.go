@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PriceObservation holds the schema definition for the PriceObservation entity.
+type PriceObservation struct {
+	ent.Schema
+}
+
+// Fields of the PriceObservation.
+func (PriceObservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("price_amount").
+			Positive().
+			Comment("The price in minor units of currency, e.g. cents."),
+		field.String("currency").
+			MaxLen(3).
+			Default("CAD"),
+		field.Time("observed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PriceObservation.
+func (PriceObservation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("price_observations").
+			Unique().
+			Required(),
+		edge.From("import_run", ImportRun.Type).
+			Ref("price_observations").
+			Unique(),
+	}
+}
+
+func (PriceObservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("observed_at").
+			Edges("item"),
+	}
+}
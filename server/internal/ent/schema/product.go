@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Product holds the schema definition for the Product entity. A product
+// groups the equivalent items different stores sell.
+type Product struct {
+	ent.Schema
+}
+
+// Fields of the Product.
+func (Product) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("brand").
+			NotEmpty(),
+		field.Float("size_quantity").
+			Optional().
+			Nillable(),
+		field.Enum("size_unit").
+			Values("g", "ml", "count").
+			Optional().
+			Nillable(),
+		field.String("match_key").
+			Optional().
+			Nillable().
+			Comment("The normalized name and brand the matcher groups items by. Unset for products split off by hand, which the matcher never assigns items to."),
+	}
+}
+
+// Edges of the Product.
+func (Product) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("items", Item.Type),
+	}
+}
+
+func (Product) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("match_key"),
+	}
+}
+
+func (Product) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
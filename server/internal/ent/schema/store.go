@@ -27,5 +27,6 @@ func (Store) Fields() []ent.Field {
 func (Store) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("items", Item.Type),
+		edge.To("import_runs", ImportRun.Type),
 	}
 }
@@ -20,6 +20,11 @@ func (User) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("name").
 			NotEmpty(),
+		field.String("display_currency").
+			MaxLen(3).
+			Optional().
+			Nillable().
+			Comment("The currency prices are shown in on the user's lists, when set."),
 	}
 }
 
@@ -30,9 +30,11 @@ type Store struct {
 type StoreEdges struct {
 	// Items holds the value of the items edge.
 	Items []*Item `json:"items,omitempty"`
+	// ImportRuns holds the value of the import_runs edge.
+	ImportRuns []*ImportRun `json:"import_runs,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ItemsOrErr returns the Items value or an error if the edge
@@ -44,6 +46,15 @@ func (e StoreEdges) ItemsOrErr() ([]*Item, error) {
 	return nil, &NotLoadedError{edge: "items"}
 }
 
+// ImportRunsOrErr returns the ImportRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e StoreEdges) ImportRunsOrErr() ([]*ImportRun, error) {
+	if e.loadedTypes[1] {
+		return e.ImportRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "import_runs"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Store) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -104,6 +115,11 @@ func (_m *Store) QueryItems() *ItemQuery {
 	return NewStoreClient(_m.config).QueryItems(_m)
 }
 
+// QueryImportRuns queries the "import_runs" edge of the Store entity.
+func (_m *Store) QueryImportRuns() *ImportRunQuery {
+	return NewStoreClient(_m.config).QueryImportRuns(_m)
+}
+
 // Update returns a builder for updating this Store.
 // Note that you need to call Store.Unwrap() before calling this method if this Store
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -20,6 +20,8 @@ const (
 	FieldGrocer = "grocer"
 	// EdgeItems holds the string denoting the items edge name in mutations.
 	EdgeItems = "items"
+	// EdgeImportRuns holds the string denoting the import_runs edge name in mutations.
+	EdgeImportRuns = "import_runs"
 	// Table holds the table name of the store in the database.
 	Table = "stores"
 	// ItemsTable is the table that holds the items relation/edge.
@@ -29,6 +31,13 @@ const (
 	ItemsInverseTable = "items"
 	// ItemsColumn is the table column denoting the items relation/edge.
 	ItemsColumn = "store_items"
+	// ImportRunsTable is the table that holds the import_runs relation/edge.
+	ImportRunsTable = "import_runs"
+	// ImportRunsInverseTable is the table name for the ImportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "importrun" package.
+	ImportRunsInverseTable = "import_runs"
+	// ImportRunsColumn is the table column denoting the import_runs relation/edge.
+	ImportRunsColumn = "store_import_runs"
 )
 
 // Columns holds all SQL columns for store fields.
@@ -103,6 +112,20 @@ func ByItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByImportRunsCount orders the results by import_runs count.
+func ByImportRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newImportRunsStep(), opts...)
+	}
+}
+
+// ByImportRuns orders the results by import_runs terms.
+func ByImportRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newImportRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newItemsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -110,3 +133,10 @@ func newItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
 	)
 }
+func newImportRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ImportRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ImportRunsTable, ImportRunsColumn),
+	)
+}
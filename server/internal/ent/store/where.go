@@ -167,6 +167,29 @@ func HasItemsWith(preds ...predicate.Item) predicate.Store {
 	})
 }
 
+// HasImportRuns applies the HasEdge predicate on the "import_runs" edge.
+func HasImportRuns() predicate.Store {
+	return predicate.Store(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImportRunsTable, ImportRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImportRunsWith applies the HasEdge predicate on the "import_runs" edge with a given conditions (other predicates).
+func HasImportRunsWith(preds ...predicate.ImportRun) predicate.Store {
+	return predicate.Store(func(s *sql.Selector) {
+		step := newImportRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Store) predicate.Store {
 	return predicate.Store(sql.AndPredicates(predicates...))
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/store"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -18,6 +20,7 @@ type StoreCreate struct {
 	config
 	mutation *StoreMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetStoreID sets the "store_id" field.
@@ -47,6 +50,21 @@ func (_c *StoreCreate) AddItems(v ...*Item) *StoreCreate {
 	return _c.AddItemIDs(ids...)
 }
 
+// AddImportRunIDs adds the "import_runs" edge to the ImportRun entity by IDs.
+func (_c *StoreCreate) AddImportRunIDs(ids ...int) *StoreCreate {
+	_c.mutation.AddImportRunIDs(ids...)
+	return _c
+}
+
+// AddImportRuns adds the "import_runs" edges to the ImportRun entity.
+func (_c *StoreCreate) AddImportRuns(v ...*ImportRun) *StoreCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddImportRunIDs(ids...)
+}
+
 // Mutation returns the StoreMutation object of the builder.
 func (_c *StoreCreate) Mutation() *StoreMutation {
 	return _c.mutation
@@ -118,6 +136,7 @@ func (_c *StoreCreate) createSpec() (*Store, *sqlgraph.CreateSpec) {
 		_node = &Store{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(store.Table, sqlgraph.NewFieldSpec(store.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = _c.conflict
 	if value, ok := _c.mutation.StoreID(); ok {
 		_spec.SetField(store.FieldStoreID, field.TypeString, value)
 		_node.StoreID = value
@@ -142,14 +161,205 @@ func (_c *StoreCreate) createSpec() (*Store, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ImportRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Store.Create().
+//		SetStoreID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StoreUpsert) {
+//			SetStoreID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StoreCreate) OnConflict(opts ...sql.ConflictOption) *StoreUpsertOne {
+	_c.conflict = opts
+	return &StoreUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Store.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StoreCreate) OnConflictColumns(columns ...string) *StoreUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StoreUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// StoreUpsertOne is the builder for "upsert"-ing
+	//  one Store node.
+	StoreUpsertOne struct {
+		create *StoreCreate
+	}
+
+	// StoreUpsert is the "OnConflict" setter.
+	StoreUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStoreID sets the "store_id" field.
+func (u *StoreUpsert) SetStoreID(v string) *StoreUpsert {
+	u.Set(store.FieldStoreID, v)
+	return u
+}
+
+// UpdateStoreID sets the "store_id" field to the value that was provided on create.
+func (u *StoreUpsert) UpdateStoreID() *StoreUpsert {
+	u.SetExcluded(store.FieldStoreID)
+	return u
+}
+
+// SetGrocer sets the "grocer" field.
+func (u *StoreUpsert) SetGrocer(v store.Grocer) *StoreUpsert {
+	u.Set(store.FieldGrocer, v)
+	return u
+}
+
+// UpdateGrocer sets the "grocer" field to the value that was provided on create.
+func (u *StoreUpsert) UpdateGrocer() *StoreUpsert {
+	u.SetExcluded(store.FieldGrocer)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Store.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *StoreUpsertOne) UpdateNewValues() *StoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Store.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StoreUpsertOne) Ignore() *StoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StoreUpsertOne) DoNothing() *StoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StoreCreate.OnConflict
+// documentation for more info.
+func (u *StoreUpsertOne) Update(set func(*StoreUpsert)) *StoreUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StoreUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStoreID sets the "store_id" field.
+func (u *StoreUpsertOne) SetStoreID(v string) *StoreUpsertOne {
+	return u.Update(func(s *StoreUpsert) {
+		s.SetStoreID(v)
+	})
+}
+
+// UpdateStoreID sets the "store_id" field to the value that was provided on create.
+func (u *StoreUpsertOne) UpdateStoreID() *StoreUpsertOne {
+	return u.Update(func(s *StoreUpsert) {
+		s.UpdateStoreID()
+	})
+}
+
+// SetGrocer sets the "grocer" field.
+func (u *StoreUpsertOne) SetGrocer(v store.Grocer) *StoreUpsertOne {
+	return u.Update(func(s *StoreUpsert) {
+		s.SetGrocer(v)
+	})
+}
+
+// UpdateGrocer sets the "grocer" field to the value that was provided on create.
+func (u *StoreUpsertOne) UpdateGrocer() *StoreUpsertOne {
+	return u.Update(func(s *StoreUpsert) {
+		s.UpdateGrocer()
+	})
+}
+
+// Exec executes the query.
+func (u *StoreUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StoreCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StoreUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StoreUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StoreUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // StoreCreateBulk is the builder for creating many Store entities in bulk.
 type StoreCreateBulk struct {
 	config
 	err      error
 	builders []*StoreCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Store entities in the database.
@@ -178,6 +388,7 @@ func (_c *StoreCreateBulk) Save(ctx context.Context) ([]*Store, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -231,3 +442,135 @@ func (_c *StoreCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Store.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StoreUpsert) {
+//			SetStoreID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *StoreCreateBulk) OnConflict(opts ...sql.ConflictOption) *StoreUpsertBulk {
+	_c.conflict = opts
+	return &StoreUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Store.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *StoreCreateBulk) OnConflictColumns(columns ...string) *StoreUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &StoreUpsertBulk{
+		create: _c,
+	}
+}
+
+// StoreUpsertBulk is the builder for "upsert"-ing
+// a bulk of Store nodes.
+type StoreUpsertBulk struct {
+	create *StoreCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Store.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *StoreUpsertBulk) UpdateNewValues() *StoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Store.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StoreUpsertBulk) Ignore() *StoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StoreUpsertBulk) DoNothing() *StoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StoreCreateBulk.OnConflict
+// documentation for more info.
+func (u *StoreUpsertBulk) Update(set func(*StoreUpsert)) *StoreUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StoreUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStoreID sets the "store_id" field.
+func (u *StoreUpsertBulk) SetStoreID(v string) *StoreUpsertBulk {
+	return u.Update(func(s *StoreUpsert) {
+		s.SetStoreID(v)
+	})
+}
+
+// UpdateStoreID sets the "store_id" field to the value that was provided on create.
+func (u *StoreUpsertBulk) UpdateStoreID() *StoreUpsertBulk {
+	return u.Update(func(s *StoreUpsert) {
+		s.UpdateStoreID()
+	})
+}
+
+// SetGrocer sets the "grocer" field.
+func (u *StoreUpsertBulk) SetGrocer(v store.Grocer) *StoreUpsertBulk {
+	return u.Update(func(s *StoreUpsert) {
+		s.SetGrocer(v)
+	})
+}
+
+// UpdateGrocer sets the "grocer" field to the value that was provided on create.
+func (u *StoreUpsertBulk) UpdateGrocer() *StoreUpsertBulk {
+	return u.Update(func(s *StoreUpsert) {
+		s.UpdateGrocer()
+	})
+}
+
+// Exec executes the query.
+func (u *StoreUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the StoreCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for StoreCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StoreUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/predicate"
 	"offgrocery-assessment/internal/ent/store"
@@ -20,11 +21,12 @@ import (
 // StoreQuery is the builder for querying Store entities.
 type StoreQuery struct {
 	config
-	ctx        *QueryContext
-	order      []store.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Store
-	withItems  *ItemQuery
+	ctx            *QueryContext
+	order          []store.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Store
+	withItems      *ItemQuery
+	withImportRuns *ImportRunQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +85,28 @@ func (_q *StoreQuery) QueryItems() *ItemQuery {
 	return query
 }
 
+// QueryImportRuns chains the current query on the "import_runs" edge.
+func (_q *StoreQuery) QueryImportRuns() *ImportRunQuery {
+	query := (&ImportRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(store.Table, store.FieldID, selector),
+			sqlgraph.To(importrun.Table, importrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, store.ImportRunsTable, store.ImportRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Store entity from the query.
 // Returns a *NotFoundError when no Store was found.
 func (_q *StoreQuery) First(ctx context.Context) (*Store, error) {
@@ -270,12 +294,13 @@ func (_q *StoreQuery) Clone() *StoreQuery {
 		return nil
 	}
 	return &StoreQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]store.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Store{}, _q.predicates...),
-		withItems:  _q.withItems.Clone(),
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]store.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.Store{}, _q.predicates...),
+		withItems:      _q.withItems.Clone(),
+		withImportRuns: _q.withImportRuns.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +318,17 @@ func (_q *StoreQuery) WithItems(opts ...func(*ItemQuery)) *StoreQuery {
 	return _q
 }
 
+// WithImportRuns tells the query-builder to eager-load the nodes that are connected to
+// the "import_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StoreQuery) WithImportRuns(opts ...func(*ImportRunQuery)) *StoreQuery {
+	query := (&ImportRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withImportRuns = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +407,9 @@ func (_q *StoreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Store,
 	var (
 		nodes       = []*Store{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withItems != nil,
+			_q.withImportRuns != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +437,13 @@ func (_q *StoreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Store,
 			return nil, err
 		}
 	}
+	if query := _q.withImportRuns; query != nil {
+		if err := _q.loadImportRuns(ctx, query, nodes,
+			func(n *Store) { n.Edges.ImportRuns = []*ImportRun{} },
+			func(n *Store, e *ImportRun) { n.Edges.ImportRuns = append(n.Edges.ImportRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,6 +478,37 @@ func (_q *StoreQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []*
 	}
 	return nil
 }
+func (_q *StoreQuery) loadImportRuns(ctx context.Context, query *ImportRunQuery, nodes []*Store, init func(*Store), assign func(*Store, *ImportRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Store)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ImportRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(store.ImportRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.store_import_runs
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "store_import_runs" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "store_import_runs" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *StoreQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
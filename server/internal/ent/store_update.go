@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
 	"offgrocery-assessment/internal/ent/predicate"
 	"offgrocery-assessment/internal/ent/store"
@@ -71,6 +72,21 @@ func (_u *StoreUpdate) AddItems(v ...*Item) *StoreUpdate {
 	return _u.AddItemIDs(ids...)
 }
 
+// AddImportRunIDs adds the "import_runs" edge to the ImportRun entity by IDs.
+func (_u *StoreUpdate) AddImportRunIDs(ids ...int) *StoreUpdate {
+	_u.mutation.AddImportRunIDs(ids...)
+	return _u
+}
+
+// AddImportRuns adds the "import_runs" edges to the ImportRun entity.
+func (_u *StoreUpdate) AddImportRuns(v ...*ImportRun) *StoreUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportRunIDs(ids...)
+}
+
 // Mutation returns the StoreMutation object of the builder.
 func (_u *StoreUpdate) Mutation() *StoreMutation {
 	return _u.mutation
@@ -97,6 +113,27 @@ func (_u *StoreUpdate) RemoveItems(v ...*Item) *StoreUpdate {
 	return _u.RemoveItemIDs(ids...)
 }
 
+// ClearImportRuns clears all "import_runs" edges to the ImportRun entity.
+func (_u *StoreUpdate) ClearImportRuns() *StoreUpdate {
+	_u.mutation.ClearImportRuns()
+	return _u
+}
+
+// RemoveImportRunIDs removes the "import_runs" edge to ImportRun entities by IDs.
+func (_u *StoreUpdate) RemoveImportRunIDs(ids ...int) *StoreUpdate {
+	_u.mutation.RemoveImportRunIDs(ids...)
+	return _u
+}
+
+// RemoveImportRuns removes "import_runs" edges to ImportRun entities.
+func (_u *StoreUpdate) RemoveImportRuns(v ...*ImportRun) *StoreUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportRunIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *StoreUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -197,6 +234,51 @@ func (_u *StoreUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ImportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportRunsIDs(); len(nodes) > 0 && !_u.mutation.ImportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{store.Label}
@@ -260,6 +342,21 @@ func (_u *StoreUpdateOne) AddItems(v ...*Item) *StoreUpdateOne {
 	return _u.AddItemIDs(ids...)
 }
 
+// AddImportRunIDs adds the "import_runs" edge to the ImportRun entity by IDs.
+func (_u *StoreUpdateOne) AddImportRunIDs(ids ...int) *StoreUpdateOne {
+	_u.mutation.AddImportRunIDs(ids...)
+	return _u
+}
+
+// AddImportRuns adds the "import_runs" edges to the ImportRun entity.
+func (_u *StoreUpdateOne) AddImportRuns(v ...*ImportRun) *StoreUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddImportRunIDs(ids...)
+}
+
 // Mutation returns the StoreMutation object of the builder.
 func (_u *StoreUpdateOne) Mutation() *StoreMutation {
 	return _u.mutation
@@ -286,6 +383,27 @@ func (_u *StoreUpdateOne) RemoveItems(v ...*Item) *StoreUpdateOne {
 	return _u.RemoveItemIDs(ids...)
 }
 
+// ClearImportRuns clears all "import_runs" edges to the ImportRun entity.
+func (_u *StoreUpdateOne) ClearImportRuns() *StoreUpdateOne {
+	_u.mutation.ClearImportRuns()
+	return _u
+}
+
+// RemoveImportRunIDs removes the "import_runs" edge to ImportRun entities by IDs.
+func (_u *StoreUpdateOne) RemoveImportRunIDs(ids ...int) *StoreUpdateOne {
+	_u.mutation.RemoveImportRunIDs(ids...)
+	return _u
+}
+
+// RemoveImportRuns removes "import_runs" edges to ImportRun entities.
+func (_u *StoreUpdateOne) RemoveImportRuns(v ...*ImportRun) *StoreUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveImportRunIDs(ids...)
+}
+
 // Where appends a list predicates to the StoreUpdate builder.
 func (_u *StoreUpdateOne) Where(ps ...predicate.Store) *StoreUpdateOne {
 	_u.mutation.Where(ps...)
@@ -416,6 +534,51 @@ func (_u *StoreUpdateOne) sqlSave(ctx context.Context) (_node *Store, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ImportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedImportRunsIDs(); len(nodes) > 0 && !_u.mutation.ImportRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ImportRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   store.ImportRunsTable,
+			Columns: []string{store.ImportRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(importrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Store{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -22,6 +22,8 @@ const (
 	FieldEmail = "email"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldDisplayCurrency holds the string denoting the display_currency field in the database.
+	FieldDisplayCurrency = "display_currency"
 	// EdgeLists holds the string denoting the lists edge name in mutations.
 	EdgeLists = "lists"
 	// Table holds the table name of the user in the database.
@@ -42,6 +44,7 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldEmail,
 	FieldName,
+	FieldDisplayCurrency,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -65,6 +68,8 @@ var (
 	EmailValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DisplayCurrencyValidator is a validator for the "display_currency" field. It is called by the builders before save.
+	DisplayCurrencyValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -95,6 +100,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByDisplayCurrency orders the results by the display_currency field.
+func ByDisplayCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayCurrency, opts...).ToFunc()
+}
+
 // ByListsCount orders the results by lists count.
 func ByListsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -75,6 +75,11 @@ func Name(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
 }
 
+// DisplayCurrency applies equality check predicate on the "display_currency" field. It's identical to DisplayCurrencyEQ.
+func DisplayCurrency(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDisplayCurrency, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreateTime, v))
@@ -285,6 +290,81 @@ func NameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldName, v))
 }
 
+// DisplayCurrencyEQ applies the EQ predicate on the "display_currency" field.
+func DisplayCurrencyEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyNEQ applies the NEQ predicate on the "display_currency" field.
+func DisplayCurrencyNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyIn applies the In predicate on the "display_currency" field.
+func DisplayCurrencyIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDisplayCurrency, vs...))
+}
+
+// DisplayCurrencyNotIn applies the NotIn predicate on the "display_currency" field.
+func DisplayCurrencyNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDisplayCurrency, vs...))
+}
+
+// DisplayCurrencyGT applies the GT predicate on the "display_currency" field.
+func DisplayCurrencyGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyGTE applies the GTE predicate on the "display_currency" field.
+func DisplayCurrencyGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyLT applies the LT predicate on the "display_currency" field.
+func DisplayCurrencyLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyLTE applies the LTE predicate on the "display_currency" field.
+func DisplayCurrencyLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyContains applies the Contains predicate on the "display_currency" field.
+func DisplayCurrencyContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyHasPrefix applies the HasPrefix predicate on the "display_currency" field.
+func DisplayCurrencyHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyHasSuffix applies the HasSuffix predicate on the "display_currency" field.
+func DisplayCurrencyHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyIsNil applies the IsNil predicate on the "display_currency" field.
+func DisplayCurrencyIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDisplayCurrency))
+}
+
+// DisplayCurrencyNotNil applies the NotNil predicate on the "display_currency" field.
+func DisplayCurrencyNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDisplayCurrency))
+}
+
+// DisplayCurrencyEqualFold applies the EqualFold predicate on the "display_currency" field.
+func DisplayCurrencyEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyContainsFold applies the ContainsFold predicate on the "display_currency" field.
+func DisplayCurrencyContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldDisplayCurrency, v))
+}
+
 // HasLists applies the HasEdge predicate on the "lists" edge.
 func HasLists() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
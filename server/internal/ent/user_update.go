@@ -63,6 +63,26 @@ func (_u *UserUpdate) SetNillableName(v *string) *UserUpdate {
 	return _u
 }
 
+// SetDisplayCurrency sets the "display_currency" field.
+func (_u *UserUpdate) SetDisplayCurrency(v string) *UserUpdate {
+	_u.mutation.SetDisplayCurrency(v)
+	return _u
+}
+
+// SetNillableDisplayCurrency sets the "display_currency" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDisplayCurrency(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetDisplayCurrency(*v)
+	}
+	return _u
+}
+
+// ClearDisplayCurrency clears the value of the "display_currency" field.
+func (_u *UserUpdate) ClearDisplayCurrency() *UserUpdate {
+	_u.mutation.ClearDisplayCurrency()
+	return _u
+}
+
 // AddListIDs adds the "lists" edge to the List entity by IDs.
 func (_u *UserUpdate) AddListIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddListIDs(ids...)
@@ -152,6 +172,11 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DisplayCurrency(); ok {
+		if err := user.DisplayCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "display_currency", err: fmt.Errorf(`ent: validator failed for field "User.display_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -176,6 +201,12 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DisplayCurrency(); ok {
+		_spec.SetField(user.FieldDisplayCurrency, field.TypeString, value)
+	}
+	if _u.mutation.DisplayCurrencyCleared() {
+		_spec.ClearField(user.FieldDisplayCurrency, field.TypeString)
+	}
 	if _u.mutation.ListsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -275,6 +306,26 @@ func (_u *UserUpdateOne) SetNillableName(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetDisplayCurrency sets the "display_currency" field.
+func (_u *UserUpdateOne) SetDisplayCurrency(v string) *UserUpdateOne {
+	_u.mutation.SetDisplayCurrency(v)
+	return _u
+}
+
+// SetNillableDisplayCurrency sets the "display_currency" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDisplayCurrency(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetDisplayCurrency(*v)
+	}
+	return _u
+}
+
+// ClearDisplayCurrency clears the value of the "display_currency" field.
+func (_u *UserUpdateOne) ClearDisplayCurrency() *UserUpdateOne {
+	_u.mutation.ClearDisplayCurrency()
+	return _u
+}
+
 // AddListIDs adds the "lists" edge to the List entity by IDs.
 func (_u *UserUpdateOne) AddListIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddListIDs(ids...)
@@ -377,6 +428,11 @@ func (_u *UserUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DisplayCurrency(); ok {
+		if err := user.DisplayCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "display_currency", err: fmt.Errorf(`ent: validator failed for field "User.display_currency": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -418,6 +474,12 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(user.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DisplayCurrency(); ok {
+		_spec.SetField(user.FieldDisplayCurrency, field.TypeString, value)
+	}
+	if _u.mutation.DisplayCurrencyCleared() {
+		_spec.ClearField(user.FieldDisplayCurrency, field.TypeString)
+	}
 	if _u.mutation.ListsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,150 @@
+package exchangeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"strings"
+
+	"offgrocery-assessment/internal/exchange/exchangestore"
+	"offgrocery-assessment/internal/money"
+)
+
+// ErrUnknownCurrency is returned by Converter when the requested display
+// currency has no exchange rate.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// RateTable is the layout of an exchange rate file: one unit of Base buys
+// Rates[c] units of currency c.
+type RateTable struct {
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+type Service interface {
+	LoadFile(ctx context.Context, filePath string) (int, error)
+	LoadRates(ctx context.Context, table RateTable) error
+	Rates(ctx context.Context) (*money.Rates, error)
+	Converter(ctx context.Context, currency string, userID int) (*Converter, error)
+}
+
+type service struct {
+	store exchangestore.Store
+}
+
+func New(store exchangestore.Store) *service {
+	return &service{store: store}
+}
+
+// LoadFile replaces the exchange rate table with the one in a JSON rate file
+// and returns the number of rates loaded.
+func (s *service) LoadFile(ctx context.Context, filePath string) (int, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return 0, fmt.Errorf("reading file: %w", err)
+	}
+
+	var table RateTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return 0, fmt.Errorf("parsing rate file: %w", err)
+	}
+
+	if err := s.LoadRates(ctx, table); err != nil {
+		return 0, err
+	}
+
+	slog.Info("exchange: loaded rates", "file", filePath, "base", table.Base, "rates", len(table.Rates))
+	return len(table.Rates), nil
+}
+
+// LoadRates validates a rate table and replaces the stored one with it.
+func (s *service) LoadRates(ctx context.Context, table RateTable) error {
+	if !money.ValidCurrency(table.Base) {
+		return fmt.Errorf("invalid base currency %q", table.Base)
+	}
+	if len(table.Rates) == 0 {
+		return errors.New("rate table has no rates")
+	}
+	for currency, rate := range table.Rates {
+		if !money.ValidCurrency(currency) {
+			return fmt.Errorf("invalid currency %q", currency)
+		}
+		if math.IsNaN(rate) || math.IsInf(rate, 0) || rate <= 0 {
+			return fmt.Errorf("rate for %s must be positive, got %v", currency, rate)
+		}
+		if currency == table.Base && rate != 1 {
+			return fmt.Errorf("rate for base currency %s must be 1, got %v", currency, rate)
+		}
+	}
+
+	return s.store.ReplaceRates(ctx, table.Base, table.Rates)
+}
+
+// Rates returns the stored rate table. With no rates loaded it only knows
+// the default currency.
+func (s *service) Rates(ctx context.Context) (*money.Rates, error) {
+	rows, err := s.store.ListRates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing exchange rates: %w", err)
+	}
+
+	base := money.DefaultCurrency
+	perBase := make(map[string]float64, len(rows))
+	for _, r := range rows {
+		base = r.Base
+		perBase[r.Currency] = r.Rate
+	}
+	return money.NewRates(base, perBase), nil
+}
+
+// Converter returns a converter to the display currency for a request. An
+// explicit currency wins over the user's preference; userID is ignored when
+// zero. It returns a nil converter when neither names a currency, and
+// ErrUnknownCurrency when the currency has no exchange rate.
+func (s *service) Converter(ctx context.Context, currency string, userID int) (*Converter, error) {
+	currency = strings.ToUpper(currency)
+	if currency == "" && userID != 0 {
+		preferred, err := s.store.GetUserDisplayCurrency(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("getting display currency of user %d: %w", userID, err)
+		}
+		currency = preferred
+	}
+	if currency == "" {
+		return nil, nil
+	}
+
+	rates, err := s.Rates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !rates.Has(currency) {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCurrency, currency)
+	}
+
+	return &Converter{Currency: currency, rates: rates}, nil
+}
+
+// Converter converts prices to a display currency. A nil Converter converts
+// nothing.
+type Converter struct {
+	Currency string
+	rates    *money.Rates
+}
+
+// Convert returns m in the display currency. It reports false when there is
+// no display currency or no rate for m's currency.
+func (c *Converter) Convert(m money.Money) (money.Money, bool) {
+	if c == nil {
+		return money.Money{}, false
+	}
+	converted, err := c.rates.Convert(m, c.Currency)
+	if err != nil {
+		return money.Money{}, false
+	}
+	return converted, true
+}
@@ -0,0 +1,65 @@
+package exchangeservice
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"offgrocery-assessment/internal/ent"
+)
+
+// fakeStore records the rate table it was last given.
+type fakeStore struct {
+	base  string
+	rates map[string]float64
+}
+
+func (s *fakeStore) ReplaceRates(ctx context.Context, base string, rates map[string]float64) error {
+	s.base, s.rates = base, rates
+	return nil
+}
+
+func (s *fakeStore) ListRates(ctx context.Context) ([]*ent.ExchangeRate, error) {
+	return nil, nil
+}
+
+func (s *fakeStore) GetUserDisplayCurrency(ctx context.Context, userID int) (string, error) {
+	return "", nil
+}
+
+func TestLoadRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   RateTable
+		wantErr bool
+	}{
+		{"valid", RateTable{Base: "CAD", Rates: map[string]float64{"USD": 0.75, "JPY": 110}}, false},
+		{"base quoted at one", RateTable{Base: "CAD", Rates: map[string]float64{"CAD": 1, "USD": 0.75}}, false},
+		{"invalid base", RateTable{Base: "cad", Rates: map[string]float64{"USD": 0.75}}, true},
+		{"empty table", RateTable{Base: "CAD", Rates: map[string]float64{}}, true},
+		{"missing rates", RateTable{Base: "CAD"}, true},
+		{"invalid currency", RateTable{Base: "CAD", Rates: map[string]float64{"US": 0.75}}, true},
+		{"zero rate", RateTable{Base: "CAD", Rates: map[string]float64{"USD": 0}}, true},
+		{"negative rate", RateTable{Base: "CAD", Rates: map[string]float64{"USD": -0.75}}, true},
+		{"base quoted at other rate", RateTable{Base: "CAD", Rates: map[string]float64{"CAD": 2, "USD": 0.75}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeStore{}
+			err := New(store).LoadRates(context.Background(), tt.table)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadRates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if store.rates != nil {
+					t.Errorf("LoadRates() stored %v despite error", store.rates)
+				}
+				return
+			}
+			if store.base != tt.table.Base || !maps.Equal(store.rates, tt.table.Rates) {
+				t.Errorf("stored %s %v, want %s %v", store.base, store.rates, tt.table.Base, tt.table.Rates)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package exchangestore
+
+import (
+	"context"
+	"fmt"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/user"
+)
+
+type Store interface {
+	ReplaceRates(ctx context.Context, base string, rates map[string]float64) error
+	ListRates(ctx context.Context) ([]*ent.ExchangeRate, error)
+	GetUserDisplayCurrency(ctx context.Context, userID int) (string, error)
+}
+
+type store struct {
+	client *ent.Client
+}
+
+func New(client *ent.Client) *store {
+	return &store{client: client}
+}
+
+// ReplaceRates swaps the whole rate table for rates quoted against base, in
+// a single transaction. The base is always stored with a rate of 1, so the
+// table keeps its base however few rates it has.
+func (s *store) ReplaceRates(ctx context.Context, base string, rates map[string]float64) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if _, err := tx.ExchangeRate.Delete().Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	builders := make([]*ent.ExchangeRateCreate, 0, len(rates)+1)
+	if _, ok := rates[base]; !ok {
+		builders = append(builders, tx.ExchangeRate.Create().
+			SetBase(base).
+			SetCurrency(base).
+			SetRate(1))
+	}
+	for currency, rate := range rates {
+		builders = append(builders, tx.ExchangeRate.Create().
+			SetBase(base).
+			SetCurrency(currency).
+			SetRate(rate))
+	}
+	if err := tx.ExchangeRate.CreateBulk(builders...).Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *store) ListRates(ctx context.Context) ([]*ent.ExchangeRate, error) {
+	return s.client.ExchangeRate.Query().All(ctx)
+}
+
+// GetUserDisplayCurrency returns the user's preferred display currency, or
+// an empty string if they have none.
+func (s *store) GetUserDisplayCurrency(ctx context.Context, userID int) (string, error) {
+	u, err := s.client.User.Query().
+		Where(user.IDEQ(userID)).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	if u.DisplayCurrency == nil {
+		return "", nil
+	}
+	return *u.DisplayCurrency, nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
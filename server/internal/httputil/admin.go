@@ -0,0 +1,23 @@
+package httputil
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// RequireAdmin returns middleware that only lets through requests carrying
+// token as a bearer token. An empty token refuses every request, so admin
+// routes stay closed until a token is configured.
+func RequireAdmin(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				WriteJSON(w, http.StatusUnauthorized, ErrorResponse{Error: "admin token required"})
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package httputil
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
+)
+
+// DisplayConverter resolves the display currency of a request from its
+// currency query param, or else the preference of the user named by its
+// user_id query param. It writes an error response and returns false if
+// either is invalid.
+func DisplayConverter(w http.ResponseWriter, r *http.Request, exchange exchangeservice.Service) (*exchangeservice.Converter, bool) {
+	var userID int
+	if userIDStr := r.URL.Query().Get("user_id"); userIDStr != "" {
+		var err error
+		if userID, err = strconv.Atoi(userIDStr); err != nil {
+			WriteJSON(w, http.StatusBadRequest, ErrorResponse{Error: "invalid user_id"})
+			return nil, false
+		}
+	}
+	return DisplayConverterFor(w, r, exchange, userID)
+}
+
+// DisplayConverterFor resolves the display currency of a request from its
+// currency query param, or else the preference of the given user; userID is
+// ignored when zero. It writes an error response and returns false if
+// either is invalid.
+func DisplayConverterFor(w http.ResponseWriter, r *http.Request, exchange exchangeservice.Service, userID int) (*exchangeservice.Converter, bool) {
+	converter, err := exchange.Converter(r.Context(), r.URL.Query().Get("currency"), userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, exchangeservice.ErrUnknownCurrency):
+			WriteJSON(w, http.StatusBadRequest, ErrorResponse{Error: "no exchange rate for currency"})
+		case ent.IsNotFound(err):
+			WriteJSON(w, http.StatusNotFound, ErrorResponse{Error: "user not found"})
+		default:
+			WriteJSON(w, http.StatusInternalServerError, ErrorResponse{Error: "failed to load exchange rates"})
+		}
+		return nil, false
+	}
+	return converter, true
+}
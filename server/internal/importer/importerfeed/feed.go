@@ -0,0 +1,190 @@
+package importerfeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// Product is the grocer-agnostic record every adapter normalizes a feed row
+// into before it is written to the database.
+type Product struct {
+	// Index is the product's position in the feed's product array.
+	Index      int
+	Name       string
+	Brand      string
+	Price      money.Money
+	ExternalID string
+	// Category is the grocer's own category or aisle label.
+	Category string
+	// Unit is the grocer's own description of how the product is sold,
+	// e.g. "per 2L carton".
+	Unit string
+	// Attributes are the slugs of the dietary attributes the feed states,
+	// e.g. dietary.Organic.
+	Attributes []string
+	// Size is the package size, when the feed describes one.
+	Size measure.Size
+}
+
+// Feed is a grocer feed opened for streaming: the store it describes and
+// where its products are. Its products are read with Each.
+type Feed struct {
+	StoreID string
+	Grocer  store.Grocer
+	// Paths locates each product field in the source document, for error
+	// reports.
+	Paths FieldPaths
+	// Total is the number of rows in the product array.
+	Total int
+
+	encoding Encoding
+	adapter  FeedAdapter
+}
+
+// FieldPaths holds the JSON paths of the product array and of each product
+// field within a product. StoreID is a path within the document for a JSON
+// feed, and within each row for a CSV or NDJSON feed.
+type FieldPaths struct {
+	StoreID    string
+	Products   string
+	Name       string
+	Brand      string
+	Price      string
+	Currency   string
+	ExternalID string
+}
+
+// FeedAdapter understands the JSON layout of a single grocer's feed.
+type FeedAdapter interface {
+	// Name is the format name used to select the adapter explicitly, e.g. "store_a".
+	Name() string
+	// Detect reports whether a feed with the given top-level keys is in this
+	// adapter's format.
+	Detect(keys map[string]bool) bool
+	// Header reads the store a feed describes, and where its fields are,
+	// from the feed's outline.
+	Header(outline *Outline) (*Feed, error)
+	// DecodeProduct decodes a single element of the product array. An error
+	// rejects the row.
+	DecodeProduct(raw json.RawMessage) (Product, error)
+}
+
+// Open reads a feed's header from its outline with adapter, ready for its
+// products to be streamed.
+func Open(adapter FeedAdapter, outline *Outline) (*Feed, error) {
+	feed, err := adapter.Header(outline)
+	if err != nil {
+		return nil, err
+	}
+
+	feed.encoding = outline.encoding
+	feed.adapter = adapter
+
+	if outline.encoding != JSON {
+		// Rows of a CSV or NDJSON feed are located by their row number.
+		feed.Paths.Products = "rows"
+		feed.Total = outline.rows
+		return feed, nil
+	}
+
+	if feed.Paths.Products == "" {
+		return nil, fmt.Errorf("%s feed has no product array path", adapter.Name())
+	}
+	total, ok := outline.Len(feed.Paths.Products)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an array", feed.Paths.Products)
+	}
+	feed.Total = total
+	return feed, nil
+}
+
+// Registry holds the known feed adapters, keyed by name.
+type Registry struct {
+	adapters []FeedAdapter
+	byName   map[string]FeedAdapter
+}
+
+// NewRegistry returns a registry containing the given adapters.
+func NewRegistry(adapters ...FeedAdapter) (*Registry, error) {
+	r := &Registry{byName: make(map[string]FeedAdapter)}
+	for _, a := range adapters {
+		if err := r.Register(a); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// DefaultRegistry returns a registry with the adapters for every grocer we
+// currently receive feeds from.
+func DefaultRegistry() *Registry {
+	r, err := NewRegistry(
+		NewStoreAAdapter(),
+		NewStoreBAdapter(),
+		NewStoreCAdapter(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds an adapter. Adapter names must be unique.
+func (r *Registry) Register(a FeedAdapter) error {
+	if _, ok := r.byName[a.Name()]; ok {
+		return fmt.Errorf("feed adapter %q already registered", a.Name())
+	}
+	r.adapters = append(r.adapters, a)
+	r.byName[a.Name()] = a
+	return nil
+}
+
+// Get returns the adapter registered under name.
+func (r *Registry) Get(name string) (FeedAdapter, error) {
+	a, ok := r.byName[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown feed format %q (known: %v)", name, r.Names())
+	}
+	return a, nil
+}
+
+// Names returns the registered adapter names in sorted order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.byName))
+	for name := range r.byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Detect sniffs the top-level keys of a feed and returns the adapter that
+// recognizes them. It fails if no adapter, or more than one, matches.
+func (r *Registry) Detect(outline *Outline) (FeedAdapter, error) {
+	keys := outline.Keys()
+
+	var matched []FeedAdapter
+	for _, a := range r.adapters {
+		if a.Detect(keys) {
+			matched = append(matched, a)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("unrecognized feed format, pass a format explicitly (known: %v)", r.Names())
+	case 1:
+		return matched[0], nil
+	default:
+		names := make([]string, len(matched))
+		for i, a := range matched {
+			names[i] = a.Name()
+		}
+		return nil, fmt.Errorf("ambiguous feed format, matches %v", names)
+	}
+}
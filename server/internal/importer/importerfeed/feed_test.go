@@ -0,0 +1,302 @@
+package importerfeed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"offgrocery-assessment/internal/item/dietary"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// readFeed streams a feed through ReadOutline, Open and Each, with the
+// adapter detected by the default registry.
+func readFeed(t *testing.T, encoding Encoding, data string) ([]Product, []RowError) {
+	t.Helper()
+
+	outline, err := ReadOutline(strings.NewReader(data), encoding)
+	if err != nil {
+		t.Fatalf("ReadOutline: %v", err)
+	}
+	adapter, err := DefaultRegistry().Detect(outline)
+	if err != nil {
+		t.Fatalf("Detect: %v", err)
+	}
+	feed, err := Open(adapter, outline)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	var products []Product
+	var rejected []RowError
+	err = feed.Each(strings.NewReader(data),
+		func(p Product) error {
+			products = append(products, p)
+			return nil
+		},
+		func(e RowError) error {
+			rejected = append(rejected, e)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Each: %v", err)
+	}
+	if len(products)+len(rejected) != feed.Total {
+		t.Errorf("read %d rows, feed total is %d", len(products)+len(rejected), feed.Total)
+	}
+	return products, rejected
+}
+
+func TestEncodingsDecodeAlike(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		ndjson string
+		csv    string
+		want   []Product
+	}{
+		{
+			name: "store a",
+			json: `{"store_location_id": "A1", "products": [
+				{"product_name": "Whole Milk", "manufacturer": "Dairyland", "retail_price": 5.29, "sku": "A-1", "category": "Dairy", "weight_grams": 2000},
+				{"product_name": "Rye Bread", "manufacturer": "Bakehouse", "retail_price": "3.49", "sku": "A-2", "category": "Bakery"}
+			]}`,
+			ndjson: `{"store_location_id": "A1", "product_name": "Whole Milk", "manufacturer": "Dairyland", "retail_price": 5.29, "sku": "A-1", "category": "Dairy", "weight_grams": 2000}
+{"store_location_id": "A1", "product_name": "Rye Bread", "manufacturer": "Bakehouse", "retail_price": "3.49", "sku": "A-2", "category": "Bakery"}
+`,
+			csv: "store_location_id,product_name,manufacturer,retail_price,sku,category,weight_grams\n" +
+				"A1,Whole Milk,Dairyland,5.29,A-1,Dairy,2000\n" +
+				"A1,Rye Bread,Bakehouse,3.49,A-2,Bakery,\n",
+			want: []Product{
+				{Index: 0, Name: "Whole Milk", Brand: "Dairyland", Price: money.New(529, "CAD"), ExternalID: "A-1", Category: "Dairy", Size: measure.Grams(2000)},
+				{Index: 1, Name: "Rye Bread", Brand: "Bakehouse", Price: money.New(349, "CAD"), ExternalID: "A-2", Category: "Bakery"},
+			},
+		},
+		{
+			name: "store b",
+			json: `{"location": {"id": "B1", "chain": "B"}, "inventory": [
+				{"item": {"label": "Cheddar", "brand_name": "Cheesy"}, "pricing": {"current_price": 7.5, "currency": "USD"}, "barcode": "0001"},
+				{"item": {"label": "Butter", "brand_name": "Creamery"}, "pricing": {"current_price": 4}, "barcode": "0002"}
+			]}`,
+			ndjson: `{"location": {"id": "B1"}, "item": {"label": "Cheddar", "brand_name": "Cheesy"}, "pricing": {"current_price": 7.5, "currency": "USD"}, "barcode": "0001"}
+{"location": {"id": "B1"}, "item": {"label": "Butter", "brand_name": "Creamery"}, "pricing": {"current_price": 4}, "barcode": "0002"}
+`,
+			csv: "location.id,item.label,item.brand_name,pricing.current_price,pricing.currency,barcode\n" +
+				"B1,Cheddar,Cheesy,7.5,USD,0001\n" +
+				"B1,Butter,Creamery,4,,0002\n",
+			want: []Product{
+				{Index: 0, Name: "Cheddar", Brand: "Cheesy", Price: money.New(750, "USD"), ExternalID: "0001"},
+				{Index: 1, Name: "Butter", Brand: "Creamery", Price: money.New(400, "CAD"), ExternalID: "0002"},
+			},
+		},
+		{
+			name: "store c",
+			json: `{"store_code": "C1", "catalogue": [
+				{"display_name": "Apples", "producer": "Orchard", "cost": 3.99, "product_id": "C-1", "aisle": "Produce", "organic": true, "unit": "per 1kg bag"},
+				{"display_name": "Pears", "producer": "Orchard", "cost": 2.5, "product_id": "C-2", "aisle": "Produce", "organic": false, "unit": "each"}
+			]}`,
+			ndjson: `{"store_code": "C1", "display_name": "Apples", "producer": "Orchard", "cost": "3.99", "product_id": "C-1", "aisle": "Produce", "organic": "true", "unit": "per 1kg bag"}
+{"store_code": "C1", "display_name": "Pears", "producer": "Orchard", "cost": 2.5, "product_id": "C-2", "aisle": "Produce", "organic": false, "unit": "each"}
+`,
+			csv: "store_code,display_name,producer,cost,product_id,aisle,organic,unit\n" +
+				"C1,Apples,Orchard,3.99,C-1,Produce,true,per 1kg bag\n" +
+				"C1,Pears,Orchard,2.5,C-2,Produce,false,each\n",
+			want: []Product{
+				{Index: 0, Name: "Apples", Brand: "Orchard", Price: money.New(399, "CAD"), ExternalID: "C-1", Category: "Produce", Unit: "per 1kg bag", Attributes: []string{dietary.Organic}, Size: measure.Grams(1000)},
+				{Index: 1, Name: "Pears", Brand: "Orchard", Price: money.New(250, "CAD"), ExternalID: "C-2", Category: "Produce", Unit: "each", Size: measure.Size{Quantity: 1, Unit: measure.Count}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, enc := range []struct {
+			encoding Encoding
+			data     string
+		}{
+			{JSON, tt.json},
+			{NDJSON, tt.ndjson},
+			{CSV, tt.csv},
+		} {
+			t.Run(tt.name+"/"+string(enc.encoding), func(t *testing.T) {
+				products, rejected := readFeed(t, enc.encoding, enc.data)
+				if len(rejected) > 0 {
+					t.Fatalf("rejected rows: %v", rejected)
+				}
+				if !reflect.DeepEqual(products, tt.want) {
+					t.Errorf("products = %+v, want %+v", products, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestRejectedRowsKeepExternalID(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		data     string
+		want     string
+	}{
+		{
+			name:     "bad price",
+			encoding: JSON,
+			data:     `{"store_location_id": "A1", "products": [{"product_name": "Milk", "manufacturer": "Dairyland", "retail_price": "free", "sku": "A-1"}]}`,
+			want:     "A-1",
+		},
+		{
+			name:     "wrong type",
+			encoding: JSON,
+			data:     `{"store_location_id": "A1", "products": [{"product_name": ["Milk"], "manufacturer": "Dairyland", "retail_price": 1, "sku": "A-1"}]}`,
+			want:     "A-1",
+		},
+		{
+			name:     "column count",
+			encoding: CSV,
+			data:     "store_location_id,sku,product_name,manufacturer,retail_price\nA1,A-1,Milk,Dairyland,1.00\nA1,A-2,Bread\n",
+			want:     "A-2",
+		},
+		{
+			name:     "other store",
+			encoding: NDJSON,
+			data: `{"store_location_id": "A1", "product_name": "Milk", "manufacturer": "Dairyland", "retail_price": 1, "sku": "A-1"}
+{"store_location_id": "A2", "product_name": "Bread", "manufacturer": "Bakehouse", "retail_price": 1, "sku": "A-2"}
+`,
+			want: "A-2",
+		},
+		{
+			name:     "no external id",
+			encoding: JSON,
+			data:     `{"store_location_id": "A1", "products": [{"product_name": "Milk", "manufacturer": "Dairyland", "retail_price": "free"}]}`,
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, rejected := readFeed(t, tt.encoding, tt.data)
+			if len(rejected) != 1 {
+				t.Fatalf("rejected %d rows, want 1: %v", len(rejected), rejected)
+			}
+			if got := rejected[0].ExternalID; got != tt.want {
+				t.Errorf("ExternalID = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryDetect(t *testing.T) {
+	overlapping, err := NewMappingAdapter(Mapping{
+		Name:       "overlapping",
+		Grocer:     "store_b",
+		DetectKeys: []string{"location"},
+		StoreID:    "location.id",
+		Products:   "inventory",
+		Fields:     MappingFields{Name: "name", Brand: "brand", Price: "price"},
+	})
+	if err != nil {
+		t.Fatalf("NewMappingAdapter: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		adapters []FeedAdapter
+		feed     string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "store a",
+			adapters: []FeedAdapter{NewStoreAAdapter(), NewStoreBAdapter(), NewStoreCAdapter()},
+			feed:     `{"store_location_id": "A-1", "products": []}`,
+			want:     "store_a",
+		},
+		{
+			name:     "store b",
+			adapters: []FeedAdapter{NewStoreAAdapter(), NewStoreBAdapter(), NewStoreCAdapter()},
+			feed:     `{"location": {"id": "B-1"}, "inventory": []}`,
+			want:     "store_b",
+		},
+		{
+			name:     "store c",
+			adapters: []FeedAdapter{NewStoreAAdapter(), NewStoreBAdapter(), NewStoreCAdapter()},
+			feed:     `{"store_code": "C-1", "catalogue": []}`,
+			want:     "store_c",
+		},
+		{
+			name:     "no adapter matches",
+			adapters: []FeedAdapter{NewStoreAAdapter(), NewStoreBAdapter(), NewStoreCAdapter()},
+			feed:     `{"shop": "D-1", "items": []}`,
+			wantErr:  "unrecognized feed format",
+		},
+		{
+			name:     "several adapters match",
+			adapters: []FeedAdapter{NewStoreBAdapter(), overlapping},
+			feed:     `{"location": {"id": "B-1"}, "inventory": []}`,
+			wantErr:  "ambiguous feed format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRegistry(tt.adapters...)
+			if err != nil {
+				t.Fatalf("NewRegistry: %v", err)
+			}
+			outline, err := ReadOutline(strings.NewReader(tt.feed), JSON)
+			if err != nil {
+				t.Fatalf("ReadOutline: %v", err)
+			}
+
+			got, err := r.Detect(outline)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Detect() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if got.Name() != tt.want {
+				t.Errorf("Detect() = %s, want %s", got.Name(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryGet(t *testing.T) {
+	r := DefaultRegistry()
+	if err := r.Register(NewStoreBAdapter()); err == nil {
+		t.Error("Register() of a duplicate name succeeded, want an error")
+	}
+
+	tests := []struct {
+		name    string
+		format  string
+		wantErr bool
+	}{
+		{name: "known format", format: "store_c"},
+		{name: "unknown format", format: "store_z", wantErr: true},
+		{name: "empty format", format: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Get(tt.format)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Get(%q) = %s, want an error", tt.format, got.Name())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Get(%q) error = %v", tt.format, err)
+			}
+			if got.Name() != tt.format {
+				t.Errorf("Get(%q) = %s", tt.format, got.Name())
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package importerfeed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The types below decode a product field written either as a JSON number or
+// boolean, or as a string holding one. Every value of a CSV feed is a string,
+// so adapters use them for any non-string field a CSV row can carry.
+
+// LooseInt is an integer, given as a JSON number or a numeric string. An
+// empty string or null is zero.
+type LooseInt int
+
+func (n *LooseInt) UnmarshalJSON(data []byte) error {
+	text, err := looseText(data)
+	if err != nil || text == "" {
+		return err
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return fmt.Errorf("expected an integer, got %q", text)
+	}
+	*n = LooseInt(v)
+	return nil
+}
+
+// LooseBool is a boolean, given as a JSON boolean or as a string such as
+// "true", "false", "1" or "0". An empty string or null is false.
+type LooseBool bool
+
+func (b *LooseBool) UnmarshalJSON(data []byte) error {
+	text, err := looseText(data)
+	if err != nil || text == "" {
+		return err
+	}
+	v, err := strconv.ParseBool(text)
+	if err != nil {
+		return fmt.Errorf("expected a boolean, got %q", text)
+	}
+	*b = LooseBool(v)
+	return nil
+}
+
+// looseText returns the text of a JSON scalar, unquoted if it is a string.
+func looseText(data []byte) (string, error) {
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(s), nil
+	}
+	return string(data), nil
+}
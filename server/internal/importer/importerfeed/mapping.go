@@ -0,0 +1,251 @@
+package importerfeed
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// Mapping declaratively describes a grocer's feed layout so it can be
+// imported without a hand-written adapter. Paths are dot-separated object
+// keys, e.g. "pricing.current_price". Field paths are relative to a single
+// element of the products array. For a CSV or NDJSON feed, every path is
+// relative to a row, and names a column of a CSV feed.
+type Mapping struct {
+	// Name is the format name the mapping registers under.
+	Name string `json:"name"`
+	// Grocer is the grocer the feed's store belongs to, e.g. "store_b".
+	Grocer string `json:"grocer"`
+	// DetectKeys are top-level keys, or keys of the first row of a CSV or
+	// NDJSON feed, that identify the layout during format auto-detection. A
+	// mapping without detect keys must be selected by name.
+	DetectKeys []string `json:"detect_keys"`
+	// StoreID is the path to the grocer's store identifier.
+	StoreID string `json:"store_id"`
+	// Products is the path to the array of products. CSV and NDJSON feeds
+	// have no product array and leave it unset.
+	Products string        `json:"products"`
+	Fields   MappingFields `json:"fields"`
+}
+
+// MappingFields holds the paths of each product field within a product.
+type MappingFields struct {
+	Name       string `json:"name"`
+	Brand      string `json:"brand"`
+	Price      string `json:"price"`
+	ExternalID string `json:"external_id"`
+	Category   string `json:"category"`
+	Unit       string `json:"unit"`
+	// Currency is the path to the price's ISO 4217 currency code. Prices are
+	// taken to be in money.DefaultCurrency when it is unset.
+	Currency string `json:"currency"`
+}
+
+// LoadMapping reads a mapping file and returns an adapter that applies it.
+func LoadMapping(filePath string) (FeedAdapter, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading mapping file: %w", err)
+	}
+
+	var m Mapping
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing mapping file: %w", err)
+	}
+
+	return NewMappingAdapter(m)
+}
+
+// NewMappingAdapter validates m and returns an adapter that applies it.
+func NewMappingAdapter(m Mapping) (FeedAdapter, error) {
+	if m.Name == "" {
+		return nil, fmt.Errorf("mapping: name is required")
+	}
+	if err := store.GrocerValidator(store.Grocer(m.Grocer)); err != nil {
+		return nil, fmt.Errorf("mapping %q: %w", m.Name, err)
+	}
+
+	required := []struct{ key, path string }{
+		{"store_id", m.StoreID},
+		{"fields.name", m.Fields.Name},
+		{"fields.brand", m.Fields.Brand},
+		{"fields.price", m.Fields.Price},
+	}
+	for _, r := range required {
+		if r.path == "" {
+			return nil, fmt.Errorf("mapping %q: %s is required", m.Name, r.key)
+		}
+	}
+
+	return mappingAdapter{mapping: m}, nil
+}
+
+type mappingAdapter struct {
+	mapping Mapping
+}
+
+func (a mappingAdapter) Name() string {
+	return a.mapping.Name
+}
+
+func (a mappingAdapter) Detect(keys map[string]bool) bool {
+	if len(a.mapping.DetectKeys) == 0 {
+		return false
+	}
+	for _, key := range a.mapping.DetectKeys {
+		if !keys[key] {
+			return false
+		}
+	}
+	return true
+}
+
+func (a mappingAdapter) Header(outline *Outline) (*Feed, error) {
+	m := a.mapping
+
+	storeID, err := outline.String(m.StoreID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Feed{
+		StoreID: storeID,
+		Grocer:  store.Grocer(m.Grocer),
+		Paths: FieldPaths{
+			StoreID:    m.StoreID,
+			Products:   m.Products,
+			Name:       m.Fields.Name,
+			Brand:      m.Fields.Brand,
+			Price:      m.Fields.Price,
+			Currency:   m.Fields.Currency,
+			ExternalID: m.Fields.ExternalID,
+		},
+	}, nil
+}
+
+func (a mappingAdapter) DecodeProduct(raw json.RawMessage) (Product, error) {
+	// Numbers are kept as their decimal text so prices are read exactly.
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var row any
+	if err := dec.Decode(&row); err != nil {
+		return Product{}, err
+	}
+	return a.product(row)
+}
+
+func (a mappingAdapter) product(row any) (Product, error) {
+	f := a.mapping.Fields
+
+	var p Product
+	var err error
+	if p.Name, err = lookupString(row, f.Name); err != nil {
+		return p, err
+	}
+	if p.Brand, err = lookupString(row, f.Brand); err != nil {
+		return p, err
+	}
+	currency, err := lookupOptionalString(row, f.Currency)
+	if err != nil {
+		return p, err
+	}
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+	if p.Price, err = lookupMoney(row, f.Price, currency); err != nil {
+		return p, err
+	}
+	if p.ExternalID, err = lookupOptionalString(row, f.ExternalID); err != nil {
+		return p, err
+	}
+	if p.Category, err = lookupOptionalString(row, f.Category); err != nil {
+		return p, err
+	}
+	if p.Unit, err = lookupOptionalString(row, f.Unit); err != nil {
+		return p, err
+	}
+	p.Size, _ = measure.ParseUnit(p.Unit)
+	return p, nil
+}
+
+// fieldError reports a value that could not be read at a path.
+type fieldError struct {
+	path   string
+	reason string
+}
+
+func (e *fieldError) Error() string {
+	return e.path + ": " + e.reason
+}
+
+// lookup walks a dot-separated path through decoded JSON objects.
+func lookup(v any, path string) (any, error) {
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, &fieldError{path: path, reason: fmt.Sprintf("expected an object at %q", key)}
+		}
+		if v, ok = obj[key]; !ok {
+			return nil, &fieldError{path: path, reason: fmt.Sprintf("missing key %q", key)}
+		}
+	}
+	return v, nil
+}
+
+func lookupString(v any, path string) (string, error) {
+	raw, err := lookup(v, path)
+	if err != nil {
+		return "", err
+	}
+	switch val := raw.(type) {
+	case string:
+		return val, nil
+	case json.Number:
+		return val.String(), nil
+	default:
+		return "", &fieldError{path: path, reason: "expected a string"}
+	}
+}
+
+// lookupOptionalString is lookupString for fields a mapping may leave unset
+// or a row may omit.
+func lookupOptionalString(v any, path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	if _, err := lookup(v, path); err != nil {
+		return "", nil
+	}
+	return lookupString(v, path)
+}
+
+// lookupMoney reads a price in major units, given either as a JSON number or
+// as a numeric string.
+func lookupMoney(v any, path string, currency string) (money.Money, error) {
+	raw, err := lookup(v, path)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	var text string
+	switch val := raw.(type) {
+	case json.Number:
+		text = val.String()
+	case string:
+		text = val
+	default:
+		return money.Money{}, &fieldError{path: path, reason: "expected a number"}
+	}
+
+	m, err := money.Parse(text, currency)
+	if err != nil {
+		return money.Money{}, &fieldError{path: path, reason: "expected a number"}
+	}
+	return m, nil
+}
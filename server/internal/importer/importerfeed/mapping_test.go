@@ -0,0 +1,155 @@
+package importerfeed
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// testMapping is a valid mapping for a feed with nested product fields.
+func testMapping() Mapping {
+	return Mapping{
+		Name:       "store_d",
+		Grocer:     "store_b",
+		DetectKeys: []string{"shop", "goods"},
+		StoreID:    "shop.code",
+		Products:   "goods",
+		Fields: MappingFields{
+			Name:       "title",
+			Brand:      "maker.name",
+			Price:      "price.amount",
+			Currency:   "price.currency",
+			ExternalID: "sku",
+			Category:   "section",
+			Unit:       "pack",
+		},
+	}
+}
+
+func TestNewMappingAdapter(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(m *Mapping)
+		wantErr string
+	}{
+		{name: "valid", edit: func(m *Mapping) {}},
+		{name: "optional fields unset", edit: func(m *Mapping) { m.Fields = MappingFields{Name: "n", Brand: "b", Price: "p"} }},
+		{name: "no name", edit: func(m *Mapping) { m.Name = "" }, wantErr: "name is required"},
+		{name: "unknown grocer", edit: func(m *Mapping) { m.Grocer = "store_z" }, wantErr: "grocer"},
+		{name: "no store id", edit: func(m *Mapping) { m.StoreID = "" }, wantErr: "store_id is required"},
+		{name: "no name field", edit: func(m *Mapping) { m.Fields.Name = "" }, wantErr: "fields.name is required"},
+		{name: "no brand field", edit: func(m *Mapping) { m.Fields.Brand = "" }, wantErr: "fields.brand is required"},
+		{name: "no price field", edit: func(m *Mapping) { m.Fields.Price = "" }, wantErr: "fields.price is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testMapping()
+			tt.edit(&m)
+			_, err := NewMappingAdapter(m)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("NewMappingAdapter() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewMappingAdapter() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMappingDetect(t *testing.T) {
+	tests := []struct {
+		name       string
+		detectKeys []string
+		keys       map[string]bool
+		want       bool
+	}{
+		{name: "all keys present", detectKeys: []string{"shop", "goods"}, keys: map[string]bool{"shop": true, "goods": true, "extra": true}, want: true},
+		{name: "a key missing", detectKeys: []string{"shop", "goods"}, keys: map[string]bool{"shop": true}},
+		{name: "no detect keys", keys: map[string]bool{"shop": true, "goods": true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testMapping()
+			m.DetectKeys = tt.detectKeys
+			a, err := NewMappingAdapter(m)
+			if err != nil {
+				t.Fatalf("NewMappingAdapter: %v", err)
+			}
+			if got := a.Detect(tt.keys); got != tt.want {
+				t.Errorf("Detect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMappingDecodeProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		want     Product
+		wantPath string
+	}{
+		{
+			name: "every field",
+			raw:  `{"title": "Rice", "maker": {"name": "Paddy"}, "price": {"amount": 12.99, "currency": "USD"}, "sku": 4001, "section": "Pantry", "pack": "2kg bag"}`,
+			want: Product{Name: "Rice", Brand: "Paddy", Price: money.New(1299, "USD"), ExternalID: "4001", Category: "Pantry", Unit: "2kg bag", Size: measure.Grams(2000)},
+		},
+		{
+			name: "optional fields omitted",
+			raw:  `{"title": "Rice", "maker": {"name": "Paddy"}, "price": {"amount": "3.50"}}`,
+			want: Product{Name: "Rice", Brand: "Paddy", Price: money.New(350, "CAD")},
+		},
+		{
+			name:     "missing nested key",
+			raw:      `{"title": "Rice", "maker": {}, "price": {"amount": 3.5}}`,
+			wantPath: "maker.name",
+		},
+		{
+			name:     "object expected on the path",
+			raw:      `{"title": "Rice", "maker": "Paddy", "price": {"amount": 3.5}}`,
+			wantPath: "maker.name",
+		},
+		{
+			name:     "name that is not a string",
+			raw:      `{"title": ["Rice"], "maker": {"name": "Paddy"}, "price": {"amount": 3.5}}`,
+			wantPath: "title",
+		},
+		{
+			name:     "price that is not a number",
+			raw:      `{"title": "Rice", "maker": {"name": "Paddy"}, "price": {"amount": "cheap"}}`,
+			wantPath: "price.amount",
+		},
+	}
+
+	a, err := NewMappingAdapter(testMapping())
+	if err != nil {
+		t.Fatalf("NewMappingAdapter: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.DecodeProduct(json.RawMessage(tt.raw))
+			if tt.wantPath != "" {
+				fe, ok := err.(*fieldError)
+				if !ok || fe.path != tt.wantPath {
+					t.Errorf("DecodeProduct() error = %v, want a field error at %s", err, tt.wantPath)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeProduct() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeProduct() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
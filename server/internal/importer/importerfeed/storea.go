@@ -0,0 +1,77 @@
+package importerfeed
+
+import (
+	"encoding/json"
+
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// StoreAProduct maps to a single product in Store A's JSON data feed.
+type StoreAProduct struct {
+	ProductName  string      `json:"product_name"`
+	Manufacturer string      `json:"manufacturer"`
+	RetailPrice  json.Number `json:"retail_price"`
+	SKU          string      `json:"sku"`
+	Category     string      `json:"category"`
+	WeightGrams  LooseInt    `json:"weight_grams"`
+}
+
+type storeAAdapter struct{}
+
+// NewStoreAAdapter returns the adapter for Store A's feed layout.
+func NewStoreAAdapter() FeedAdapter {
+	return storeAAdapter{}
+}
+
+func (storeAAdapter) Name() string {
+	return string(store.GrocerStoreA)
+}
+
+func (storeAAdapter) Detect(keys map[string]bool) bool {
+	return keys["store_location_id"]
+}
+
+func (storeAAdapter) Header(outline *Outline) (*Feed, error) {
+	storeID, err := outline.OptionalString("store_location_id")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Feed{
+		StoreID: storeID,
+		Grocer:  store.GrocerStoreA,
+		Paths: FieldPaths{
+			StoreID:    "store_location_id",
+			Products:   "products",
+			Name:       "product_name",
+			Brand:      "manufacturer",
+			Price:      "retail_price",
+			ExternalID: "sku",
+		},
+	}, nil
+}
+
+func (storeAAdapter) DecodeProduct(raw json.RawMessage) (Product, error) {
+	var p StoreAProduct
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Product{}, err
+	}
+
+	price, err := money.Parse(p.RetailPrice.String(), money.DefaultCurrency)
+	if err != nil {
+		return Product{}, &fieldError{path: "retail_price", reason: err.Error()}
+	}
+	product := Product{
+		Name:       p.ProductName,
+		Brand:      p.Manufacturer,
+		Price:      price,
+		ExternalID: p.SKU,
+		Category:   p.Category,
+	}
+	if p.WeightGrams > 0 {
+		product.Size = measure.Grams(float64(p.WeightGrams))
+	}
+	return product, nil
+}
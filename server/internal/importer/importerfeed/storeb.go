@@ -0,0 +1,85 @@
+package importerfeed
+
+import (
+	"encoding/json"
+
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/money"
+)
+
+// StoreBItem describes the product on a Store B inventory line.
+type StoreBItem struct {
+	Label     string `json:"label"`
+	BrandName string `json:"brand_name"`
+}
+
+// StoreBPricing is the price block on a Store B inventory line.
+type StoreBPricing struct {
+	CurrentPrice json.Number `json:"current_price"`
+	Currency     string      `json:"currency"`
+}
+
+// StoreBProduct maps to a single inventory line in Store B's JSON data feed.
+type StoreBProduct struct {
+	Item    StoreBItem    `json:"item"`
+	Pricing StoreBPricing `json:"pricing"`
+	Barcode string        `json:"barcode"`
+}
+
+type storeBAdapter struct{}
+
+// NewStoreBAdapter returns the adapter for Store B's feed layout.
+func NewStoreBAdapter() FeedAdapter {
+	return storeBAdapter{}
+}
+
+func (storeBAdapter) Name() string {
+	return string(store.GrocerStoreB)
+}
+
+func (storeBAdapter) Detect(keys map[string]bool) bool {
+	return keys["location"]
+}
+
+func (storeBAdapter) Header(outline *Outline) (*Feed, error) {
+	storeID, err := outline.OptionalString("location.id")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Feed{
+		StoreID: storeID,
+		Grocer:  store.GrocerStoreB,
+		Paths: FieldPaths{
+			StoreID:    "location.id",
+			Products:   "inventory",
+			Name:       "item.label",
+			Brand:      "item.brand_name",
+			Price:      "pricing.current_price",
+			Currency:   "pricing.currency",
+			ExternalID: "barcode",
+		},
+	}, nil
+}
+
+func (storeBAdapter) DecodeProduct(raw json.RawMessage) (Product, error) {
+	var p StoreBProduct
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Product{}, err
+	}
+
+	currency := p.Pricing.Currency
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+	price, err := money.Parse(p.Pricing.CurrentPrice.String(), currency)
+	if err != nil {
+		return Product{}, &fieldError{path: "pricing.current_price", reason: err.Error()}
+	}
+	return Product{
+		Name:       p.Item.Label,
+		Brand:      p.Item.BrandName,
+		Price:      price,
+		ExternalID: p.Barcode,
+	}, nil
+}
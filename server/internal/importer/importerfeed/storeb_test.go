@@ -0,0 +1,67 @@
+package importerfeed
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"offgrocery-assessment/internal/money"
+)
+
+func TestStoreBDecodeProduct(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Product
+		wantErr bool
+	}{
+		{
+			name: "priced in its currency",
+			raw:  `{"item": {"label": "Cheddar", "brand_name": "Cheesy"}, "pricing": {"current_price": 7.5, "currency": "USD"}, "barcode": "0001"}`,
+			want: Product{Name: "Cheddar", Brand: "Cheesy", Price: money.New(750, "USD"), ExternalID: "0001"},
+		},
+		{
+			name: "currency defaults",
+			raw:  `{"item": {"label": "Butter", "brand_name": "Creamery"}, "pricing": {"current_price": 4}, "barcode": "0002"}`,
+			want: Product{Name: "Butter", Brand: "Creamery", Price: money.New(400, "CAD"), ExternalID: "0002"},
+		},
+		{
+			name: "zero decimal currency",
+			raw:  `{"item": {"label": "Tofu", "brand_name": "Soy"}, "pricing": {"current_price": 250, "currency": "JPY"}}`,
+			want: Product{Name: "Tofu", Brand: "Soy", Price: money.New(250, "JPY")},
+		},
+		{
+			name:    "missing price",
+			raw:     `{"item": {"label": "Butter", "brand_name": "Creamery"}, "pricing": {}}`,
+			wantErr: true,
+		},
+		{
+			name: "price rounded to the cent",
+			raw:  `{"item": {"label": "Butter", "brand_name": "Creamery"}, "pricing": {"current_price": 4.995}}`,
+			want: Product{Name: "Butter", Brand: "Creamery", Price: money.New(500, "CAD")},
+		},
+		{
+			name:    "price that is not a number",
+			raw:     `{"item": {"label": "Butter", "brand_name": "Creamery"}, "pricing": {"current_price": "4,99"}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewStoreBAdapter().DecodeProduct(json.RawMessage(tt.raw))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("DecodeProduct() = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeProduct() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeProduct() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package importerfeed
+
+import (
+	"encoding/json"
+
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/item/dietary"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// StoreCProduct maps to a single catalogue entry in Store C's JSON data feed.
+type StoreCProduct struct {
+	DisplayName string      `json:"display_name"`
+	Producer    string      `json:"producer"`
+	Cost        json.Number `json:"cost"`
+	ProductID   string      `json:"product_id"`
+	Aisle       string      `json:"aisle"`
+	Organic     LooseBool   `json:"organic"`
+	Unit        string      `json:"unit"`
+}
+
+type storeCAdapter struct{}
+
+// NewStoreCAdapter returns the adapter for Store C's feed layout.
+func NewStoreCAdapter() FeedAdapter {
+	return storeCAdapter{}
+}
+
+func (storeCAdapter) Name() string {
+	return string(store.GrocerStoreC)
+}
+
+func (storeCAdapter) Detect(keys map[string]bool) bool {
+	return keys["store_code"]
+}
+
+func (storeCAdapter) Header(outline *Outline) (*Feed, error) {
+	storeID, err := outline.OptionalString("store_code")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Feed{
+		StoreID: storeID,
+		Grocer:  store.GrocerStoreC,
+		Paths: FieldPaths{
+			StoreID:    "store_code",
+			Products:   "catalogue",
+			Name:       "display_name",
+			Brand:      "producer",
+			Price:      "cost",
+			ExternalID: "product_id",
+		},
+	}, nil
+}
+
+func (storeCAdapter) DecodeProduct(raw json.RawMessage) (Product, error) {
+	var p StoreCProduct
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Product{}, err
+	}
+
+	price, err := money.Parse(p.Cost.String(), money.DefaultCurrency)
+	if err != nil {
+		return Product{}, &fieldError{path: "cost", reason: err.Error()}
+	}
+	size, _ := measure.ParseUnit(p.Unit)
+	var attributes []string
+	if p.Organic {
+		attributes = append(attributes, dietary.Organic)
+	}
+	return Product{
+		Name:       p.DisplayName,
+		Brand:      p.Producer,
+		Price:      price,
+		ExternalID: p.ProductID,
+		Category:   p.Aisle,
+		Unit:       p.Unit,
+		Attributes: attributes,
+		Size:       size,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package importerfeed
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"offgrocery-assessment/internal/item/dietary"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+func TestStoreCDecodeProduct(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    Product
+		wantErr bool
+	}{
+		{
+			name: "organic with a sized unit",
+			raw:  `{"display_name": "Apples", "producer": "Orchard", "cost": 3.99, "product_id": "C-1", "aisle": "Produce", "organic": true, "unit": "per 1kg bag"}`,
+			want: Product{Name: "Apples", Brand: "Orchard", Price: money.New(399, "CAD"), ExternalID: "C-1", Category: "Produce", Unit: "per 1kg bag", Attributes: []string{dietary.Organic}, Size: measure.Grams(1000)},
+		},
+		{
+			name: "organic as text",
+			raw:  `{"display_name": "Kale", "producer": "Farm", "cost": "2.49", "organic": "1"}`,
+			want: Product{Name: "Kale", Brand: "Farm", Price: money.New(249, "CAD"), Attributes: []string{dietary.Organic}},
+		},
+		{
+			name: "not organic",
+			raw:  `{"display_name": "Pears", "producer": "Orchard", "cost": 2.5, "aisle": "Produce", "organic": false, "unit": "each"}`,
+			want: Product{Name: "Pears", Brand: "Orchard", Price: money.New(250, "CAD"), Category: "Produce", Unit: "each", Size: measure.Size{Quantity: 1, Unit: measure.Count}},
+		},
+		{
+			name: "unit without a size",
+			raw:  `{"display_name": "Oat Drink", "producer": "Oatly", "cost": 4.49, "aisle": "dairy alternatives", "unit": "carton"}`,
+			want: Product{Name: "Oat Drink", Brand: "Oatly", Price: money.New(449, "CAD"), Category: "dairy alternatives", Unit: "carton"},
+		},
+		{
+			name:    "organic that is not a boolean",
+			raw:     `{"display_name": "Kale", "producer": "Farm", "cost": 2.49, "organic": "yes"}`,
+			wantErr: true,
+		},
+		{
+			name:    "cost that is not a number",
+			raw:     `{"display_name": "Pears", "producer": "Orchard", "cost": "cheap"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewStoreCAdapter().DecodeProduct(json.RawMessage(tt.raw))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("DecodeProduct() = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeProduct() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeProduct() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
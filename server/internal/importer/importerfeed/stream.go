@@ -0,0 +1,446 @@
+package importerfeed
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Encoding is the file format a feed is written in.
+type Encoding string
+
+const (
+	// JSON feeds are a single JSON document holding an array of products.
+	JSON Encoding = "json"
+	// NDJSON feeds hold one JSON object per line, one product each.
+	NDJSON Encoding = "ndjson"
+	// CSV feeds hold a header row followed by one product per row. Header
+	// names are dot-separated paths, so a "pricing.current_price" column is
+	// read like the same field of a JSON product.
+	CSV Encoding = "csv"
+)
+
+// ParseEncoding returns the encoding named s.
+func ParseEncoding(s string) (Encoding, error) {
+	switch e := Encoding(strings.ToLower(s)); e {
+	case JSON, NDJSON, CSV:
+		return e, nil
+	default:
+		return "", fmt.Errorf("unknown feed encoding %q (known: json, ndjson, csv)", s)
+	}
+}
+
+// EncodingOf guesses a feed's encoding from its file name extension,
+// defaulting to JSON.
+func EncodingOf(fileName string) Encoding {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return CSV
+	case ".ndjson", ".jsonl":
+		return NDJSON
+	default:
+		return JSON
+	}
+}
+
+// Outline is what a single streaming pass over a feed learns without holding
+// its products in memory at once: enough to detect the feed's format, read
+// the store it describes and count its products. For a JSON feed it is the
+// document with its arrays left out. For a CSV or NDJSON feed it is the first
+// row, with the number of rows.
+type Outline struct {
+	encoding Encoding
+	doc      map[string]any
+	arrays   map[string]int
+	rows     int
+}
+
+// ReadOutline reads the outline of the feed in r.
+func ReadOutline(r io.Reader, encoding Encoding) (*Outline, error) {
+	o := &Outline{encoding: encoding, arrays: make(map[string]int)}
+
+	var err error
+	switch encoding {
+	case JSON:
+		err = o.readJSON(r)
+	case NDJSON:
+		err = eachNDJSON(r, o.readRow)
+	case CSV:
+		err = eachCSV(r, func(row map[string]any, rowErr error) error {
+			if rowErr != nil {
+				o.rows++
+				return nil
+			}
+			return o.readRow(row, nil)
+		})
+	default:
+		_, err = ParseEncoding(string(encoding))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// readJSON reads the outline of a JSON feed document.
+func (o *Outline) readJSON(r io.Reader) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return errors.New("feed must be a JSON object")
+	}
+
+	o.doc, err = o.readObject(dec, "")
+	return err
+}
+
+// readRow counts a row of a CSV or NDJSON feed, keeping the first one.
+func (o *Outline) readRow(row map[string]any, _ json.RawMessage) error {
+	if o.rows == 0 {
+		o.doc = row
+	}
+	o.rows++
+	return nil
+}
+
+// readObject reads the members of an object whose opening brace has been
+// consumed, up to and including its closing brace.
+func (o *Outline) readObject(dec *json.Decoder, prefix string) (map[string]any, error) {
+	obj := make(map[string]any)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := tok.(string)
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if obj[key], err = o.readValue(dec, path); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// readValue reads the value at path. Arrays are counted and skipped one
+// element at a time, and read as nil.
+func (o *Outline) readValue(dec *json.Decoder, path string) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		return o.readObject(dec, path)
+	case json.Delim('['):
+		n := 0
+		for ; dec.More(); n++ {
+			var element json.RawMessage
+			if err := dec.Decode(&element); err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", path, n, err)
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		o.arrays[path] = n
+		return nil, nil
+	default:
+		return tok, nil
+	}
+}
+
+// Keys returns the document's top-level keys, or the first row's for a CSV
+// or NDJSON feed.
+func (o *Outline) Keys() map[string]bool {
+	keys := make(map[string]bool, len(o.doc))
+	for key := range o.doc {
+		keys[key] = true
+	}
+	return keys
+}
+
+// String returns the string at a dot-separated path.
+func (o *Outline) String(path string) (string, error) {
+	return lookupString(o.doc, path)
+}
+
+// OptionalString returns the string at a dot-separated path, or an empty
+// string if the path is missing.
+func (o *Outline) OptionalString(path string) (string, error) {
+	return lookupOptionalString(o.doc, path)
+}
+
+// Len returns the number of elements of the array at a dot-separated path,
+// and false if there is no array there.
+func (o *Outline) Len(path string) (int, bool) {
+	n, ok := o.arrays[path]
+	return n, ok
+}
+
+// Each streams the products of the feed in r, the file the feed's outline was
+// read from. accept is called with each row the adapter decodes and reject
+// with each row it cannot, in file order. An error from either callback stops
+// the stream and is returned. Every row of a CSV or NDJSON feed must name the
+// feed's store.
+func (f *Feed) Each(r io.Reader, accept func(Product) error, reject func(RowError) error) error {
+	// A rejected row still names its item when its external id can be read,
+	// so the item is not delisted while the grocer lists it.
+	rejectRow := func(index int, row map[string]any, err error) error {
+		e := rowError(f.Paths.Products, index, err)
+		e.ExternalID, _ = lookupOptionalString(row, f.Paths.ExternalID)
+		return reject(e)
+	}
+	decode := func(index int, row map[string]any, raw json.RawMessage) error {
+		p, err := f.adapter.DecodeProduct(raw)
+		if err != nil {
+			if row == nil {
+				row = decodeRow(raw)
+			}
+			return rejectRow(index, row, err)
+		}
+		p.Index = index
+		return accept(p)
+	}
+
+	index := 0
+	switch f.encoding {
+	case NDJSON:
+		return eachNDJSON(r, func(row map[string]any, raw json.RawMessage) error {
+			defer func() { index++ }()
+			if err := f.checkStore(row); err != nil {
+				return rejectRow(index, row, err)
+			}
+			return decode(index, row, raw)
+		})
+	case CSV:
+		return eachCSV(r, func(row map[string]any, rowErr error) error {
+			defer func() { index++ }()
+			if rowErr != nil {
+				return rejectRow(index, row, rowErr)
+			}
+			if err := f.checkStore(row); err != nil {
+				return rejectRow(index, row, err)
+			}
+			raw, err := json.Marshal(row)
+			if err != nil {
+				return err
+			}
+			return decode(index, row, raw)
+		})
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	if err := seekArray(dec, f.Paths.Products); err != nil {
+		return err
+	}
+
+	for i := 0; dec.More(); i++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("%s[%d]: %w", f.Paths.Products, i, err)
+		}
+
+		if err := decode(i, nil, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeRow decodes a JSON product row as an object, or returns nil if it is
+// not one.
+func decodeRow(raw json.RawMessage) map[string]any {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var row map[string]any
+	if err := dec.Decode(&row); err != nil {
+		return nil
+	}
+	return row
+}
+
+// checkStore reports a CSV or NDJSON row that names a store other than the
+// feed's.
+func (f *Feed) checkStore(row map[string]any) error {
+	storeID, err := lookupOptionalString(row, f.Paths.StoreID)
+	if err != nil {
+		return err
+	}
+	if storeID != f.StoreID {
+		return &fieldError{path: f.Paths.StoreID, reason: fmt.Sprintf("store id %q differs from the feed's %q", storeID, f.StoreID)}
+	}
+	return nil
+}
+
+// eachNDJSON calls fn with each object in a stream of JSON values, decoded
+// and raw.
+func eachNDJSON(r io.Reader, fn func(row map[string]any, raw json.RawMessage) error) error {
+	dec := json.NewDecoder(r)
+	for i := 0; dec.More(); i++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("row %d: %w", i, err)
+		}
+
+		// Numbers are kept as their decimal text so prices are read exactly.
+		rowDec := json.NewDecoder(bytes.NewReader(raw))
+		rowDec.UseNumber()
+		var row map[string]any
+		if err := rowDec.Decode(&row); err != nil || row == nil {
+			return fmt.Errorf("row %d: expected a JSON object", i)
+		}
+
+		if err := fn(row, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eachCSV calls fn with each data row of a CSV file as an object keyed by the
+// header row, with dotted column names expanded into nested objects. A row
+// with the wrong number of columns is passed with an error, and holds the
+// columns both it and the header have.
+func eachCSV(r io.Reader, fn func(row map[string]any, rowErr error) error) error {
+	cr := csv.NewReader(r)
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return errors.New("csv feed has no header row")
+	}
+	if err != nil {
+		return fmt.Errorf("reading csv header: %w", err)
+	}
+	// Spreadsheet exports often start with a byte order mark.
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		var rowErr error
+		if errors.Is(err, csv.ErrFieldCount) {
+			rowErr = fmt.Errorf("expected %d columns, got %d", len(header), len(record))
+		} else if err != nil {
+			return fmt.Errorf("reading csv: %w", err)
+		}
+
+		row := make(map[string]any, len(header))
+		for i, column := range header[:min(len(header), len(record))] {
+			setPath(row, column, record[i])
+		}
+		if err := fn(row, rowErr); err != nil {
+			return err
+		}
+	}
+}
+
+// setPath stores value in obj at a dot-separated path, creating the objects
+// along it.
+func setPath(obj map[string]any, path string, value string) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := obj[key].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			obj[key] = next
+		}
+		obj = next
+	}
+	obj[keys[len(keys)-1]] = value
+}
+
+// rowError locates a row decoding error within the product array.
+func rowError(products string, index int, err error) RowError {
+	path := fmt.Sprintf("%s[%d]", products, index)
+	reason := err.Error()
+
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		path += "." + fe.path
+		reason = fe.reason
+	}
+	return RowError{Index: index, Path: path, Reason: reason}
+}
+
+// seekArray advances dec past the opening bracket of the array at a
+// dot-separated path, skipping everything before it.
+func seekArray(dec *json.Decoder, path string) error {
+	keys := strings.Split(path, ".")
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	for depth, key := range keys {
+		if tok != json.Delim('{') {
+			return fmt.Errorf("%s: expected an object at %q", path, key)
+		}
+
+		found := false
+		for !found && dec.More() {
+			if tok, err = dec.Token(); err != nil {
+				return err
+			}
+			if tok == key {
+				found = true
+				break
+			}
+			if err := skipValue(dec); err != nil {
+				return err
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: missing key %q", path, key)
+		}
+
+		if tok, err = dec.Token(); err != nil {
+			return err
+		}
+		if depth == len(keys)-1 && tok != json.Delim('[') {
+			return fmt.Errorf("%s: expected an array", path)
+		}
+	}
+	return nil
+}
+
+// skipValue reads past the next value without keeping it.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
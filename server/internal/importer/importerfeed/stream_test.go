@@ -0,0 +1,258 @@
+package importerfeed
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadOutline(t *testing.T) {
+	tests := []struct {
+		name      string
+		encoding  Encoding
+		data      string
+		wantKeys  []string
+		wantStore string
+		wantLens  map[string]int
+		wantRows  int
+		wantErr   bool
+	}{
+		{
+			name:      "json document",
+			encoding:  JSON,
+			data:      `{"store_location_id": "A1", "products": [{"sku": "A-1"}, {"sku": "A-2"}]}`,
+			wantKeys:  []string{"store_location_id", "products"},
+			wantStore: "A1",
+			wantLens:  map[string]int{"products": 2},
+		},
+		{
+			name:      "nested json arrays",
+			encoding:  JSON,
+			data:      `{"location": {"id": "B1", "aisles": [[1], [2], [3]]}, "inventory": []}`,
+			wantKeys:  []string{"location", "inventory"},
+			wantStore: "",
+			wantLens:  map[string]int{"location.aisles": 3, "inventory": 0},
+		},
+		{
+			name:      "ndjson first row",
+			encoding:  NDJSON,
+			data:      "{\"store_location_id\": \"A1\", \"sku\": \"A-1\"}\n{\"store_location_id\": \"A1\", \"sku\": \"A-2\"}\n{\"store_location_id\": \"A1\", \"sku\": \"A-3\"}\n",
+			wantKeys:  []string{"store_location_id", "sku"},
+			wantStore: "A1",
+			wantRows:  3,
+		},
+		{
+			name:      "csv header with byte order mark",
+			encoding:  CSV,
+			data:      "\ufeffstore_location_id,sku\nA1,A-1\nA1,A-2\n",
+			wantKeys:  []string{"store_location_id", "sku"},
+			wantStore: "A1",
+			wantRows:  2,
+		},
+		{
+			name:      "csv rows with the wrong column count are counted",
+			encoding:  CSV,
+			data:      "store_location_id,sku\nA1,A-1\nA1\n",
+			wantKeys:  []string{"store_location_id", "sku"},
+			wantStore: "A1",
+			wantRows:  2,
+		},
+		{name: "json array document", encoding: JSON, data: `[{"sku": "A-1"}]`, wantErr: true},
+		{name: "truncated json", encoding: JSON, data: `{"products": [{"sku": "A-1"}`, wantErr: true},
+		{name: "ndjson row not an object", encoding: NDJSON, data: "[1, 2]\n", wantErr: true},
+		{name: "csv without header", encoding: CSV, data: "", wantErr: true},
+		{name: "unknown encoding", encoding: "xml", data: "<feed/>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := ReadOutline(strings.NewReader(tt.data), tt.encoding)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ReadOutline() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadOutline() error = %v", err)
+			}
+
+			wantKeys := make(map[string]bool, len(tt.wantKeys))
+			for _, key := range tt.wantKeys {
+				wantKeys[key] = true
+			}
+			if got := o.Keys(); !reflect.DeepEqual(got, wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, wantKeys)
+			}
+			if got, _ := o.OptionalString("store_location_id"); got != tt.wantStore {
+				t.Errorf("OptionalString(store_location_id) = %q, want %q", got, tt.wantStore)
+			}
+			for path, want := range tt.wantLens {
+				if got, ok := o.Len(path); !ok || got != want {
+					t.Errorf("Len(%q) = %d, %v, want %d, true", path, got, ok, want)
+				}
+			}
+			if o.rows != tt.wantRows {
+				t.Errorf("rows = %d, want %d", o.rows, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestSeekArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"top-level array", `{"products": [{"sku": "A-1"}]}`, "products", `{"sku": "A-1"}`, false},
+		{"skips earlier members", `{"store": {"id": "A1", "tags": ["x"]}, "count": 1, "products": [7]}`, "products", `7`, false},
+		{"nested array", `{"location": {"id": "B1", "inventory": ["B-1"]}}`, "location.inventory", `"B-1"`, false},
+		{"empty array", `{"products": []}`, "products", ``, false},
+		{"missing key", `{"items": []}`, "products", ``, true},
+		{"missing nested key", `{"location": {"id": "B1"}}`, "location.inventory", ``, true},
+		{"not an array", `{"products": {"sku": "A-1"}}`, "products", ``, true},
+		{"parent not an object", `{"location": ["B1"]}`, "location.inventory", ``, true},
+		{"document not an object", `[1, 2]`, "products", ``, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec := json.NewDecoder(strings.NewReader(tt.data))
+			err := seekArray(dec, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("seekArray(%q) error = %v, want error %v", tt.path, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var got string
+			if dec.More() {
+				var first json.RawMessage
+				if err := dec.Decode(&first); err != nil {
+					t.Fatalf("decoding first element: %v", err)
+				}
+				got = string(first)
+			}
+			if got != tt.want {
+				t.Errorf("first element = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEach(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding Encoding
+		data     string
+		// outline is read in place of data when data has none.
+		outline      string
+		wantAccepted []int
+		wantRejected []int
+		wantErr      bool
+	}{
+		{
+			name:         "json rows in order",
+			encoding:     JSON,
+			data:         `{"store_location_id": "A1", "products": [{"product_name": "Milk", "manufacturer": "Dairyland", "retail_price": 1, "sku": "A-1"}, {"product_name": "Bread", "manufacturer": "Bakehouse", "retail_price": "x", "sku": "A-2"}, {"product_name": "Eggs", "manufacturer": "Farm", "retail_price": 3, "sku": "A-3"}]}`,
+			wantAccepted: []int{0, 2},
+			wantRejected: []int{1},
+		},
+		{
+			name:     "ndjson rows from other stores are rejected",
+			encoding: NDJSON,
+			data: `{"store_location_id": "A1", "product_name": "Milk", "manufacturer": "Dairyland", "retail_price": 1, "sku": "A-1"}
+{"store_location_id": "A2", "product_name": "Bread", "manufacturer": "Bakehouse", "retail_price": 2, "sku": "A-2"}
+{"product_name": "Eggs", "manufacturer": "Farm", "retail_price": 3, "sku": "A-3"}
+`,
+			wantAccepted: []int{0},
+			wantRejected: []int{1, 2},
+		},
+		{
+			name:         "csv rows with the wrong column count are rejected",
+			encoding:     CSV,
+			data:         "store_location_id,product_name,manufacturer,retail_price,sku\nA1,Milk,Dairyland,1,A-1\nA1,Bread\nA1,Eggs,Farm,3,A-3\n",
+			wantAccepted: []int{0, 2},
+			wantRejected: []int{1},
+		},
+		{
+			name:     "malformed json element stops the stream",
+			encoding: JSON,
+			data:     `{"store_location_id": "A1", "products": [{"product_name": "Milk", "manufacturer": "Dairyland", "retail_price": 1, "sku": "A-1"}, {"product_name": }]}`,
+			outline:  `{"store_location_id": "A1", "products": []}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outlineData := tt.data
+			if tt.outline != "" {
+				outlineData = tt.outline
+			}
+			outline, err := ReadOutline(strings.NewReader(outlineData), tt.encoding)
+			if err != nil {
+				t.Fatalf("ReadOutline: %v", err)
+			}
+			feed, err := Open(NewStoreAAdapter(), outline)
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+
+			var accepted, rejected []int
+			err = feed.Each(strings.NewReader(tt.data),
+				func(p Product) error {
+					accepted = append(accepted, p.Index)
+					return nil
+				},
+				func(e RowError) error {
+					rejected = append(rejected, e.Index)
+					return nil
+				},
+			)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Each() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(accepted, tt.wantAccepted) {
+				t.Errorf("accepted rows %v, want %v", accepted, tt.wantAccepted)
+			}
+			if !reflect.DeepEqual(rejected, tt.wantRejected) {
+				t.Errorf("rejected rows %v, want %v", rejected, tt.wantRejected)
+			}
+		})
+	}
+}
+
+func TestEachStopsOnCallbackError(t *testing.T) {
+	data := `{"store_location_id": "A1", "products": [{"product_name": "Milk", "manufacturer": "Dairyland", "retail_price": 1, "sku": "A-1"}, {"product_name": "Bread", "manufacturer": "Bakehouse", "retail_price": 2, "sku": "A-2"}]}`
+	outline, err := ReadOutline(strings.NewReader(data), JSON)
+	if err != nil {
+		t.Fatalf("ReadOutline: %v", err)
+	}
+	feed, err := Open(NewStoreAAdapter(), outline)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = feed.Each(strings.NewReader(data),
+		func(Product) error {
+			calls++
+			return stop
+		},
+		func(RowError) error { return nil },
+	)
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("Each() = %v after %d calls, want %v after 1", err, calls, stop)
+	}
+}
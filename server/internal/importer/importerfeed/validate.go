@@ -0,0 +1,72 @@
+package importerfeed
+
+import (
+	"fmt"
+	"strings"
+
+	"offgrocery-assessment/internal/money"
+)
+
+// RowError describes a feed row that was rejected, locating the offending
+// value by the row's index and JSON path. ExternalID is the row's external
+// id, when it could be read.
+type RowError struct {
+	Index      int    `json:"index"`
+	Path       string `json:"path"`
+	Reason     string `json:"reason"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Reason)
+}
+
+// Validator checks products against the rules the database enforces, one
+// row at a time as a feed is streamed. It keeps nothing between rows, so
+// memory stays flat however long the feed. Rows repeating an external id are
+// not rejected: the import writes them on the unique (store, external_id)
+// key, so the last one wins.
+type Validator struct {
+	paths FieldPaths
+}
+
+// NewValidator returns a validator for the products of feed.
+func NewValidator(feed *Feed) *Validator {
+	return &Validator{paths: feed.Paths}
+}
+
+// Check returns the problem with p, or nil if p is valid.
+func (v *Validator) Check(p Product) *RowError {
+	field, reason := checkProduct(p, v.paths)
+	if reason == "" {
+		return nil
+	}
+	return &RowError{
+		Index:      p.Index,
+		Path:       fmt.Sprintf("%s[%d].%s", v.paths.Products, p.Index, field),
+		Reason:     reason,
+		ExternalID: p.ExternalID,
+	}
+}
+
+// checkProduct returns the path and reason of the first problem with p, or an
+// empty reason if p is valid.
+func checkProduct(p Product, paths FieldPaths) (string, string) {
+	if strings.TrimSpace(p.Name) == "" {
+		return paths.Name, "name is empty"
+	}
+	if strings.TrimSpace(p.Brand) == "" {
+		return paths.Brand, "brand is empty"
+	}
+	if !p.Price.IsPositive() {
+		return paths.Price, fmt.Sprintf("price must be positive, got %s", p.Price.Decimal())
+	}
+	if !money.ValidCurrency(p.Price.Currency) {
+		path := paths.Currency
+		if path == "" {
+			path = paths.Price
+		}
+		return path, fmt.Sprintf("currency must be a three-letter ISO 4217 code, got %q", p.Price.Currency)
+	}
+	return "", ""
+}
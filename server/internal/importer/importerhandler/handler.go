@@ -0,0 +1,153 @@
+package importerhandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/httputil"
+	"offgrocery-assessment/internal/importer/importerservice"
+)
+
+type Handler interface {
+	Routes() chi.Router
+	ListRuns(w http.ResponseWriter, r *http.Request)
+	GetRun(w http.ResponseWriter, r *http.Request)
+	UploadFeed(w http.ResponseWriter, r *http.Request)
+	GetJob(w http.ResponseWriter, r *http.Request)
+}
+
+type handler struct {
+	service importerservice.Service
+	queue   importerservice.Queue
+	// admin guards every route: runs and jobs carry feed file names, error
+	// messages and rejected rows, which are not for the public.
+	admin func(http.Handler) http.Handler
+}
+
+func New(service importerservice.Service, queue importerservice.Queue, admin func(http.Handler) http.Handler) *handler {
+	return &handler{service: service, queue: queue, admin: admin}
+}
+
+func (h *handler) Routes() chi.Router {
+	r := chi.NewRouter()
+	r.Use(h.admin)
+	r.Get("/", h.ListRuns)
+	r.Post("/", h.UploadFeed)
+	r.Get("/jobs/{id}", h.GetJob)
+	r.Get("/{id}", h.GetRun)
+	return r
+}
+
+// maxUploadSize caps the size of an uploaded feed.
+const maxUploadSize = 100 << 20
+
+func (h *handler) ListRuns(w http.ResponseWriter, r *http.Request) {
+	limit := 20
+	if countStr := r.URL.Query().Get("count"); countStr != "" {
+		parsed, err := strconv.Atoi(countStr)
+		if err != nil || parsed < 1 {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid count"})
+			return
+		}
+		limit = parsed
+	}
+
+	runs, err := h.service.ListRuns(r.Context(), limit)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to list import runs"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, runs)
+}
+
+func (h *handler) GetRun(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid import run id"})
+		return
+	}
+
+	run, err := h.service.GetRun(r.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "import run not found"})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to get import run"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, run)
+}
+
+// UploadFeed queues a feed uploaded as the "file" field of a multipart form
+// for a background import and returns the job to poll. The optional "format",
+// "force" and "max_errors" fields mirror the import command's flags. The
+// route requires the admin token.
+func (h *handler) UploadFeed(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid multipart form"})
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "file is required"})
+		return
+	}
+	defer file.Close()
+
+	opts := importerservice.JobOptions{
+		Format:    r.FormValue("format"),
+		Force:     r.FormValue("force") == "true",
+		MaxErrors: 10,
+	}
+	if maxErrorsStr := r.FormValue("max_errors"); maxErrorsStr != "" {
+		if opts.MaxErrors, err = strconv.Atoi(maxErrorsStr); err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid max_errors"})
+			return
+		}
+	}
+
+	job, err := h.queue.Enqueue(r.Context(), header.Filename, file, opts)
+	if err != nil {
+		if errors.Is(err, importerservice.ErrUnknownFormat) {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: err.Error()})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to queue import"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusAccepted, job)
+}
+
+// GetJob returns an import job's status and progress, and the summary of its
+// import run once it has finished.
+func (h *handler) GetJob(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid import job id"})
+		return
+	}
+
+	job, err := h.queue.GetJob(r.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "import job not found"})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to get import job"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, job)
+}
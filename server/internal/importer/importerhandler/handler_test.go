@@ -0,0 +1,42 @@
+package importerhandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"offgrocery-assessment/internal/httputil"
+)
+
+func TestRoutesRequireAdmin(t *testing.T) {
+	// The service and queue are never reached, so nil ones are enough: the
+	// admin middleware turns the request away first.
+	routes := New(nil, nil, httputil.RequireAdmin("secret")).Routes()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		auth   string
+	}{
+		{"list runs", http.MethodGet, "/", ""},
+		{"get run", http.MethodGet, "/1", ""},
+		{"get job", http.MethodGet, "/jobs/1", ""},
+		{"get job with wrong token", http.MethodGet, "/jobs/1", "Bearer guess"},
+		{"upload", http.MethodPost, "/", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			routes.ServeHTTP(w, r)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("%s %s status = %d, want %d", tt.method, tt.path, w.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
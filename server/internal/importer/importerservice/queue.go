@@ -0,0 +1,282 @@
+package importerservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/importer/importerfeed"
+	"offgrocery-assessment/internal/importer/importerstore"
+)
+
+// ErrUnknownFormat is returned by Enqueue when the format hint names no
+// registered feed adapter.
+var ErrUnknownFormat = errors.New("unknown feed format")
+
+// queuePollInterval is how often the queue looks for jobs when it has not
+// been told about a new one.
+const queuePollInterval = 10 * time.Second
+
+// jobLease is how long a claimed job stays with its queue without a
+// heartbeat. A queue that stops, or loses the database, for longer has its
+// running job claimed by another one. Heartbeats renew the lease every
+// jobHeartbeat.
+const (
+	jobLease     = 2 * time.Minute
+	jobHeartbeat = jobLease / 4
+)
+
+// JobOptions configures an uploaded feed's import.
+type JobOptions struct {
+	// Format names the feed adapter to use. Empty detects it from the file.
+	Format    string
+	Force     bool
+	MaxErrors int
+}
+
+// Queue runs imports of uploaded feeds in the background, one at a time.
+// Jobs are kept in the database, so queued jobs survive a restart, and a
+// running job is leased to the queue that claimed it, so several servers can
+// share the queue and a job whose server died is picked up again once its
+// lease runs out.
+type Queue interface {
+	Enqueue(ctx context.Context, fileName string, feed io.Reader, opts JobOptions) (*ent.ImportJob, error)
+	GetJob(ctx context.Context, id int) (*ent.ImportJob, error)
+	Run(ctx context.Context)
+}
+
+type queue struct {
+	store     importerstore.Store
+	service   Service
+	registry  *importerfeed.Registry
+	uploadDir string
+	wake      chan struct{}
+	// owner identifies the queue on the jobs it claims.
+	owner string
+}
+
+// NewQueue returns a queue that spools uploaded feeds to uploadDir and
+// imports them with service.
+func NewQueue(store importerstore.Store, service Service, registry *importerfeed.Registry, uploadDir string) *queue {
+	return &queue{
+		store:     store,
+		service:   service,
+		registry:  registry,
+		uploadDir: uploadDir,
+		wake:      make(chan struct{}, 1),
+		owner:     queueOwner(),
+	}
+}
+
+// queueOwner returns a name for a queue that is unique across servers and
+// restarts.
+func queueOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return fmt.Sprintf("%s/%d/%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
+
+// Enqueue spools an uploaded feed to disk and queues it for import.
+func (q *queue) Enqueue(ctx context.Context, fileName string, feed io.Reader, opts JobOptions) (*ent.ImportJob, error) {
+	if opts.Format != "" {
+		if _, err := q.registry.Get(opts.Format); err != nil {
+			return nil, fmt.Errorf("%w %q", ErrUnknownFormat, opts.Format)
+		}
+	}
+
+	if err := os.MkdirAll(q.uploadDir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating upload directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(q.uploadDir, "feed-*"+filepath.Ext(fileName))
+	if err != nil {
+		return nil, fmt.Errorf("creating upload file: %w", err)
+	}
+	if _, err := io.Copy(f, feed); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("writing upload file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("writing upload file: %w", err)
+	}
+
+	job, err := q.store.CreateJob(ctx, importerstore.JobParams{
+		FileName:  filepath.Base(fileName),
+		FilePath:  f.Name(),
+		Format:    opts.Format,
+		Force:     opts.Force,
+		MaxErrors: opts.MaxErrors,
+	})
+	if err != nil {
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("creating import job: %w", err)
+	}
+
+	slog.Info("importer: queued import job", "job", job.ID, "file", job.FileName)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	return job, nil
+}
+
+// GetJob returns an import job with its run, once it has one.
+func (q *queue) GetJob(ctx context.Context, id int) (*ent.ImportJob, error) {
+	return q.store.GetJob(ctx, id)
+}
+
+// Run imports queued jobs until ctx is cancelled.
+func (q *queue) Run(ctx context.Context) {
+	ticker := time.NewTicker(queuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain runs queued jobs until none are left.
+func (q *queue) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := q.store.ClaimNextJob(ctx, q.owner, jobLease)
+		if ent.IsNotFound(err) {
+			return
+		}
+		if err != nil {
+			slog.Error("importer: failed to claim import job", "error", err)
+			return
+		}
+
+		q.runJob(ctx, job)
+	}
+}
+
+// runJob imports a claimed job, renewing its lease while the import runs.
+// The import is cancelled if the lease is lost, since another queue may have
+// claimed the job by then.
+func (q *queue) runJob(ctx context.Context, job *ent.ImportJob) {
+	slog.Info("importer: running import job", "job", job.ID, "file", job.FileName, "owner", q.owner)
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	heartbeat := make(chan struct{})
+	go func() {
+		defer close(heartbeat)
+		q.heartbeat(jobCtx, cancel, job.ID)
+	}()
+
+	run, err := q.service.Import(jobCtx, job.FilePath, Options{
+		FileName:  job.FileName,
+		Format:    job.Format,
+		Force:     job.Force,
+		MaxErrors: job.MaxErrors,
+		Progress: func(processed, total int) {
+			if err := q.store.UpdateJobProgress(jobCtx, job.ID, processed, total); err != nil {
+				slog.Warn("importer: failed to record job progress", "job", job.ID, "error", err)
+			}
+		},
+	})
+	interrupted := err != nil && jobCtx.Err() != nil
+	cancel()
+	<-heartbeat
+
+	outcome := importerstore.JobOutcome{Status: importjob.StatusSucceeded}
+	if run != nil {
+		outcome.RunID = run.ID
+	}
+
+	// The outcome is recorded even when ctx was cancelled by a shutdown, so
+	// the job does not stay running until its lease runs out.
+	recordCtx, cancelRecord := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancelRecord()
+
+	// An interrupted import was rolled back, so its job goes back in the
+	// queue with its feed, for this or another queue to run again.
+	if interrupted {
+		slog.Warn("importer: interrupted import job", "job", job.ID, "error", err)
+		outcome.Error = fmt.Sprintf("import interrupted: %v", err)
+		if err := q.store.ReleaseJob(recordCtx, job.ID, q.owner, outcome); err != nil {
+			slog.Error("importer: failed to release import job", "job", job.ID, "error", err)
+		}
+		return
+	}
+
+	switch {
+	case errors.Is(err, ErrAlreadyImported):
+		outcome.Status = importjob.StatusSkipped
+	case err != nil:
+		outcome.Status = importjob.StatusFailed
+		outcome.Error = err.Error()
+	}
+
+	if _, err := q.store.FinishJob(recordCtx, job.ID, q.owner, outcome); err != nil {
+		slog.Error("importer: failed to record import job outcome", "job", job.ID, "error", err)
+		// Another queue has claimed the job and needs its feed.
+		if ent.IsNotFound(err) {
+			return
+		}
+	}
+
+	if err := os.Remove(job.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.Warn("importer: failed to remove uploaded feed", "job", job.ID, "error", err)
+	}
+
+	slog.Info("importer: finished import job", "job", job.ID, "status", outcome.Status)
+}
+
+// heartbeat renews the lease on a job every jobHeartbeat until ctx is
+// cancelled. It calls cancel when the lease is lost to another queue, or
+// when it could not be renewed before it ran out.
+func (q *queue) heartbeat(ctx context.Context, cancel context.CancelFunc, jobID int) {
+	ticker := time.NewTicker(jobHeartbeat)
+	defer ticker.Stop()
+
+	renewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := q.store.RenewJobLease(ctx, jobID, q.owner, jobLease)
+		switch {
+		case err == nil:
+			renewed = time.Now()
+		case ent.IsNotFound(err):
+			slog.Warn("importer: import job lease was taken over", "job", jobID)
+			cancel()
+			return
+		default:
+			slog.Warn("importer: failed to renew import job lease", "job", jobID, "error", err)
+			if time.Since(renewed) >= jobLease {
+				cancel()
+				return
+			}
+		}
+	}
+}
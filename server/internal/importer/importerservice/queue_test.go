@@ -0,0 +1,131 @@
+package importerservice
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/importer/importerstore"
+)
+
+// fakeJobStore records what the queue does with the job it runs.
+type fakeJobStore struct {
+	importerstore.Store
+	// lost makes the store report the job's lease as taken over.
+	lost     bool
+	finished *importerstore.JobOutcome
+	released *importerstore.JobOutcome
+}
+
+func (s *fakeJobStore) RenewJobLease(ctx context.Context, id int, owner string, lease time.Duration) error {
+	return nil
+}
+
+func (s *fakeJobStore) UpdateJobProgress(ctx context.Context, id int, processed, total int) error {
+	return nil
+}
+
+func (s *fakeJobStore) FinishJob(ctx context.Context, id int, owner string, outcome importerstore.JobOutcome) (*ent.ImportJob, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s.lost {
+		return nil, &ent.NotFoundError{}
+	}
+	s.finished = &outcome
+	return &ent.ImportJob{ID: id}, nil
+}
+
+func (s *fakeJobStore) ReleaseJob(ctx context.Context, id int, owner string, outcome importerstore.JobOutcome) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s.lost {
+		return &ent.NotFoundError{}
+	}
+	s.released = &outcome
+	return nil
+}
+
+// fakeImporter imports by calling its import function.
+type fakeImporter struct {
+	Service
+	importFn func(ctx context.Context) (*ent.ImportRun, error)
+}
+
+func (s *fakeImporter) Import(ctx context.Context, filePath string, opts Options) (*ent.ImportRun, error) {
+	return s.importFn(ctx)
+}
+
+func TestRunJob(t *testing.T) {
+	run := &ent.ImportRun{ID: 9}
+	succeed := func(ctx context.Context) (*ent.ImportRun, error) { return run, nil }
+	// interrupt stands in for an import that is still running when the
+	// queue is stopped, and is rolled back.
+	interrupt := func(ctx context.Context) (*ent.ImportRun, error) {
+		<-ctx.Done()
+		return run, ctx.Err()
+	}
+
+	tests := []struct {
+		name         string
+		importFn     func(ctx context.Context) (*ent.ImportRun, error)
+		lost         bool
+		stop         bool
+		wantFinished importjob.Status
+		wantReleased bool
+		wantKept     bool
+	}{
+		{name: "succeeded", importFn: succeed, wantFinished: importjob.StatusSucceeded},
+		{name: "already imported", importFn: func(ctx context.Context) (*ent.ImportRun, error) {
+			return run, ErrAlreadyImported
+		}, wantFinished: importjob.StatusSkipped},
+		{name: "failed", importFn: func(ctx context.Context) (*ent.ImportRun, error) {
+			return run, errors.New("feed has no store id")
+		}, wantFinished: importjob.StatusFailed},
+		{name: "lease lost", importFn: succeed, lost: true, wantKept: true},
+		{name: "stopped", importFn: interrupt, stop: true, wantReleased: true, wantKept: true},
+		{name: "stopped after lease lost", importFn: interrupt, stop: true, lost: true, wantKept: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feed := filepath.Join(t.TempDir(), "feed.json")
+			if err := os.WriteFile(feed, []byte(`{}`), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			store := &fakeJobStore{lost: tt.lost}
+			q := NewQueue(store, &fakeImporter{importFn: tt.importFn}, nil, t.TempDir())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.stop {
+				cancel()
+			}
+			q.runJob(ctx, &ent.ImportJob{ID: 5, FileName: "feed.json", FilePath: feed})
+
+			switch {
+			case tt.wantFinished != "":
+				if store.finished == nil || store.finished.Status != tt.wantFinished || store.finished.RunID != run.ID {
+					t.Errorf("finished = %+v, want status %s with run %d", store.finished, tt.wantFinished, run.ID)
+				}
+			case store.finished != nil:
+				t.Errorf("finished = %+v, want none", store.finished)
+			}
+			if released := store.released != nil; released != tt.wantReleased {
+				t.Errorf("released = %+v, want released %v", store.released, tt.wantReleased)
+			}
+			if store.released != nil && store.released.RunID != run.ID {
+				t.Errorf("released run = %d, want %d", store.released.RunID, run.ID)
+			}
+			if _, err := os.Stat(feed); (err == nil) != tt.wantKept {
+				t.Errorf("feed kept = %v, want %v", err == nil, tt.wantKept)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package importerservice
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"offgrocery-assessment/internal/importer/importerfeed"
+)
+
+// RejectionReport writes the rows an import rejects as they are found, as a
+// JSON array or as CSV with a header row. Unlike the run, which records only
+// the first rejections, it holds every one without keeping them in memory.
+type RejectionReport struct {
+	w     io.Writer
+	csv   *csv.Writer
+	count int
+	// err is the first write error, reported by Close.
+	err error
+}
+
+// NewRejectionReport returns a report writing to w in format, "json" or
+// "csv".
+func NewRejectionReport(w io.Writer, format string) (*RejectionReport, error) {
+	r := &RejectionReport{w: w}
+	switch format {
+	case "json":
+		_, r.err = io.WriteString(w, "[")
+	case "csv":
+		r.csv = csv.NewWriter(w)
+		r.err = r.csv.Write([]string{"index", "path", "reason", "external_id"})
+	default:
+		return nil, fmt.Errorf("unknown rejection report format %q (known: json, csv)", format)
+	}
+	return r, nil
+}
+
+// Add writes a rejected row to the report. It fits Options.Rejected.
+func (r *RejectionReport) Add(row importerfeed.RowError) {
+	if r.err != nil {
+		return
+	}
+	r.count++
+
+	if r.csv != nil {
+		r.err = r.csv.Write([]string{strconv.Itoa(row.Index), row.Path, row.Reason, row.ExternalID})
+		return
+	}
+
+	data, err := json.Marshal(row)
+	if err != nil {
+		r.err = err
+		return
+	}
+	sep := ",\n  "
+	if r.count == 1 {
+		sep = "\n  "
+	}
+	if _, err := io.WriteString(r.w, sep); err != nil {
+		r.err = err
+		return
+	}
+	_, r.err = r.w.Write(data)
+}
+
+// Count returns the number of rows written to the report.
+func (r *RejectionReport) Count() int {
+	return r.count
+}
+
+// Close finishes the report and returns the first error writing it. It does
+// not close the underlying writer.
+func (r *RejectionReport) Close() error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.csv != nil {
+		r.csv.Flush()
+		return r.csv.Error()
+	}
+	end := "]\n"
+	if r.count > 0 {
+		end = "\n]\n"
+	}
+	_, err := io.WriteString(r.w, end)
+	return err
+}
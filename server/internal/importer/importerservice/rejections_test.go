@@ -0,0 +1,60 @@
+package importerservice
+
+import (
+	"strings"
+	"testing"
+
+	"offgrocery-assessment/internal/importer/importerfeed"
+)
+
+func TestRejectionReport(t *testing.T) {
+	rows := []importerfeed.RowError{
+		{Index: 3, Path: "products[3].manufacturer", Reason: "brand is empty", ExternalID: "sku-3"},
+		{Index: 7, Path: "products[7].retail_price", Reason: `price must be positive, got "0.00"`},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		rows   []importerfeed.RowError
+		want   string
+	}{
+		{"json", "json", rows, `[
+  {"index":3,"path":"products[3].manufacturer","reason":"brand is empty","external_id":"sku-3"},
+  {"index":7,"path":"products[7].retail_price","reason":"price must be positive, got \"0.00\""}
+]
+`},
+		{"empty json", "json", nil, "[]\n"},
+		{"csv", "csv", rows, `index,path,reason,external_id
+3,products[3].manufacturer,brand is empty,sku-3
+7,products[7].retail_price,"price must be positive, got ""0.00""",
+`},
+		{"empty csv", "csv", nil, "index,path,reason,external_id\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			report, err := NewRejectionReport(&b, tt.format)
+			if err != nil {
+				t.Fatalf("NewRejectionReport() error = %v", err)
+			}
+			for _, row := range tt.rows {
+				report.Add(row)
+			}
+			if err := report.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+			if b.String() != tt.want {
+				t.Errorf("report = %q, want %q", b.String(), tt.want)
+			}
+			if report.Count() != len(tt.rows) {
+				t.Errorf("Count() = %d, want %d", report.Count(), len(tt.rows))
+			}
+		})
+	}
+
+	if _, err := NewRejectionReport(&strings.Builder{}, "xml"); err == nil {
+		t.Error("NewRejectionReport() accepted an unknown format")
+	}
+}
@@ -0,0 +1,237 @@
+package importerservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/importer/importerfeed"
+	"offgrocery-assessment/internal/importer/importerstore"
+	"offgrocery-assessment/internal/money"
+)
+
+// DiffReport describes what importing a feed would change, without writing.
+type DiffReport struct {
+	Format   string        `json:"format"`
+	StoreID  string        `json:"store_id"`
+	NewStore bool          `json:"new_store"`
+	New      []DiffItem    `json:"new"`
+	Updated  []PriceChange `json:"updated"`
+	// Relisted lists the delisted items the feed brings back, whether or not
+	// it changes them otherwise.
+	Relisted  []PriceChange `json:"relisted"`
+	Unchanged []DiffItem    `json:"unchanged"`
+	Delisted  []DiffItem    `json:"delisted"`
+	// Rejected lists the rows that failed validation and would be skipped.
+	Rejected []importerfeed.RowError `json:"rejected"`
+}
+
+// DiffItem is a single product in a diff report.
+type DiffItem struct {
+	ExternalID string      `json:"external_id,omitempty"`
+	Name       string      `json:"name"`
+	Brand      string      `json:"brand"`
+	Price      money.Money `json:"price"`
+}
+
+// PriceChange is an existing item the feed changes. Any field the import
+// writes counts as a change, so OldPrice equals Price when only others do.
+type PriceChange struct {
+	DiffItem
+	OldPrice money.Money `json:"old_price"`
+	// PercentChange is zero when the currency changed.
+	PercentChange float64 `json:"percent_change"`
+}
+
+// differ builds a diff report a batch of feed rows at a time, comparing
+// products against the store's items the same way the import matches them,
+// so a dry run never holds the whole feed or the whole store.
+type differ struct {
+	report *DiffReport
+	// seen holds the ids of the store's items matched so far, which are
+	// not delisted.
+	seen map[int]bool
+}
+
+// newDiffer returns a differ for a feed of the given format and store.
+func newDiffer(format string, storeID string) *differ {
+	return &differ{
+		report: &DiffReport{
+			Format:    format,
+			StoreID:   storeID,
+			New:       []DiffItem{},
+			Updated:   []PriceChange{},
+			Relisted:  []PriceChange{},
+			Unchanged: []DiffItem{},
+			Delisted:  []DiffItem{},
+			Rejected:  []importerfeed.RowError{},
+		},
+		seen: make(map[int]bool),
+	}
+}
+
+// batchKeys returns the external ids and names a batch of valid products
+// and rejected rows' external ids could match the store's items on.
+func batchKeys(products []importerfeed.Product, rejectedIDs []string) ([]string, []importerstore.NameBrand) {
+	externalIDs := append([]string(nil), rejectedIDs...)
+	var names []importerstore.NameBrand
+	named := make(map[importerstore.NameBrand]bool)
+	for _, p := range products {
+		if p.ExternalID != "" {
+			externalIDs = append(externalIDs, p.ExternalID)
+		}
+		name := importerstore.NameBrand{Name: p.Name, Brand: p.Brand}
+		if !named[name] {
+			named[name] = true
+			names = append(names, name)
+		}
+	}
+	return externalIDs, names
+}
+
+// addBatch reports a batch of valid products as new, updated or unchanged,
+// and keeps the items of rejected rows from being delisted. items are the
+// store's items with the batch's keys, oldest first. An external id repeated
+// in the batch is reported once, with the last of its products, which is
+// the one the import keeps.
+func (d *differ) addBatch(products []importerfeed.Product, rejectedIDs []string, items []*ent.Item) {
+	byExternalID := make(map[string]*ent.Item)
+	byNameBrand := make(map[[2]string]*ent.Item)
+	legacy := make(map[[2]string]*ent.Item)
+	for _, it := range items {
+		key := [2]string{it.Name, it.Brand}
+		if it.ExternalID != nil {
+			byExternalID[*it.ExternalID] = it
+		} else if _, ok := legacy[key]; !ok {
+			legacy[key] = it
+		}
+		if _, ok := byNameBrand[key]; !ok {
+			byNameBrand[key] = it
+		}
+	}
+
+	last := make(map[string]int)
+	for i, p := range products {
+		if p.ExternalID != "" {
+			last[p.ExternalID] = i
+		}
+	}
+	for i, p := range products {
+		if p.ExternalID != "" && last[p.ExternalID] != i {
+			continue
+		}
+		key := [2]string{p.Name, p.Brand}
+		var match *ent.Item
+		switch {
+		case p.ExternalID == "":
+			match = byNameBrand[key]
+		case byExternalID[p.ExternalID] != nil:
+			match = byExternalID[p.ExternalID]
+		case legacy[key] != nil && !d.seen[legacy[key].ID]:
+			match = legacy[key]
+		}
+		d.add(p, match)
+	}
+
+	for _, id := range rejectedIDs {
+		if it := byExternalID[id]; it != nil {
+			d.seen[it.ID] = true
+		}
+	}
+}
+
+// add reports a valid product as new, or as a change to the item it matched
+// by the same test the import counts updates with.
+func (d *differ) add(p importerfeed.Product, match *ent.Item) {
+	item := DiffItem{ExternalID: p.ExternalID, Name: p.Name, Brand: p.Brand, Price: p.Price}
+	if match == nil {
+		d.report.New = append(d.report.New, item)
+		return
+	}
+	d.seen[match.ID] = true
+
+	if !importerstore.ItemChanged(match, itemParams(p)) {
+		d.report.Unchanged = append(d.report.Unchanged, item)
+		return
+	}
+	change := PriceChange{DiffItem: item, OldPrice: money.New(match.PriceAmount, match.Currency)}
+	if change.OldPrice.Currency == p.Price.Currency {
+		change.PercentChange = math.Round(float64(p.Price.Amount-match.PriceAmount)/float64(match.PriceAmount)*10000) / 100
+	}
+	if !match.Available {
+		d.report.Relisted = append(d.report.Relisted, change)
+		return
+	}
+	d.report.Updated = append(d.report.Updated, change)
+}
+
+// reject reports a row that failed validation.
+func (d *differ) reject(r importerfeed.RowError) {
+	d.report.Rejected = append(d.report.Rejected, r)
+}
+
+// delist reports the given available items as delisted unless the feed
+// matched them.
+func (d *differ) delist(items []*ent.Item) {
+	for _, it := range items {
+		if d.seen[it.ID] {
+			continue
+		}
+		item := DiffItem{Name: it.Name, Brand: it.Brand, Price: money.New(it.PriceAmount, it.Currency)}
+		if it.ExternalID != nil {
+			item.ExternalID = *it.ExternalID
+		}
+		d.report.Delisted = append(d.report.Delisted, item)
+	}
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *DiffReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteTable writes the report as a human readable table. Unchanged items are
+// only counted.
+func (r *DiffReport) WriteTable(w io.Writer) error {
+	storeNote := ""
+	if r.NewStore {
+		storeNote = ", new store"
+	}
+	fmt.Fprintf(w, "store %s (%s%s): %d new, %d updated, %d relisted, %d unchanged, %d delisted, %d rejected\n\n",
+		r.StoreID, r.Format, storeNote, len(r.New), len(r.Updated), len(r.Relisted), len(r.Unchanged), len(r.Delisted), len(r.Rejected))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tEXTERNAL ID\tNAME\tBRAND\tOLD PRICE\tNEW PRICE\tCHANGE")
+	for _, it := range r.New {
+		fmt.Fprintf(tw, "new\t%s\t%s\t%s\t\t%s\t\n", it.ExternalID, it.Name, it.Brand, it.Price)
+	}
+	for _, it := range r.Updated {
+		fmt.Fprintf(tw, "updated\t%s\t%s\t%s\t%s\t%s\t%+.2f%%\n", it.ExternalID, it.Name, it.Brand, it.OldPrice, it.Price, it.PercentChange)
+	}
+	for _, it := range r.Relisted {
+		fmt.Fprintf(tw, "relisted\t%s\t%s\t%s\t%s\t%s\t%+.2f%%\n", it.ExternalID, it.Name, it.Brand, it.OldPrice, it.Price, it.PercentChange)
+	}
+	for _, it := range r.Delisted {
+		fmt.Fprintf(tw, "delisted\t%s\t%s\t%s\t%s\t\t\n", it.ExternalID, it.Name, it.Brand, it.Price)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(r.Rejected) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "REJECTED ROW\tPATH\tREASON")
+	for _, rej := range r.Rejected {
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", rej.Index, rej.Path, rej.Reason)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,165 @@
+package importerservice
+
+import (
+	"reflect"
+	"testing"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/importer/importerfeed"
+	"offgrocery-assessment/internal/importer/importerstore"
+	"offgrocery-assessment/internal/money"
+)
+
+func storedItem(id int, externalID, name string, price int64, available bool) *ent.Item {
+	it := &ent.Item{ID: id, Name: name, Brand: "Natrel", PriceAmount: price, Currency: "CAD", Available: available}
+	if externalID != "" {
+		it.ExternalID = &externalID
+	}
+	return it
+}
+
+func feedProduct(externalID, name string, price int64) importerfeed.Product {
+	return importerfeed.Product{ExternalID: externalID, Name: name, Brand: "Natrel", Price: money.New(price, "CAD")}
+}
+
+// counts summarizes a diff report as the ids of its items by status, with
+// names standing in for missing external ids.
+func counts(r *DiffReport) map[string][]string {
+	got := map[string][]string{}
+	add := func(status string, it DiffItem) {
+		key := it.ExternalID
+		if key == "" {
+			key = it.Name
+		}
+		got[status] = append(got[status], key)
+	}
+	for _, it := range r.New {
+		add("new", it)
+	}
+	for _, it := range r.Updated {
+		add("updated", it.DiffItem)
+	}
+	for _, it := range r.Relisted {
+		add("relisted", it.DiffItem)
+	}
+	for _, it := range r.Unchanged {
+		add("unchanged", it)
+	}
+	for _, it := range r.Delisted {
+		add("delisted", it)
+	}
+	return got
+}
+
+func TestDifferAddBatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []*ent.Item
+		products    []importerfeed.Product
+		rejectedIDs []string
+		want        map[string][]string
+	}{
+		{
+			name:     "new item",
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 529)},
+			want:     map[string][]string{"new": {"sku-1"}},
+		},
+		{
+			name:     "unchanged item",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk", 529, true)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 529)},
+			want:     map[string][]string{"unchanged": {"sku-1"}},
+		},
+		{
+			name:     "price change",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk", 529, true)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 549)},
+			want:     map[string][]string{"updated": {"sku-1"}},
+		},
+		{
+			name:     "rename at the same price",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk", 529, true)},
+			products: []importerfeed.Product{feedProduct("sku-1", "Milk 2%", 529)},
+			want:     map[string][]string{"updated": {"sku-1"}},
+		},
+		{
+			name:     "size read from the name",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk 2L", 529, true)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk 2L", 529)},
+			want:     map[string][]string{"updated": {"sku-1"}},
+		},
+		{
+			name:     "relisted unchanged",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk", 529, false)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 529)},
+			want:     map[string][]string{"relisted": {"sku-1"}},
+		},
+		{
+			name:     "relisted at a new price",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk", 529, false)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 549)},
+			want:     map[string][]string{"relisted": {"sku-1"}},
+		},
+		{
+			name:     "legacy item adopted once",
+			items:    []*ent.Item{storedItem(1, "", "2% Milk", 529, true)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 529), feedProduct("sku-2", "2% Milk", 529)},
+			want:     map[string][]string{"unchanged": {"sku-1"}, "new": {"sku-2"}},
+		},
+		{
+			name:     "unkeyed item matched by name",
+			items:    []*ent.Item{storedItem(1, "", "2% Milk", 529, true)},
+			products: []importerfeed.Product{feedProduct("", "2% Milk", 549)},
+			want:     map[string][]string{"updated": {"2% Milk"}},
+		},
+		{
+			name:     "repeated external id keeps the last row",
+			items:    []*ent.Item{storedItem(1, "sku-1", "2% Milk", 529, true)},
+			products: []importerfeed.Product{feedProduct("sku-1", "2% Milk", 549), feedProduct("sku-1", "2% Milk", 529)},
+			want:     map[string][]string{"unchanged": {"sku-1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDiffer("store_a", "a-1")
+			d.addBatch(tt.products, tt.rejectedIDs, tt.items)
+			if got := counts(d.report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("report = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifferDelist(t *testing.T) {
+	milk := storedItem(1, "sku-1", "2% Milk", 529, true)
+	cream := storedItem(2, "sku-2", "Cream", 399, true)
+	butter := storedItem(3, "", "Butter", 599, true)
+
+	d := newDiffer("store_a", "a-1")
+	// sku-2's row was rejected, so its item is still stocked.
+	d.addBatch([]importerfeed.Product{feedProduct("sku-1", "2% Milk", 529)}, []string{"sku-2"}, []*ent.Item{milk, cream})
+	d.delist([]*ent.Item{milk, cream, butter})
+
+	want := map[string][]string{"unchanged": {"sku-1"}, "delisted": {"Butter"}}
+	if got := counts(d.report); !reflect.DeepEqual(got, want) {
+		t.Errorf("report = %v, want %v", got, want)
+	}
+}
+
+func TestBatchKeys(t *testing.T) {
+	products := []importerfeed.Product{
+		feedProduct("sku-1", "2% Milk", 529),
+		feedProduct("", "2% Milk", 529),
+		feedProduct("", "Cream", 399),
+	}
+	externalIDs, names := batchKeys(products, []string{"bad"})
+
+	if want := []string{"bad", "sku-1"}; !reflect.DeepEqual(externalIDs, want) {
+		t.Errorf("external ids = %v, want %v", externalIDs, want)
+	}
+	want := []importerstore.NameBrand{{Name: "2% Milk", Brand: "Natrel"}, {Name: "Cream", Brand: "Natrel"}}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+}
@@ -2,72 +2,539 @@ package importerservice
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"slices"
+	"time"
 
+	"offgrocery-assessment/internal/category/taxonomy"
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/schema"
 	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/importer/importerfeed"
 	"offgrocery-assessment/internal/importer/importerstore"
+	"offgrocery-assessment/internal/item/dietary"
+	"offgrocery-assessment/internal/item/measure"
 )
 
-// StoreAProduct maps to a single product in Store A's JSON data feed.
-type StoreAProduct struct {
-	ProductName  string  `json:"product_name"`
-	Manufacturer string  `json:"manufacturer"`
-	RetailPrice  float64 `json:"retail_price"`
-	SKU          string  `json:"sku"`
-	Category     string  `json:"category"`
-	WeightGrams  int     `json:"weight_grams"`
+// ErrAlreadyImported is returned by Import when a file with the same contents
+// was already imported successfully and force was not set.
+var ErrAlreadyImported = errors.New("file already imported")
+
+// Options configures a single import.
+type Options struct {
+	// FileName is recorded on the run. It defaults to the base name of the
+	// imported file's path.
+	FileName string
+	// Format names the feed adapter to use. Empty detects it from the file.
+	Format string
+	// Encoding is the feed file's encoding. Empty guesses it from the file
+	// name extension.
+	Encoding importerfeed.Encoding
+	// Force imports the file even if identical contents were already imported.
+	Force bool
+	// MaxErrors is the number of rows that may fail validation before the
+	// whole import is abandoned. Negative means no limit.
+	MaxErrors int
+	// Progress, if set, is called as each batch of rows is written, with the
+	// number of feed rows processed so far and the total in the feed.
+	Progress func(processed, total int)
+	// Rejected, if set, is called with every row that fails validation,
+	// including those past the ones recorded on the run.
+	Rejected func(row importerfeed.RowError)
+}
+
+func (o Options) progress(processed, total int) {
+	if o.Progress != nil {
+		o.Progress(processed, total)
+	}
 }
 
-// StoreAData is the top-level JSON structure for Store A's data feed.
-type StoreAData struct {
-	StoreLocationID string          `json:"store_location_id"`
-	Products        []StoreAProduct `json:"products"`
+// batchSize is the number of valid rows held in memory and written to the
+// database at a time.
+const batchSize = 500
+
+// recordTimeout bounds recording the outcome of a run or job whose context
+// was cancelled, such as when the server shuts down mid-import.
+const recordTimeout = 10 * time.Second
+
+// maxRecordedRejections caps the rejected rows recorded on a run, so a feed
+// full of bad rows does not fill memory or the run record. Every rejected
+// row is still counted.
+const maxRecordedRejections = 100
+
+// Matcher groups a store's items into products once an import has written
+// them.
+type Matcher interface {
+	MatchStore(ctx context.Context, storeID int) error
 }
 
 type Service interface {
-	Import(ctx context.Context, filePath string) error
+	Import(ctx context.Context, filePath string, opts Options) (*ent.ImportRun, error)
+	DryRun(ctx context.Context, filePath string, opts Options) (*DiffReport, error)
+	ListRuns(ctx context.Context, limit int) ([]*ent.ImportRun, error)
+	GetRun(ctx context.Context, id int) (*ent.ImportRun, error)
+	Watch(ctx context.Context, opts WatchOptions) error
 }
 
 type service struct {
-	store importerstore.Store
+	store    importerstore.Store
+	registry *importerfeed.Registry
+	matcher  Matcher
 }
 
-func New(store importerstore.Store) *service {
-	return &service{store: store}
+func New(store importerstore.Store, registry *importerfeed.Registry, matcher Matcher) *service {
+	return &service{store: store, registry: registry, matcher: matcher}
 }
 
-// Import reads a Store A JSON file and imports the products into the database.
-func (s *service) Import(ctx context.Context, filePath string) error {
-	slog.Info("importer: starting import", "file", filePath)
+// Import reads a grocer feed file and imports its products into the database,
+// recording the run and its outcome. The feed is streamed rather than read
+// into memory, and applied in a single transaction in batches, so a failure
+// leaves the store as it was before the import. The
+// feed layout is detected from its top-level keys unless format names a
+// registered adapter explicitly. Rows that fail validation are skipped and
+// recorded on the run, up to opts.MaxErrors. A file whose contents were
+// already imported successfully is skipped with ErrAlreadyImported unless
+// opts.Force is set. After a successful import the store's items are matched
+// to products; a matching failure is logged but does not fail the import. An
+// import cancelled through ctx is rolled back and its run recorded as
+// interrupted.
+func (s *service) Import(ctx context.Context, filePath string, opts Options) (*ent.ImportRun, error) {
+	slog.Info("importer: starting import", "file", filePath, "format", opts.Format)
+
+	hash, err := hashFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.Force {
+		previous, err := s.store.FindSucceededRun(ctx, hash)
+		if err == nil {
+			slog.Info("importer: file already imported, skipping", "file", filePath, "run", previous.ID)
+			return previous, ErrAlreadyImported
+		}
+		if !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("checking previous runs: %w", err)
+		}
+	}
+
+	fileName := opts.FileName
+	if fileName == "" {
+		fileName = filepath.Base(filePath)
+	}
 
-	data, err := os.ReadFile(filePath)
+	runRecord, err := s.store.CreateRun(ctx, importerstore.RunParams{
+		FileName: fileName,
+		SHA256:   hash,
+		Format:   opts.Format,
+	})
 	if err != nil {
-		return fmt.Errorf("reading file: %w", err)
+		return nil, fmt.Errorf("creating import run: %w", err)
 	}
 
-	var storeData StoreAData
-	if err := json.Unmarshal(data, &storeData); err != nil {
-		return fmt.Errorf("parsing json: %w", err)
+	outcome, err := s.apply(ctx, runRecord, filePath, opts)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		outcome.Status = importrun.StatusInterrupted
+		outcome.Error = err.Error()
+		slog.Warn("importer: import interrupted", "run", runRecord.ID, "error", err)
+	case err != nil:
+		outcome.Status = importrun.StatusFailed
+		outcome.Error = err.Error()
+		slog.Error("importer: import failed", "run", runRecord.ID, "error", err)
+	default:
+		outcome.Status = importrun.StatusSucceeded
+		if merr := s.matcher.MatchStore(ctx, outcome.StoreID); merr != nil {
+			slog.Error("importer: failed to match items to products", "run", runRecord.ID, "error", merr)
+		}
+	}
+
+	// The outcome is recorded even when ctx was cancelled, so an interrupted
+	// run does not stay running.
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancel()
+	finished, ferr := s.store.FinishRun(recordCtx, runRecord.ID, outcome)
+	if ferr != nil {
+		slog.Error("importer: failed to record import run outcome", "run", runRecord.ID, "error", ferr)
+		if err == nil {
+			err = fmt.Errorf("recording import run: %w", ferr)
+		}
 	}
 
-	slog.Info("importer: parsed data", "store", storeData.StoreLocationID, "products", len(storeData.Products))
+	return finished, err
+}
+
+// apply streams the feed, validating its rows and writing the valid ones in
+// batches of batchSize within a single transaction, and returns the counts to
+// record on the run. Only one batch of rows is held in memory at a time.
+func (s *service) apply(ctx context.Context, runRecord *ent.ImportRun, filePath string, opts Options) (importerstore.RunOutcome, error) {
+	outcome := importerstore.RunOutcome{Rejections: []schema.Rejection{}}
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return outcome, fmt.Errorf("reading file: %w", err)
+	}
+	defer f.Close()
 
-	storeRecord, err := s.store.FindOrCreateStore(ctx, storeData.StoreLocationID, store.GrocerStoreA)
+	adapter, feed, err := s.openFeed(f, filePath, opts)
 	if err != nil {
-		return fmt.Errorf("finding or creating store: %w", err)
+		return outcome, err
+	}
+	outcome.Format = adapter.Name()
+
+	if feed.StoreID == "" {
+		return outcome, fmt.Errorf("%s feed has no store id", adapter.Name())
 	}
 
-	for _, p := range storeData.Products {
-		err := s.store.UpsertItem(ctx, p.ProductName, p.Manufacturer, p.RetailPrice, storeRecord.ID)
+	// Item timestamps are stored with second precision, so the run start is
+	// truncated to keep items it sees from comparing as older than it.
+	run := importerstore.Run{ID: runRecord.ID, StartedAt: runRecord.StartedAt.UTC().Truncate(time.Second)}
+
+	validator := importerfeed.NewValidator(feed)
+
+	var (
+		storeID       int
+		result        importerstore.UpsertResult
+		delisted      int
+		rows          int
+		rejectedIDs   []string
+		tooManyErrors bool
+	)
+	reject := func(r importerfeed.RowError) error {
+		rows++
+		outcome.Failed++
+		if r.ExternalID != "" {
+			rejectedIDs = append(rejectedIDs, r.ExternalID)
+		}
+		if len(outcome.Rejections) < maxRecordedRejections {
+			outcome.Rejections = append(outcome.Rejections, schema.Rejection{Index: r.Index, Path: r.Path, Reason: r.Reason})
+		}
+		if opts.Rejected != nil {
+			opts.Rejected(r)
+		}
+		slog.Warn("importer: rejected row", "index", r.Index, "path", r.Path, "reason", r.Reason)
+		if opts.MaxErrors >= 0 && outcome.Failed > opts.MaxErrors {
+			tooManyErrors = true
+			return fmt.Errorf("more than the maximum of %d rows failed validation", opts.MaxErrors)
+		}
+		return nil
+	}
+
+	err = s.store.WithTx(ctx, func(tx importerstore.Store) error {
+		storeRecord, err := tx.FindOrCreateStore(ctx, feed.StoreID, feed.Grocer)
+		if err != nil {
+			return fmt.Errorf("finding or creating store: %w", err)
+		}
+		storeID = storeRecord.ID
+
+		// Rejected rows' items are still stocked, so they are marked seen
+		// to keep them from being delisted. Their ids are only held until
+		// the next batch is written.
+		touch := func() error {
+			if err := tx.TouchItems(ctx, storeRecord.ID, run, rejectedIDs); err != nil {
+				return fmt.Errorf("marking rejected items as seen: %w", err)
+			}
+			rejectedIDs = rejectedIDs[:0]
+			return nil
+		}
+
+		batch := make([]importerstore.ItemParams, 0, batchSize)
+		flush := func() error {
+			if err := touch(); err != nil {
+				return err
+			}
+			if len(batch) == 0 {
+				return nil
+			}
+			written, err := s.writeBatch(ctx, tx, storeRecord.ID, feed.Grocer, run, batch)
+			if err != nil {
+				return err
+			}
+			result.Created += written.Created
+			result.Updated += written.Updated
+			batch = batch[:0]
+
+			opts.progress(rows, feed.Total)
+			slog.Info("importer: wrote batch", "store", feed.StoreID, "processed", rows, "total", feed.Total)
+			return nil
+		}
+
+		opts.progress(0, feed.Total)
+		err = feed.Each(f, func(p importerfeed.Product) error {
+			if r := validator.Check(p); r != nil {
+				if err := reject(*r); err != nil {
+					return err
+				}
+			} else {
+				rows++
+				batch = append(batch, itemParams(p))
+			}
+			if len(batch)+len(rejectedIDs) >= batchSize {
+				return flush()
+			}
+			return nil
+		}, func(r importerfeed.RowError) error {
+			if err := reject(r); err != nil {
+				return err
+			}
+			if len(rejectedIDs) >= batchSize {
+				return touch()
+			}
+			return nil
+		})
 		if err != nil {
-			return fmt.Errorf("upserting item %q: %w", p.ProductName, err)
+			return err
+		}
+		if err := flush(); err != nil {
+			return err
 		}
+
+		if delisted, err = tx.DelistMissingItems(ctx, storeRecord.ID, run); err != nil {
+			return fmt.Errorf("delisting missing items: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.Error("importer: import rolled back", "store", feed.StoreID, "error", err)
+		if !tooManyErrors {
+			outcome.Failed = feed.Total
+		}
+		return outcome, err
 	}
 
-	slog.Info("importer: import complete", "store", storeData.StoreLocationID, "total_products", len(storeData.Products))
+	outcome.StoreID = storeID
+	outcome.Created = result.Created
+	outcome.Updated = result.Updated
+	outcome.Delisted = delisted
+
+	slog.Info("importer: import complete",
+		"store", feed.StoreID,
+		"run", run.ID,
+		"created", result.Created,
+		"updated", result.Updated,
+		"delisted", delisted,
+		"rejected", outcome.Failed,
+	)
+
+	return outcome, nil
+}
+
+// writeBatch resolves the categories of a batch of items and upserts them.
+func (s *service) writeBatch(ctx context.Context, tx importerstore.Store, storeID int, grocer store.Grocer, run importerstore.Run, items []importerstore.ItemParams) (importerstore.UpsertResult, error) {
+	rawCategories := make([]string, len(items))
+	for i, it := range items {
+		rawCategories[i] = it.Aisle
+	}
+	categoryIDs, err := tx.ResolveCategories(ctx, grocer, rawCategories)
+	if err != nil {
+		return importerstore.UpsertResult{}, fmt.Errorf("resolving categories: %w", err)
+	}
+	for i := range items {
+		items[i].CategoryID = categoryIDs[taxonomy.Normalize(items[i].Aisle)]
+	}
+
+	result, err := tx.UpsertItems(ctx, storeID, run, items)
+	if err != nil {
+		return importerstore.UpsertResult{}, fmt.Errorf("upserting items: %w", err)
+	}
+	return result, nil
+}
+
+// itemParams converts a valid feed product into the item to write.
+func itemParams(p importerfeed.Product) importerstore.ItemParams {
+	// Feeds without a size field often still carry one in the product
+	// name, e.g. "Large Eggs (12 pack)".
+	if p.Size.IsZero() {
+		p.Size, _ = measure.Parse(p.Name)
+	}
+	// Names also carry dietary claims, e.g. "Organic Bananas".
+	attributes := append([]string(nil), p.Attributes...)
+	for _, slug := range dietary.Detect(p.Name) {
+		if !slices.Contains(attributes, slug) {
+			attributes = append(attributes, slug)
+		}
+	}
+	return importerstore.ItemParams{
+		Name:       p.Name,
+		Brand:      p.Brand,
+		Price:      p.Price,
+		ExternalID: p.ExternalID,
+		Aisle:      p.Category,
+		Unit:       p.Unit,
+		Attributes: attributes,
+		Size:       p.Size,
+	}
+}
+
+// DryRun streams a feed and reports the items an import would create, update,
+// leave unchanged and delist, without writing anything. Products are compared
+// in batches of batchSize against the store's items they could match, and the
+// store's available items are then paged through for the ones to delist, so
+// neither the feed nor the store is held in memory. Only the format and
+// encoding options apply.
+func (s *service) DryRun(ctx context.Context, filePath string, opts Options) (*DiffReport, error) {
+	slog.Info("importer: starting dry run", "file", filePath, "format", opts.Format)
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+	defer f.Close()
+
+	adapter, feed, err := s.openFeed(f, filePath, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	storeRecord, err := s.store.FindStore(ctx, feed.StoreID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("finding store: %w", err)
+	}
+
+	validator := importerfeed.NewValidator(feed)
+	diff := newDiffer(adapter.Name(), feed.StoreID)
+
+	var (
+		batch       []importerfeed.Product
+		rejectedIDs []string
+	)
+	flush := func() error {
+		var items []*ent.Item
+		if storeRecord != nil {
+			externalIDs, names := batchKeys(batch, rejectedIDs)
+			found, err := s.store.ListMatchingItems(ctx, storeRecord.ID, externalIDs, names)
+			if err != nil {
+				return fmt.Errorf("listing matching items: %w", err)
+			}
+			items = found
+		}
+		diff.addBatch(batch, rejectedIDs, items)
+		batch, rejectedIDs = batch[:0], rejectedIDs[:0]
+		return nil
+	}
+	reject := func(r importerfeed.RowError) error {
+		diff.reject(r)
+		if r.ExternalID != "" {
+			rejectedIDs = append(rejectedIDs, r.ExternalID)
+		}
+		if len(batch)+len(rejectedIDs) >= batchSize {
+			return flush()
+		}
+		return nil
+	}
+	err = feed.Each(f, func(p importerfeed.Product) error {
+		if r := validator.Check(p); r != nil {
+			return reject(*r)
+		}
+		batch = append(batch, p)
+		if len(batch)+len(rejectedIDs) >= batchSize {
+			return flush()
+		}
+		return nil
+	}, reject)
+	if err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	if storeRecord != nil {
+		afterID := 0
+		for {
+			items, err := s.store.ListAvailableItems(ctx, storeRecord.ID, afterID, batchSize)
+			if err != nil {
+				return nil, fmt.Errorf("listing available items: %w", err)
+			}
+			diff.delist(items)
+			if len(items) < batchSize {
+				break
+			}
+			afterID = items[len(items)-1].ID
+		}
+	}
+
+	diff.report.NewStore = storeRecord == nil
+	return diff.report, nil
+}
+
+// hashFile returns the hex SHA-256 of a file's contents, read as a stream.
+func hashFile(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("reading file: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("reading file: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// openFeed reads the outline of the feed in f, read from filePath, and opens
+// it with the adapter selected by opts.Format, leaving f rewound for its
+// products to be streamed.
+func (s *service) openFeed(f io.ReadSeeker, filePath string, opts Options) (importerfeed.FeedAdapter, *importerfeed.Feed, error) {
+	encoding := opts.Encoding
+	if encoding == "" {
+		encoding = importerfeed.EncodingOf(filePath)
+	}
+
+	outline, err := importerfeed.ReadOutline(f, encoding)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing %s feed: %w", encoding, err)
+	}
+
+	adapter, err := s.adapterFor(outline, opts.Format)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	feed, err := importerfeed.Open(adapter, outline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing %s feed: %w", adapter.Name(), err)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, fmt.Errorf("rewinding file: %w", err)
+	}
+
+	slog.Info("importer: opened feed", "format", adapter.Name(), "encoding", encoding, "store", feed.StoreID, "products", feed.Total)
+
+	return adapter, feed, nil
+}
+
+// adapterFor returns the adapter named by format, or sniffs the feed's
+// outline when format is empty.
+func (s *service) adapterFor(outline *importerfeed.Outline, format string) (importerfeed.FeedAdapter, error) {
+	if format != "" {
+		return s.registry.Get(format)
+	}
+
+	adapter, err := s.registry.Detect(outline)
+	if err != nil {
+		return nil, fmt.Errorf("detecting feed format: %w", err)
+	}
+
+	slog.Info("importer: detected feed format", "format", adapter.Name())
+
+	return adapter, nil
+}
+
+func (s *service) ListRuns(ctx context.Context, limit int) ([]*ent.ImportRun, error) {
+	return s.store.ListRuns(ctx, limit)
+}
 
-	return nil
+func (s *service) GetRun(ctx context.Context, id int) (*ent.ImportRun, error) {
+	return s.store.GetRun(ctx, id)
 }
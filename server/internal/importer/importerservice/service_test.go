@@ -0,0 +1,270 @@
+package importerservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/importrun"
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/importer/importerfeed"
+	"offgrocery-assessment/internal/importer/importerstore"
+)
+
+// fakeItemStore keeps a store's items in memory and records what an import
+// or dry run asks of it.
+type fakeItemStore struct {
+	importerstore.Store
+	items []*ent.Item
+	// lookups records the keys of each ListMatchingItems call.
+	lookups  [][]string
+	upserted []importerstore.ItemParams
+	touched  []string
+	// imported maps the hashes of files already imported successfully to
+	// their runs.
+	imported map[string]*ent.ImportRun
+	runs     []importerstore.RunParams
+	outcome  *importerstore.RunOutcome
+}
+
+func (s *fakeItemStore) WithTx(ctx context.Context, fn func(tx importerstore.Store) error) error {
+	return fn(s)
+}
+
+func (s *fakeItemStore) FindStore(ctx context.Context, storeID string) (*ent.Store, error) {
+	return &ent.Store{ID: 1, StoreID: storeID}, nil
+}
+
+func (s *fakeItemStore) FindOrCreateStore(ctx context.Context, storeID string, grocer store.Grocer) (*ent.Store, error) {
+	return &ent.Store{ID: 1, StoreID: storeID}, nil
+}
+
+func (s *fakeItemStore) FindSucceededRun(ctx context.Context, sha256 string) (*ent.ImportRun, error) {
+	if run, ok := s.imported[sha256]; ok {
+		return run, nil
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (s *fakeItemStore) CreateRun(ctx context.Context, params importerstore.RunParams) (*ent.ImportRun, error) {
+	s.runs = append(s.runs, params)
+	return &ent.ImportRun{ID: 9}, nil
+}
+
+func (s *fakeItemStore) FinishRun(ctx context.Context, id int, outcome importerstore.RunOutcome) (*ent.ImportRun, error) {
+	s.outcome = &outcome
+	return &ent.ImportRun{ID: id, Status: outcome.Status}, nil
+}
+
+func (s *fakeItemStore) ResolveCategories(ctx context.Context, grocer store.Grocer, rawValues []string) (map[string]int, error) {
+	return map[string]int{}, nil
+}
+
+func (s *fakeItemStore) UpsertItems(ctx context.Context, storeID int, run importerstore.Run, items []importerstore.ItemParams) (importerstore.UpsertResult, error) {
+	s.upserted = append(s.upserted, items...)
+	return importerstore.UpsertResult{Created: len(items)}, nil
+}
+
+func (s *fakeItemStore) TouchItems(ctx context.Context, storeID int, run importerstore.Run, externalIDs []string) error {
+	s.touched = append(s.touched, externalIDs...)
+	return nil
+}
+
+func (s *fakeItemStore) DelistMissingItems(ctx context.Context, storeID int, run importerstore.Run) (int, error) {
+	return 0, nil
+}
+
+func (s *fakeItemStore) ListMatchingItems(ctx context.Context, storeID int, externalIDs []string, names []importerstore.NameBrand) ([]*ent.Item, error) {
+	s.lookups = append(s.lookups, externalIDs)
+	var found []*ent.Item
+	for _, it := range s.items {
+		byID := it.ExternalID != nil && slices.Contains(externalIDs, *it.ExternalID)
+		byName := slices.Contains(names, importerstore.NameBrand{Name: it.Name, Brand: it.Brand})
+		if byID || byName {
+			found = append(found, it)
+		}
+	}
+	return found, nil
+}
+
+func (s *fakeItemStore) ListAvailableItems(ctx context.Context, storeID int, afterID int, limit int) ([]*ent.Item, error) {
+	var found []*ent.Item
+	for _, it := range s.items {
+		if it.Available && it.ID > afterID && len(found) < limit {
+			found = append(found, it)
+		}
+	}
+	return found, nil
+}
+
+type noMatcher struct{}
+
+func (noMatcher) MatchStore(ctx context.Context, storeID int) error { return nil }
+
+// writeFeed writes a Store A feed of the given products and returns its path.
+func writeFeed(t *testing.T, products []map[string]any) string {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{"store_location_id": "a-1", "products": products})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "feed.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func product(sku, name, brand, price string) map[string]any {
+	return map[string]any{"sku": sku, "product_name": name, "manufacturer": brand, "retail_price": json.Number(price)}
+}
+
+func TestImportRejections(t *testing.T) {
+	tests := []struct {
+		name         string
+		valid        int
+		rejected     int
+		maxErrors    int
+		wantStatus   importrun.Status
+		wantRecorded int
+	}{
+		{"few rejections", 20, 3, -1, importrun.StatusSucceeded, 3},
+		{"rejections beyond the record", 20, maxRecordedRejections + 50, -1, importrun.StatusSucceeded, maxRecordedRejections},
+		{"more than the maximum", 20, 11, 10, importrun.StatusFailed, 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var products []map[string]any
+			for i := range tt.valid {
+				products = append(products, product(fmt.Sprintf("ok-%d", i), "Milk", "Natrel", "4.99"))
+			}
+			for i := range tt.rejected {
+				products = append(products, product(fmt.Sprintf("bad-%d", i), "Milk", "", "4.99"))
+			}
+
+			st := &fakeItemStore{}
+			s := New(st, importerfeed.DefaultRegistry(), noMatcher{})
+			reported := 0
+			_, err := s.Import(context.Background(), writeFeed(t, products), Options{
+				MaxErrors: tt.maxErrors,
+				Rejected:  func(importerfeed.RowError) { reported++ },
+			})
+			if (err != nil) != (tt.wantStatus == importrun.StatusFailed) {
+				t.Fatalf("Import() error = %v, want status %s", err, tt.wantStatus)
+			}
+
+			outcome := st.outcome
+			if outcome.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", outcome.Status, tt.wantStatus)
+			}
+			if outcome.Failed != tt.rejected {
+				t.Errorf("failed = %d, want %d", outcome.Failed, tt.rejected)
+			}
+			if reported != tt.rejected {
+				t.Errorf("reported %d rejections, want every one of %d", reported, tt.rejected)
+			}
+			if len(outcome.Rejections) != tt.wantRecorded {
+				t.Errorf("recorded %d rejections, want %d", len(outcome.Rejections), tt.wantRecorded)
+			}
+			if tt.wantStatus == importrun.StatusSucceeded && len(st.touched) != tt.rejected {
+				t.Errorf("touched %d rejected items, want %d", len(st.touched), tt.rejected)
+			}
+		})
+	}
+}
+
+func TestImportSkipsImportedFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		imported bool
+		force    bool
+		wantSkip bool
+	}{
+		{"new file", false, false, false},
+		{"already imported", true, false, true},
+		{"already imported and forced", true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeFeed(t, []map[string]any{product("ok-1", "Milk", "Natrel", "4.99")})
+			hash, err := hashFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			st := &fakeItemStore{imported: map[string]*ent.ImportRun{}}
+			if tt.imported {
+				st.imported[hash] = &ent.ImportRun{ID: 3, Status: importrun.StatusSucceeded}
+			}
+
+			s := New(st, importerfeed.DefaultRegistry(), noMatcher{})
+			got, err := s.Import(context.Background(), path, Options{Force: tt.force, MaxErrors: -1})
+			if tt.wantSkip {
+				if !errors.Is(err, ErrAlreadyImported) || got.ID != 3 {
+					t.Errorf("Import() = %v, %v, want run 3 and ErrAlreadyImported", got, err)
+				}
+				if len(st.runs) != 0 || len(st.upserted) != 0 {
+					t.Errorf("recorded %d runs and wrote %d items for a skipped file", len(st.runs), len(st.upserted))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Import() error = %v", err)
+			}
+			if len(st.runs) != 1 || st.runs[0].SHA256 != hash || st.runs[0].FileName != "feed.json" {
+				t.Errorf("runs = %+v, want one for feed.json with the file's hash", st.runs)
+			}
+			if len(st.upserted) != 1 {
+				t.Errorf("wrote %d items, want 1", len(st.upserted))
+			}
+		})
+	}
+}
+
+func TestDryRunBatches(t *testing.T) {
+	ext := func(id string) *string { return &id }
+	st := &fakeItemStore{items: []*ent.Item{
+		{ID: 1, Name: "Milk", Brand: "Natrel", PriceAmount: 499, Currency: "CAD", ExternalID: ext("sku-0"), Available: true},
+		{ID: 2, Name: "Butter", Brand: "Lactantia", PriceAmount: 599, Currency: "CAD", ExternalID: ext("sku-1"), Available: true},
+		{ID: 3, Name: "Cream", Brand: "Natrel", PriceAmount: 399, Currency: "CAD", ExternalID: ext("gone"), Available: true},
+		{ID: 4, Name: "Eggs", Brand: "Burnbrae", PriceAmount: 399, Currency: "CAD", ExternalID: ext("bad"), Available: true},
+	}}
+
+	// More than a batch of products, so the lookups are split.
+	products := []map[string]any{
+		product("sku-0", "Milk", "Natrel", "4.99"),
+		product("sku-1", "Butter", "Lactantia", "6.49"),
+		product("bad", "Eggs", "", "3.99"),
+	}
+	for i := 2; i < batchSize+10; i++ {
+		products = append(products, product(fmt.Sprintf("sku-%d", i), "Bread", "Dempster's", "3.49"))
+	}
+
+	report, err := New(st, importerfeed.DefaultRegistry(), noMatcher{}).DryRun(context.Background(), writeFeed(t, products), Options{})
+	if err != nil {
+		t.Fatalf("DryRun() error = %v", err)
+	}
+
+	if len(st.lookups) != 2 {
+		t.Errorf("looked items up %d times, want once per batch", len(st.lookups))
+	}
+	for _, keys := range st.lookups {
+		if len(keys) > batchSize {
+			t.Errorf("looked up %d external ids, want at most %d", len(keys), batchSize)
+		}
+	}
+	if len(report.Unchanged) != 1 || len(report.Updated) != 1 || len(report.New) != batchSize+8 || len(report.Rejected) != 1 {
+		t.Errorf("report has %d unchanged, %d updated, %d new and %d rejected, want 1, 1, %d and 1",
+			len(report.Unchanged), len(report.Updated), len(report.New), len(report.Rejected), batchSize+8)
+	}
+	if len(report.Delisted) != 1 || report.Delisted[0].ExternalID != "gone" {
+		t.Errorf("delisted = %+v, want only gone", report.Delisted)
+	}
+}
@@ -0,0 +1,155 @@
+package importerservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Subfolders of a watched directory that processed feeds are moved into.
+const (
+	watchDoneDir   = "done"
+	watchFailedDir = "failed"
+)
+
+// WatchOptions configures Watch.
+type WatchOptions struct {
+	// Dir is the drop directory to watch.
+	Dir string
+	// Interval is how often Dir is polled.
+	Interval time.Duration
+	// Force and MaxErrors apply to every import, as in Options.
+	Force     bool
+	MaxErrors int
+}
+
+// fileState is what a poll saw of a dropped file.
+type fileState struct {
+	size    int64
+	modTime time.Time
+}
+
+// Watch polls a drop directory and imports every feed file that appears in
+// it, detecting each file's format, until ctx is cancelled. A file is only
+// picked up once its size and modification time are unchanged between two
+// polls, so files still being written are left alone. Imported files, and
+// files already imported before, are moved into the done subfolder; files
+// that fail are moved into the failed subfolder next to a .error file with
+// the reason.
+func (s *service) Watch(ctx context.Context, opts WatchOptions) error {
+	if opts.Interval <= 0 {
+		return fmt.Errorf("watch interval must be positive, got %s", opts.Interval)
+	}
+	for _, sub := range []string{watchDoneDir, watchFailedDir} {
+		if err := os.MkdirAll(filepath.Join(opts.Dir, sub), 0o750); err != nil {
+			return fmt.Errorf("creating %s directory: %w", sub, err)
+		}
+	}
+
+	slog.Info("importer: watching directory", "dir", opts.Dir, "interval", opts.Interval)
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	pending := make(map[string]fileState)
+	for {
+		ready, err := pollDir(opts.Dir, pending)
+		if err != nil {
+			slog.Error("importer: failed to read watched directory", "dir", opts.Dir, "error", err)
+		}
+
+		for _, name := range ready {
+			if ctx.Err() != nil {
+				break
+			}
+			s.importDropped(ctx, opts, name)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("importer: stopped watching directory", "dir", opts.Dir)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollDir lists the regular files in dir and returns the names of those that
+// have not changed since the previous poll. pending carries what the previous
+// poll saw and is updated in place.
+func pollDir(dir string, pending map[string]fileState) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(entries))
+	var ready []string
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		name := entry.Name()
+		seen[name] = true
+		state := fileState{size: info.Size(), modTime: info.ModTime()}
+		if previous, ok := pending[name]; ok && previous == state {
+			ready = append(ready, name)
+			delete(pending, name)
+			continue
+		}
+		pending[name] = state
+	}
+
+	for name := range pending {
+		if !seen[name] {
+			delete(pending, name)
+		}
+	}
+	return ready, nil
+}
+
+// importDropped imports a single dropped file and moves it out of the way.
+func (s *service) importDropped(ctx context.Context, opts WatchOptions, name string) {
+	path := filepath.Join(opts.Dir, name)
+
+	_, err := s.Import(ctx, path, Options{
+		Force:     opts.Force,
+		MaxErrors: opts.MaxErrors,
+	})
+	if ctx.Err() != nil {
+		// Interrupted mid-import: the transaction was rolled back, so leave
+		// the file to be picked up again.
+		return
+	}
+
+	dest := watchDoneDir
+	if err != nil && !errors.Is(err, ErrAlreadyImported) {
+		dest = watchFailedDir
+	}
+
+	// Processed files are prefixed with the time they were processed so the
+	// same name can be dropped again without clobbering the earlier file.
+	target := filepath.Join(opts.Dir, dest, time.Now().UTC().Format("20060102T150405Z")+"-"+name)
+	if rerr := os.Rename(path, target); rerr != nil {
+		slog.Error("importer: failed to move processed feed", "file", path, "to", target, "error", rerr)
+		return
+	}
+
+	if dest == watchFailedDir {
+		if werr := os.WriteFile(target+".error", []byte(err.Error()+"\n"), 0o640); werr != nil {
+			slog.Warn("importer: failed to write feed error file", "file", target, "error", werr)
+		}
+	}
+
+	slog.Info("importer: processed dropped feed", "file", name, "moved_to", target)
+}
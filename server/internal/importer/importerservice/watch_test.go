@@ -0,0 +1,168 @@
+package importerservice
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/importer/importerfeed"
+)
+
+func TestPollDir(t *testing.T) {
+	tests := []struct {
+		name string
+		// between changes the directory between the first and second poll.
+		between func(t *testing.T, dir string)
+		want    []string
+	}{
+		{
+			name:    "unchanged files are ready",
+			between: func(t *testing.T, dir string) {},
+			want:    []string{"a.json", "b.csv"},
+		},
+		{
+			name: "files still being written are not",
+			between: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, "a.json"), "{\"store_location_id\": \"a-1\"}")
+			},
+			want: []string{"b.csv"},
+		},
+		{
+			name: "files touched are not",
+			between: func(t *testing.T, dir string) {
+				later := time.Now().Add(time.Hour)
+				if err := os.Chtimes(filepath.Join(dir, "b.csv"), later, later); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: []string{"a.json"},
+		},
+		{
+			name: "files that just appeared are not",
+			between: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, "c.ndjson"), "{}")
+			},
+			want: []string{"a.json", "b.csv"},
+		},
+		{
+			name: "removed files are forgotten",
+			between: func(t *testing.T, dir string) {
+				if err := os.Remove(filepath.Join(dir, "a.json")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: []string{"b.csv"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "a.json"), "{}")
+			writeFile(t, filepath.Join(dir, "b.csv"), "name,brand\n")
+			writeFile(t, filepath.Join(dir, ".partial"), "{}")
+			if err := os.Mkdir(filepath.Join(dir, watchDoneDir), 0o750); err != nil {
+				t.Fatal(err)
+			}
+
+			pending := make(map[string]fileState)
+			ready, err := pollDir(dir, pending)
+			if err != nil {
+				t.Fatalf("first pollDir() error = %v", err)
+			}
+			if len(ready) != 0 {
+				t.Errorf("first pollDir() = %v, want nothing ready", ready)
+			}
+
+			tt.between(t, dir)
+			ready, err = pollDir(dir, pending)
+			if err != nil {
+				t.Fatalf("second pollDir() error = %v", err)
+			}
+			if !reflect.DeepEqual(ready, tt.want) {
+				t.Errorf("second pollDir() = %v, want %v", ready, tt.want)
+			}
+			for _, name := range ready {
+				if _, ok := pending[name]; ok {
+					t.Errorf("%s is still pending once ready", name)
+				}
+			}
+		})
+	}
+}
+
+func TestImportDropped(t *testing.T) {
+	feed := `{"store_location_id": "a-1", "products": [{"sku": "ok-1", "product_name": "Milk", "manufacturer": "Natrel", "retail_price": 4.99}]}`
+
+	tests := []struct {
+		name     string
+		contents string
+		imported bool
+		cancel   bool
+		wantDest string
+	}{
+		{"imported", feed, false, false, watchDoneDir},
+		{"already imported", feed, true, false, watchDoneDir},
+		{"not a feed", "not json", false, false, watchFailedDir},
+		{"interrupted", feed, false, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, sub := range []string{watchDoneDir, watchFailedDir} {
+				if err := os.Mkdir(filepath.Join(dir, sub), 0o750); err != nil {
+					t.Fatal(err)
+				}
+			}
+			path := filepath.Join(dir, "feed.json")
+			writeFile(t, path, tt.contents)
+
+			st := &fakeItemStore{imported: map[string]*ent.ImportRun{}}
+			if tt.imported {
+				hash, err := hashFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				st.imported[hash] = &ent.ImportRun{ID: 3}
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				cancel()
+			}
+			defer cancel()
+
+			s := New(st, importerfeed.DefaultRegistry(), noMatcher{})
+			s.importDropped(ctx, WatchOptions{Dir: dir, MaxErrors: -1}, "feed.json")
+
+			if _, err := os.Stat(path); (err == nil) != (tt.wantDest == "") {
+				t.Errorf("feed.json left in place = %v, want %v", err == nil, tt.wantDest == "")
+			}
+			for _, sub := range []string{watchDoneDir, watchFailedDir} {
+				moved, err := filepath.Glob(filepath.Join(dir, sub, "*-feed.json"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := sub == tt.wantDest; (len(moved) == 1) != want {
+					t.Errorf("%s holds %v, want the feed there %v", sub, moved, want)
+				}
+				if sub == watchFailedDir && len(moved) == 1 {
+					if _, err := os.Stat(moved[0] + ".error"); err != nil {
+						t.Errorf("failed feed has no error file: %v", err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, path string, contents string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
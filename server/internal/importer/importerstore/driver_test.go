@@ -0,0 +1,153 @@
+package importerstore
+
+import (
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"offgrocery-assessment/internal/ent"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// fakeRows is a result set of a fakeDriver query.
+type fakeRows struct {
+	columns []string
+	values  [][]any
+}
+
+// statement is a statement a fakeDriver was sent.
+type statement struct {
+	query string
+	args  []any
+}
+
+// fakeDriver is a dialect.Driver that records the statements it is sent and
+// answers the queries among them from a script, in order, so store methods
+// run without a database. Queries past the end of the script get no rows,
+// and execs affect the number of rows in affected, or one.
+type fakeDriver struct {
+	script     []fakeRows
+	affected   []int64
+	statements []statement
+}
+
+func newFakeClient(script ...fakeRows) (*ent.Client, *fakeDriver) {
+	d := &fakeDriver{script: script}
+	return ent.NewClient(ent.Driver(d)), d
+}
+
+func (d *fakeDriver) Exec(ctx context.Context, query string, args, v any) error {
+	d.statements = append(d.statements, statement{query, args.([]any)})
+	if res, ok := v.(*stdsql.Result); ok {
+		*res = d.result()
+	}
+	return nil
+}
+
+func (d *fakeDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	d.statements = append(d.statements, statement{query, args})
+	return d.result(), nil
+}
+
+func (d *fakeDriver) result() driver.Result {
+	affected := int64(1)
+	if len(d.affected) > 0 {
+		affected, d.affected = d.affected[0], d.affected[1:]
+	}
+	return fakeResult(affected)
+}
+
+func (d *fakeDriver) Query(ctx context.Context, query string, args, v any) error {
+	d.statements = append(d.statements, statement{query, args.([]any)})
+	var rows fakeRows
+	if len(d.script) > 0 {
+		rows, d.script = d.script[0], d.script[1:]
+	}
+	*v.(*sql.Rows) = sql.Rows{ColumnScanner: &fakeCursor{rows: rows, next: -1}}
+	return nil
+}
+
+func (d *fakeDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return dialect.NopTx(d), nil
+}
+
+func (d *fakeDriver) Close() error    { return nil }
+func (d *fakeDriver) Dialect() string { return dialect.MySQL }
+
+// find returns the statements starting with prefix.
+func (d *fakeDriver) find(prefix string) []statement {
+	var found []statement
+	for _, s := range d.statements {
+		if strings.HasPrefix(s.query, prefix) {
+			found = append(found, s)
+		}
+	}
+	return found
+}
+
+// writes returns the statements other than queries, each cut short after
+// the table it writes, such as "UPDATE `items`".
+func (d *fakeDriver) writes() []string {
+	var writes []string
+	for _, s := range d.statements {
+		if strings.HasPrefix(s.query, "SELECT") {
+			continue
+		}
+		open := strings.IndexByte(s.query, '`')
+		end := strings.IndexByte(s.query[open+1:], '`')
+		writes = append(writes, s.query[:open+end+2])
+	}
+	return writes
+}
+
+type fakeResult int64
+
+func (r fakeResult) LastInsertId() (int64, error) { return 1000, nil }
+func (r fakeResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+// fakeCursor walks a fakeRows result set.
+type fakeCursor struct {
+	rows fakeRows
+	next int
+}
+
+func (c *fakeCursor) Close() error                               { return nil }
+func (c *fakeCursor) ColumnTypes() ([]*stdsql.ColumnType, error) { return nil, nil }
+func (c *fakeCursor) Columns() ([]string, error)                 { return c.rows.columns, nil }
+func (c *fakeCursor) Err() error                                 { return nil }
+func (c *fakeCursor) NextResultSet() bool                        { return false }
+
+func (c *fakeCursor) Next() bool {
+	c.next++
+	return c.next < len(c.rows.values)
+}
+
+func (c *fakeCursor) Scan(dest ...any) error {
+	row := c.rows.values[c.next]
+	if len(dest) != len(row) {
+		return fmt.Errorf("scanning %d values into %d destinations", len(row), len(dest))
+	}
+	for i, v := range row {
+		scanner, ok := dest[i].(stdsql.Scanner)
+		if !ok {
+			// Plain destinations, such as the *bool of an existence
+			// check, take values of their own type.
+			target := reflect.ValueOf(dest[i])
+			value := reflect.ValueOf(v)
+			if target.Kind() != reflect.Pointer || !value.Type().AssignableTo(target.Elem().Type()) {
+				return fmt.Errorf("scanning %T into %T", v, dest[i])
+			}
+			target.Elem().Set(value)
+			continue
+		}
+		if err := scanner.Scan(v); err != nil {
+			return fmt.Errorf("scanning %s: %w", c.rows.columns[i], err)
+		}
+	}
+	return nil
+}
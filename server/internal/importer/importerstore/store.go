@@ -2,15 +2,133 @@ package importerstore
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"offgrocery-assessment/internal/category/taxonomy"
 	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/categorymapping"
+	"offgrocery-assessment/internal/ent/importjob"
+	"offgrocery-assessment/internal/ent/importrun"
 	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/predicate"
+	"offgrocery-assessment/internal/ent/schema"
 	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/item/dietary"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 )
 
+// bulkBatchSize caps the number of rows sent in a single bulk insert.
+const bulkBatchSize = 500
+
+// ItemParams holds the fields written for a single imported item. Aisle and
+// Unit are only known for grocers whose feeds carry them, CategoryID is zero
+// when the item has no category, and Size is zero when its package size is
+// unknown. Attributes holds dietary attribute slugs.
+type ItemParams struct {
+	Name       string
+	Brand      string
+	Price      money.Money
+	ExternalID string
+	Aisle      string
+	CategoryID int
+	Unit       string
+	Attributes []string
+	Size       measure.Size
+}
+
+// Run identifies the import run writing items. StartedAt stamps every item
+// the run sees and is the cut-off for delisting the ones it did not see.
+type Run struct {
+	ID        int
+	StartedAt time.Time
+}
+
+// UpsertResult counts the items an upsert created and the existing items
+// whose fields it changed. Items written unchanged are in neither count.
+type UpsertResult struct {
+	Created int
+	Updated int
+}
+
+// RunParams describes a new import run.
+type RunParams struct {
+	FileName string
+	SHA256   string
+	Format   string
+}
+
+// RunOutcome is recorded on an import run when it finishes. StoreID is zero
+// when the run failed before its store was known.
+type RunOutcome struct {
+	StoreID  int
+	Format   string
+	Status   importrun.Status
+	Created  int
+	Updated  int
+	Delisted int
+	Failed   int
+	Error    string
+	// Rejections lists the first of the rows skipped because they failed
+	// validation. Failed counts all of them.
+	Rejections []schema.Rejection
+}
+
+// NameBrand identifies an item without an external id by its name and brand.
+type NameBrand struct {
+	Name  string
+	Brand string
+}
+
+// JobParams describes a feed upload to queue for import.
+type JobParams struct {
+	FileName  string
+	FilePath  string
+	Format    string
+	Force     bool
+	MaxErrors int
+}
+
+// JobOutcome is recorded on an import job when it finishes. RunID is zero
+// when the job failed before its run was recorded.
+type JobOutcome struct {
+	Status importjob.Status
+	RunID  int
+	Error  string
+}
+
 type Store interface {
+	// WithTx runs fn against a Store bound to a single transaction, committing
+	// if fn succeeds and rolling back otherwise.
+	WithTx(ctx context.Context, fn func(tx Store) error) error
+	FindStore(ctx context.Context, storeID string) (*ent.Store, error)
 	FindOrCreateStore(ctx context.Context, storeID string, grocer store.Grocer) (*ent.Store, error)
-	UpsertItem(ctx context.Context, name string, brand string, price float64, storeID int) error
+	ListMatchingItems(ctx context.Context, storeID int, externalIDs []string, names []NameBrand) ([]*ent.Item, error)
+	ListAvailableItems(ctx context.Context, storeID int, afterID int, limit int) ([]*ent.Item, error)
+	ResolveCategories(ctx context.Context, grocer store.Grocer, rawValues []string) (map[string]int, error)
+	UpsertItems(ctx context.Context, storeID int, run Run, items []ItemParams) (UpsertResult, error)
+	TouchItems(ctx context.Context, storeID int, run Run, externalIDs []string) error
+	DelistMissingItems(ctx context.Context, storeID int, run Run) (int, error)
+	CreateRun(ctx context.Context, params RunParams) (*ent.ImportRun, error)
+	FinishRun(ctx context.Context, id int, outcome RunOutcome) (*ent.ImportRun, error)
+	FindSucceededRun(ctx context.Context, sha256 string) (*ent.ImportRun, error)
+	ListRuns(ctx context.Context, limit int) ([]*ent.ImportRun, error)
+	GetRun(ctx context.Context, id int) (*ent.ImportRun, error)
+	CreateJob(ctx context.Context, params JobParams) (*ent.ImportJob, error)
+	ClaimNextJob(ctx context.Context, owner string, lease time.Duration) (*ent.ImportJob, error)
+	RenewJobLease(ctx context.Context, id int, owner string, lease time.Duration) error
+	UpdateJobProgress(ctx context.Context, id int, processed, total int) error
+	FinishJob(ctx context.Context, id int, owner string, outcome JobOutcome) (*ent.ImportJob, error)
+	ReleaseJob(ctx context.Context, id int, owner string, outcome JobOutcome) error
+	GetJob(ctx context.Context, id int) (*ent.ImportJob, error)
 }
 
 type importerStore struct {
@@ -21,6 +139,31 @@ func New(client *ent.Client) *importerStore {
 	return &importerStore{client: client}
 }
 
+func (s *importerStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if err := fn(&importerStore{client: tx.Client()}); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *importerStore) FindStore(ctx context.Context, storeID string) (*ent.Store, error) {
+	return s.client.Store.Query().
+		Where(store.StoreIDEQ(storeID)).
+		Only(ctx)
+}
+
 func (s *importerStore) FindOrCreateStore(ctx context.Context, storeID string, grocer store.Grocer) (*ent.Store, error) {
 	storeRecord, err := s.client.Store.Query().
 		Where(store.StoreIDEQ(storeID)).
@@ -34,35 +177,846 @@ func (s *importerStore) FindOrCreateStore(ctx context.Context, storeID string, g
 	return storeRecord, err
 }
 
-func (s *importerStore) UpsertItem(ctx context.Context, name string, brand string, price float64, storeID int) error {
-	exists, err := s.client.Item.Query().
+// ListMatchingItems returns the store's items, including delisted ones, that
+// feed rows with the given external ids, or names and brands, could be
+// matched to, oldest first.
+func (s *importerStore) ListMatchingItems(ctx context.Context, storeID int, externalIDs []string, names []NameBrand) ([]*ent.Item, error) {
+	var matches []predicate.Item
+	if len(externalIDs) > 0 {
+		matches = append(matches, item.ExternalIDIn(externalIDs...))
+	}
+	for _, n := range names {
+		matches = append(matches, item.And(item.NameEQ(n.Name), item.BrandEQ(n.Brand)))
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return s.client.Item.Query().
 		Where(
-			item.NameEQ(name),
-			item.BrandEQ(brand),
 			item.HasStoreWith(store.IDEQ(storeID)),
+			item.Or(matches...),
 		).
-		Exist(ctx)
-	if err != nil {
-		return err
-	}
+		Order(ent.Asc(item.FieldID)).
+		All(ctx)
+}
 
-	if exists {
-		_, err = s.client.Item.Update().
+// ListAvailableItems returns up to limit of the store's available items with
+// an id above afterID, in id order, so callers can page through them.
+func (s *importerStore) ListAvailableItems(ctx context.Context, storeID int, afterID int, limit int) ([]*ent.Item, error) {
+	return s.client.Item.Query().
+		Where(
+			item.HasStoreWith(store.IDEQ(storeID)),
+			item.AvailableEQ(true),
+			item.IDGT(afterID),
+		).
+		Order(ent.Asc(item.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// ResolveCategories maps a grocer's raw category labels to shared category
+// ids, keyed by the normalized label. Labels without a mapping are mapped to
+// the category with the matching slug, which is created if needed.
+func (s *importerStore) ResolveCategories(ctx context.Context, grocer store.Grocer, rawValues []string) (map[string]int, error) {
+	ids := make(map[string]int)
+	for _, raw := range rawValues {
+		normalized := taxonomy.Normalize(raw)
+		if normalized == "" {
+			continue
+		}
+		if _, ok := ids[normalized]; ok {
+			continue
+		}
+
+		mapping, err := s.client.CategoryMapping.Query().
 			Where(
-				item.NameEQ(name),
-				item.BrandEQ(brand),
-				item.HasStoreWith(store.IDEQ(storeID)),
+				categorymapping.GrocerEQ(categorymapping.Grocer(grocer)),
+				categorymapping.RawValueEQ(normalized),
 			).
-			SetPrice(price).
+			WithCategory().
+			Only(ctx)
+		if err == nil {
+			ids[normalized] = mapping.Edges.Category.ID
+			continue
+		}
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+
+		categoryRecord, err := s.findOrCreateCategory(ctx, normalized)
+		if err != nil {
+			return nil, fmt.Errorf("category %q: %w", normalized, err)
+		}
+
+		err = s.client.CategoryMapping.Create().
+			SetGrocer(categorymapping.Grocer(grocer)).
+			SetRawValue(normalized).
+			SetCategory(categoryRecord).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("mapping category %q: %w", normalized, err)
+		}
+		ids[normalized] = categoryRecord.ID
+	}
+	return ids, nil
+}
+
+func (s *importerStore) findOrCreateCategory(ctx context.Context, label string) (*ent.Category, error) {
+	categoryRecord, err := s.client.Category.Query().
+		Where(category.SlugEQ(taxonomy.Slug(label))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return s.client.Category.Create().
+			SetSlug(taxonomy.Slug(label)).
+			SetName(taxonomy.Name(label)).
 			Save(ctx)
+	}
+	return categoryRecord, err
+}
+
+// UpsertItems creates or updates the given items of a store. Items carrying an
+// external id are written with bulk inserts that update on the unique
+// (store, external_id) key, so renamed products keep their identity. Items
+// without one fall back to matching on name and brand. Every item written is
+// marked available and seen by the run, and a price observation tagged with
+// the run is recorded for every new item and every price change. Items'
+// attributes are replaced with the feed's unless they were pinned by hand.
+func (s *importerStore) UpsertItems(ctx context.Context, storeID int, run Run, items []ItemParams) (UpsertResult, error) {
+	var result UpsertResult
+
+	attributeIDs, err := s.resolveAttributes(ctx, items)
+	if err != nil {
+		return result, fmt.Errorf("resolving attributes: %w", err)
+	}
+
+	var keyed, unkeyed []ItemParams
+	for _, p := range items {
+		if p.ExternalID == "" {
+			unkeyed = append(unkeyed, p)
+			continue
+		}
+		keyed = append(keyed, p)
+	}
+
+	if err := s.adoptLegacyItems(ctx, storeID, keyed); err != nil {
+		return result, fmt.Errorf("adopting legacy items: %w", err)
+	}
+
+	for start := 0; start < len(keyed); start += bulkBatchSize {
+		end := min(start+bulkBatchSize, len(keyed))
+		created, updated, err := s.upsertBatch(ctx, storeID, run, keyed[start:end])
+		if err != nil {
+			return result, fmt.Errorf("upserting items %d-%d: %w", start, end-1, err)
+		}
+		if err := s.syncAttributes(ctx, storeID, keyed[start:end], attributeIDs); err != nil {
+			return result, fmt.Errorf("setting attributes of items %d-%d: %w", start, end-1, err)
+		}
+		result.Created += created
+		result.Updated += updated
+	}
+
+	for _, p := range unkeyed {
+		created, updated, err := s.upsertByName(ctx, storeID, run, p, attributeIDs)
+		if err != nil {
+			return result, fmt.Errorf("upserting item %q: %w", p.Name, err)
+		}
+		switch {
+		case created:
+			result.Created++
+		case updated:
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
+
+// upsertBatch bulk upserts items that all carry an external id, records
+// their price changes and returns how many of them were new and how many
+// existing ones changed. An external id repeated in the batch is counted
+// once, with the last of its items, which is the one the upsert keeps.
+func (s *importerStore) upsertBatch(ctx context.Context, storeID int, run Run, items []ItemParams) (int, int, error) {
+	latest := make(map[string]ItemParams, len(items))
+	var externalIDs []string
+	for _, p := range items {
+		if _, ok := latest[p.ExternalID]; !ok {
+			externalIDs = append(externalIDs, p.ExternalID)
+		}
+		latest[p.ExternalID] = p
+	}
+
+	before, err := s.itemsByExternalID(ctx, storeID, externalIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := s.bulkUpsert(ctx, storeID, run, items); err != nil {
+		return 0, 0, err
+	}
+
+	var created, updated int
+	var changed []ItemParams
+	for _, id := range externalIDs {
+		p := latest[id]
+		old, ok := before[id]
+		switch {
+		case !ok:
+			created++
+		case ItemChanged(old, p):
+			updated++
+		}
+		if !ok || old.PriceAmount != p.Price.Amount || old.Currency != p.Price.Currency {
+			changed = append(changed, p)
+		}
+	}
+	if len(changed) == 0 {
+		return created, updated, nil
+	}
+
+	changedIDs := make([]string, len(changed))
+	for i, p := range changed {
+		changedIDs[i] = p.ExternalID
+	}
+
+	after, err := s.itemsByExternalID(ctx, storeID, changedIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	builders := make([]*ent.PriceObservationCreate, 0, len(changed))
+	for _, p := range changed {
+		it, ok := after[p.ExternalID]
+		if !ok {
+			return 0, 0, fmt.Errorf("item %q missing after upsert", p.ExternalID)
+		}
+		builders = append(builders, s.client.PriceObservation.Create().
+			SetItemID(it.ID).
+			SetPriceAmount(p.Price.Amount).
+			SetCurrency(p.Price.Currency).
+			SetImportRunID(run.ID))
+	}
+
+	if err := s.client.PriceObservation.CreateBulk(builders...).Exec(ctx); err != nil {
+		return 0, 0, err
+	}
+	return created, updated, nil
+}
+
+// itemsByExternalID returns the store's items with the given external ids,
+// keyed by external id.
+func (s *importerStore) itemsByExternalID(ctx context.Context, storeID int, externalIDs []string) (map[string]*ent.Item, error) {
+	items, err := s.client.Item.Query().
+		Where(
+			item.ExternalIDIn(externalIDs...),
+			item.HasStoreWith(store.IDEQ(storeID)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byExternalID := make(map[string]*ent.Item, len(items))
+	for _, it := range items {
+		byExternalID[*it.ExternalID] = it
+	}
+	return byExternalID, nil
+}
+
+// bulkUpsert inserts items in a single statement that updates the existing
+// item on the unique (store, external_id) key instead.
+func (s *importerStore) bulkUpsert(ctx context.Context, storeID int, run Run, items []ItemParams) error {
+	query, args := upsertQuery(storeID, run, items, time.Now())
+	_, err := s.client.ExecContext(ctx, query, args...)
+	return err
+}
+
+// upsertColumns are the columns upsertQuery inserts, in order.
+var upsertColumns = []string{
+	item.FieldName,
+	item.FieldBrand,
+	item.FieldPriceAmount,
+	item.FieldCurrency,
+	item.FieldExternalID,
+	item.FieldAisle,
+	item.FieldCategoryID,
+	item.FieldUnit,
+	item.FieldSizeQuantity,
+	item.FieldSizeUnit,
+	item.FieldUnitPriceMicros,
+	item.FieldProductPinned,
+	item.FieldAttributesPinned,
+	item.FieldAvailable,
+	item.FieldLastSeenAt,
+	item.StoreColumn,
+	item.FieldCreateTime,
+	item.FieldUpdateTime,
+}
+
+// upsertReplaced are the columns an upsert overwrites with the inserted
+// row's values. Aisle, category and unit are only overwritten when the row
+// carries a value, so a feed that omits them keeps the stored ones, as
+// upsertByName does. Pins and the creation time are never overwritten.
+var upsertReplaced = []string{
+	item.FieldName,
+	item.FieldBrand,
+	item.FieldPriceAmount,
+	item.FieldCurrency,
+	item.FieldSizeQuantity,
+	item.FieldSizeUnit,
+	item.FieldUnitPriceMicros,
+	item.FieldAvailable,
+	item.FieldLastSeenAt,
+	item.FieldUpdateTime,
+}
+
+// upsertQuery returns the statement bulkUpsert runs. It refers to the
+// inserted row by the alias new, as ent only renders the VALUES() function
+// MySQL deprecated for it.
+func upsertQuery(storeID int, run Run, items []ItemParams, now time.Time) (string, []any) {
+	insert := sql.Dialect(dialect.MySQL).
+		Insert(item.Table).
+		Columns(upsertColumns...)
+	for _, p := range items {
+		insert.Values(
+			p.Name,
+			p.Brand,
+			p.Price.Amount,
+			p.Price.Currency,
+			p.ExternalID,
+			nonEmpty(p.Aisle),
+			nonZero(p.CategoryID),
+			nonEmpty(p.Unit),
+			sizeQuantity(p.Size),
+			sizeUnit(p.Size),
+			unitPrice(p.Price, p.Size),
+			false,
+			false,
+			true,
+			run.StartedAt,
+			storeID,
+			now,
+			now,
+		)
+	}
+	query, args := insert.Query()
+
+	updates := make([]string, 0, len(upsertReplaced)+4)
+	for _, column := range upsertReplaced {
+		updates = append(updates, fmt.Sprintf("`%s` = `new`.`%s`", column, column))
+	}
+	for _, column := range []string{item.FieldAisle, item.FieldCategoryID, item.FieldUnit} {
+		updates = append(updates, fmt.Sprintf("`%s` = COALESCE(`new`.`%s`, `%s`.`%s`)", column, column, item.Table, column))
+	}
+	updates = append(updates, fmt.Sprintf("`%s` = NULL", item.FieldDelistedAt))
+
+	return query + " AS `new` ON DUPLICATE KEY UPDATE " + strings.Join(updates, ", "), args
+}
+
+// ItemChanged reports whether upserting p changes any of the stored item's
+// fields, including relisting it. Aisle, category and unit only count when p
+// provides them, since they are kept otherwise. Dry runs use it to report
+// the updates an import would count.
+func ItemChanged(old *ent.Item, p ItemParams) bool {
+	return old.Name != p.Name ||
+		old.Brand != p.Brand ||
+		old.PriceAmount != p.Price.Amount ||
+		old.Currency != p.Price.Currency ||
+		(p.Aisle != "" && old.Aisle != p.Aisle) ||
+		(p.CategoryID != 0 && !equalPtr(old.CategoryID, nonZero(p.CategoryID))) ||
+		(p.Unit != "" && old.Unit != p.Unit) ||
+		!equalPtr(old.SizeQuantity, sizeQuantity(p.Size)) ||
+		!equalPtr(old.SizeUnit, sizeUnit(p.Size)) ||
+		!equalPtr(old.UnitPriceMicros, unitPrice(p.Price, p.Size)) ||
+		!old.Available
+}
+
+// equalPtr reports whether two optional values are both unset or equal.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// adoptLegacyItems assigns external ids to items that were imported before
+// external ids were recorded, matching them on name and brand, so the bulk
+// upsert updates them instead of inserting duplicates.
+func (s *importerStore) adoptLegacyItems(ctx context.Context, storeID int, items []ItemParams) error {
+	legacy, err := s.client.Item.Query().
+		Where(
+			item.ExternalIDIsNil(),
+			item.HasStoreWith(store.IDEQ(storeID)),
+		).
+		All(ctx)
+	if err != nil || len(legacy) == 0 {
 		return err
 	}
 
-	_, err = s.client.Item.Create().
-		SetName(name).
-		SetBrand(brand).
-		SetPrice(price).
+	externalIDs := make([]string, len(items))
+	for i, p := range items {
+		externalIDs[i] = p.ExternalID
+	}
+	taken, err := s.itemsByExternalID(ctx, storeID, externalIDs)
+	if err != nil {
+		return err
+	}
+
+	for itemID, externalID := range legacyAdoptions(legacy, items, taken) {
+		if err := s.client.Item.UpdateOneID(itemID).SetExternalID(externalID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacyAdoptions returns the external id each legacy item adopts, keyed by
+// item id. A legacy item adopts the external id of the first of items with
+// its name and brand, unless another item already has or adopts that
+// external id.
+func legacyAdoptions(legacy []*ent.Item, items []ItemParams, taken map[string]*ent.Item) map[int]string {
+	byNameBrand := make(map[[2]string]*ent.Item, len(legacy))
+	for _, it := range legacy {
+		byNameBrand[[2]string{it.Name, it.Brand}] = it
+	}
+
+	adoptions := make(map[int]string)
+	adopted := make(map[string]bool)
+	for _, p := range items {
+		key := [2]string{p.Name, p.Brand}
+		it, ok := byNameBrand[key]
+		if !ok {
+			continue
+		}
+		delete(byNameBrand, key)
+		if _, ok := taken[p.ExternalID]; ok || adopted[p.ExternalID] {
+			continue
+		}
+		adoptions[it.ID] = p.ExternalID
+		adopted[p.ExternalID] = true
+	}
+	return adoptions
+}
+
+// upsertByName creates or updates an item from a feed that does not provide
+// external ids, matching on name and brand. It reports whether the item was
+// new and, if not, whether any of its fields changed.
+func (s *importerStore) upsertByName(ctx context.Context, storeID int, run Run, params ItemParams, attributeIDs map[string]int) (bool, bool, error) {
+	existing, err := s.client.Item.Query().
+		Where(
+			item.NameEQ(params.Name),
+			item.BrandEQ(params.Brand),
+			item.HasStoreWith(store.IDEQ(storeID)),
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, false, err
+	}
+
+	if existing != nil {
+		updated := ItemChanged(existing, params)
+		update := s.client.Item.UpdateOne(existing)
+		if !existing.AttributesPinned {
+			update = update.
+				ClearAttributes().
+				AddAttributeIDs(idsOf(params.Attributes, attributeIDs)...)
+		}
+		if params.Size.IsZero() {
+			update = update.ClearSizeQuantity().ClearSizeUnit()
+		}
+		if unitPrice(params.Price, params.Size) == nil {
+			update = update.ClearUnitPriceMicros()
+		}
+		err := update.
+			SetPriceAmount(params.Price.Amount).
+			SetCurrency(params.Price.Currency).
+			SetNillableAisle(nonEmpty(params.Aisle)).
+			SetNillableCategoryID(nonZero(params.CategoryID)).
+			SetNillableUnit(nonEmpty(params.Unit)).
+			SetNillableSizeQuantity(sizeQuantity(params.Size)).
+			SetNillableSizeUnit(sizeUnit(params.Size)).
+			SetNillableUnitPriceMicros(unitPrice(params.Price, params.Size)).
+			SetAvailable(true).
+			ClearDelistedAt().
+			SetLastSeenAt(run.StartedAt).
+			Exec(ctx)
+		if err != nil || money.New(existing.PriceAmount, existing.Currency) == params.Price {
+			return false, updated, err
+		}
+		return false, updated, s.recordPrice(ctx, existing.ID, run.ID, params.Price)
+	}
+
+	created, err := s.client.Item.Create().
+		SetName(params.Name).
+		SetBrand(params.Brand).
+		SetPriceAmount(params.Price.Amount).
+		SetCurrency(params.Price.Currency).
+		SetNillableAisle(nonEmpty(params.Aisle)).
+		SetNillableCategoryID(nonZero(params.CategoryID)).
+		SetNillableUnit(nonEmpty(params.Unit)).
+		AddAttributeIDs(idsOf(params.Attributes, attributeIDs)...).
+		SetNillableSizeQuantity(sizeQuantity(params.Size)).
+		SetNillableSizeUnit(sizeUnit(params.Size)).
+		SetNillableUnitPriceMicros(unitPrice(params.Price, params.Size)).
+		SetLastSeenAt(run.StartedAt).
 		SetStoreID(storeID).
 		Save(ctx)
-	return err
+	if err != nil {
+		return false, false, err
+	}
+	return true, false, s.recordPrice(ctx, created.ID, run.ID, params.Price)
+}
+
+// resolveAttributes returns the ids of the attributes the items carry, keyed
+// by slug, creating attributes that do not exist yet.
+func (s *importerStore) resolveAttributes(ctx context.Context, items []ItemParams) (map[string]int, error) {
+	ids := make(map[string]int)
+	for _, p := range items {
+		for _, slug := range p.Attributes {
+			if _, ok := ids[slug]; ok {
+				continue
+			}
+
+			attributeRecord, err := s.client.Attribute.Query().
+				Where(attribute.SlugEQ(slug)).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				attributeRecord, err = s.client.Attribute.Create().
+					SetSlug(slug).
+					SetName(dietary.Name(slug)).
+					Save(ctx)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q: %w", slug, err)
+			}
+			ids[slug] = attributeRecord.ID
+		}
+	}
+	return ids, nil
+}
+
+// syncAttributes replaces the attributes of the store's items with the given
+// external ids with the ones in their params, skipping items whose
+// attributes were pinned by hand and items that already have them.
+func (s *importerStore) syncAttributes(ctx context.Context, storeID int, items []ItemParams, attributeIDs map[string]int) error {
+	externalIDs := make([]string, len(items))
+	for i, p := range items {
+		externalIDs[i] = p.ExternalID
+	}
+
+	existing, err := s.client.Item.Query().
+		Where(
+			item.ExternalIDIn(externalIDs...),
+			item.HasStoreWith(store.IDEQ(storeID)),
+			item.AttributesPinnedEQ(false),
+		).
+		WithAttributes().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	byExternalID := make(map[string]*ent.Item, len(existing))
+	for _, it := range existing {
+		byExternalID[*it.ExternalID] = it
+	}
+
+	for _, p := range items {
+		it, ok := byExternalID[p.ExternalID]
+		if !ok {
+			continue
+		}
+
+		want := idsOf(p.Attributes, attributeIDs)
+		have := make([]int, len(it.Edges.Attributes))
+		for i, a := range it.Edges.Attributes {
+			have[i] = a.ID
+		}
+		slices.Sort(want)
+		slices.Sort(have)
+		if slices.Equal(want, have) {
+			continue
+		}
+
+		err := s.client.Item.UpdateOne(it).
+			ClearAttributes().
+			AddAttributeIDs(want...).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// idsOf looks up the ids of attribute slugs resolved by resolveAttributes.
+func idsOf(slugs []string, attributeIDs map[string]int) []int {
+	ids := make([]int, len(slugs))
+	for i, slug := range slugs {
+		ids[i] = attributeIDs[slug]
+	}
+	return ids
+}
+
+func (s *importerStore) recordPrice(ctx context.Context, itemID int, runID int, price money.Money) error {
+	return s.client.PriceObservation.Create().
+		SetItemID(itemID).
+		SetPriceAmount(price.Amount).
+		SetCurrency(price.Currency).
+		SetImportRunID(runID).
+		Exec(ctx)
+}
+
+// TouchItems marks the store's items with the given external ids as seen by
+// the run without changing them, so rows rejected from a feed do not get
+// their items delisted.
+func (s *importerStore) TouchItems(ctx context.Context, storeID int, run Run, externalIDs []string) error {
+	if len(externalIDs) == 0 {
+		return nil
+	}
+	return s.client.Item.Update().
+		Where(
+			item.ExternalIDIn(externalIDs...),
+			item.HasStoreWith(store.IDEQ(storeID)),
+		).
+		SetLastSeenAt(run.StartedAt).
+		Exec(ctx)
+}
+
+// DelistMissingItems marks the store's available items that the run did not
+// see as unavailable, stamping when they were delisted. Items are kept so
+// lists referencing them and their price history survive.
+func (s *importerStore) DelistMissingItems(ctx context.Context, storeID int, run Run) (int, error) {
+	return s.client.Item.Update().
+		Where(
+			item.HasStoreWith(store.IDEQ(storeID)),
+			item.AvailableEQ(true),
+			item.Or(
+				item.LastSeenAtIsNil(),
+				item.LastSeenAtLT(run.StartedAt),
+			),
+		).
+		SetAvailable(false).
+		SetDelistedAt(time.Now()).
+		Save(ctx)
+}
+
+func (s *importerStore) CreateRun(ctx context.Context, params RunParams) (*ent.ImportRun, error) {
+	return s.client.ImportRun.Create().
+		SetFileName(params.FileName).
+		SetSha256(params.SHA256).
+		SetFormat(params.Format).
+		Save(ctx)
+}
+
+func (s *importerStore) FinishRun(ctx context.Context, id int, outcome RunOutcome) (*ent.ImportRun, error) {
+	update := s.client.ImportRun.UpdateOneID(id).
+		SetStatus(outcome.Status).
+		SetFinishedAt(time.Now()).
+		SetCreatedCount(outcome.Created).
+		SetUpdatedCount(outcome.Updated).
+		SetDelistedCount(outcome.Delisted).
+		SetFailedCount(outcome.Failed).
+		SetError(outcome.Error).
+		SetRejections(outcome.Rejections)
+	if outcome.StoreID != 0 {
+		update = update.SetStoreID(outcome.StoreID)
+	}
+	if outcome.Format != "" {
+		update = update.SetFormat(outcome.Format)
+	}
+	return update.Save(ctx)
+}
+
+// FindSucceededRun returns the latest successful run of a file with the given
+// hash.
+func (s *importerStore) FindSucceededRun(ctx context.Context, sha256 string) (*ent.ImportRun, error) {
+	return s.client.ImportRun.Query().
+		Where(
+			importrun.Sha256EQ(sha256),
+			importrun.StatusEQ(importrun.StatusSucceeded),
+		).
+		Order(ent.Desc(importrun.FieldStartedAt)).
+		First(ctx)
+}
+
+// ListRuns returns the most recent import runs, newest first.
+func (s *importerStore) ListRuns(ctx context.Context, limit int) ([]*ent.ImportRun, error) {
+	return s.client.ImportRun.Query().
+		WithStore().
+		Order(ent.Desc(importrun.FieldStartedAt), ent.Desc(importrun.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (s *importerStore) GetRun(ctx context.Context, id int) (*ent.ImportRun, error) {
+	return s.client.ImportRun.Query().
+		Where(importrun.IDEQ(id)).
+		WithStore().
+		Only(ctx)
+}
+
+func (s *importerStore) CreateJob(ctx context.Context, params JobParams) (*ent.ImportJob, error) {
+	return s.client.ImportJob.Create().
+		SetFileName(params.FileName).
+		SetFilePath(params.FilePath).
+		SetFormat(params.Format).
+		SetForce(params.Force).
+		SetMaxErrors(params.MaxErrors).
+		Save(ctx)
+}
+
+// ClaimNextJob marks the oldest claimable job as running under owner, with a
+// lease that ends after lease, and returns it. A job is claimable while it is
+// queued, or while it is running under a lease that has run out because its
+// owner stopped renewing it; its import transaction was rolled back, so
+// running it again is safe. It returns a not found error when no job is
+// claimable.
+func (s *importerStore) ClaimNextJob(ctx context.Context, owner string, lease time.Duration) (*ent.ImportJob, error) {
+	for {
+		now := time.Now()
+		job, err := s.client.ImportJob.Query().
+			Where(claimable(now)).
+			Order(ent.Asc(importjob.FieldID)).
+			First(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		claimed, err := s.client.ImportJob.Update().
+			Where(
+				importjob.IDEQ(job.ID),
+				claimable(now),
+			).
+			SetStatus(importjob.StatusRunning).
+			SetOwner(owner).
+			SetLeaseExpiresAt(now.Add(lease)).
+			SetStartedAt(now).
+			SetRowsProcessed(0).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if claimed == 1 {
+			return s.client.ImportJob.Get(ctx, job.ID)
+		}
+	}
+}
+
+// claimable matches jobs that are queued or whose lease ran out before now.
+func claimable(now time.Time) predicate.ImportJob {
+	return importjob.Or(
+		importjob.StatusEQ(importjob.StatusQueued),
+		importjob.And(
+			importjob.StatusEQ(importjob.StatusRunning),
+			importjob.LeaseExpiresAtLT(now),
+		),
+	)
+}
+
+// RenewJobLease extends the lease owner holds on a running job. It returns a
+// not found error when owner no longer holds the job.
+func (s *importerStore) RenewJobLease(ctx context.Context, id int, owner string, lease time.Duration) error {
+	return s.client.ImportJob.UpdateOneID(id).
+		Where(
+			importjob.OwnerEQ(owner),
+			importjob.StatusEQ(importjob.StatusRunning),
+		).
+		SetLeaseExpiresAt(time.Now().Add(lease)).
+		Exec(ctx)
+}
+
+func (s *importerStore) UpdateJobProgress(ctx context.Context, id int, processed, total int) error {
+	return s.client.ImportJob.UpdateOneID(id).
+		SetRowsProcessed(processed).
+		SetRowsTotal(total).
+		Exec(ctx)
+}
+
+// FinishJob records the outcome of a job owner holds and ends its lease. It
+// returns a not found error when owner no longer holds the job.
+func (s *importerStore) FinishJob(ctx context.Context, id int, owner string, outcome JobOutcome) (*ent.ImportJob, error) {
+	update := s.client.ImportJob.UpdateOneID(id).
+		Where(
+			importjob.OwnerEQ(owner),
+			importjob.StatusEQ(importjob.StatusRunning),
+		).
+		SetStatus(outcome.Status).
+		SetFinishedAt(time.Now()).
+		SetError(outcome.Error).
+		ClearLeaseExpiresAt()
+	if outcome.RunID != 0 {
+		update = update.SetImportRunID(outcome.RunID)
+	}
+	return update.Save(ctx)
+}
+
+// ReleaseJob puts a job owner holds back in the queue after its import was
+// interrupted, recording the interrupted run and why, so any queue can claim
+// it again straight away. It returns a not found error when owner no longer
+// holds the job.
+func (s *importerStore) ReleaseJob(ctx context.Context, id int, owner string, outcome JobOutcome) error {
+	update := s.client.ImportJob.UpdateOneID(id).
+		Where(
+			importjob.OwnerEQ(owner),
+			importjob.StatusEQ(importjob.StatusRunning),
+		).
+		SetStatus(importjob.StatusQueued).
+		SetError(outcome.Error).
+		SetRowsProcessed(0).
+		ClearOwner().
+		ClearStartedAt().
+		ClearLeaseExpiresAt()
+	if outcome.RunID != 0 {
+		update = update.SetImportRunID(outcome.RunID)
+	}
+	return update.Exec(ctx)
+}
+
+func (s *importerStore) GetJob(ctx context.Context, id int) (*ent.ImportJob, error) {
+	return s.client.ImportJob.Query().
+		Where(importjob.IDEQ(id)).
+		WithImportRun().
+		Only(ctx)
+}
+
+// nonZero returns nil for a zero id so optional edges are left unset.
+func nonZero(id int) *int {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+// nonEmpty returns nil for an empty string so optional columns are left
+// unset when a feed does not provide a value.
+func nonEmpty(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+// sizeQuantity, sizeUnit and unitPrice return nil for an unknown size so the
+// size columns are left unset.
+func sizeQuantity(size measure.Size) *float64 {
+	if size.IsZero() {
+		return nil
+	}
+	return &size.Quantity
+}
+
+func sizeUnit(size measure.Size) *item.SizeUnit {
+	if size.IsZero() {
+		return nil
+	}
+	u := item.SizeUnit(size.Unit)
+	return &u
+}
+
+func unitPrice(price money.Money, size measure.Size) *int64 {
+	perUnit, ok := measure.UnitPrice(price, size)
+	if !ok {
+		return nil
+	}
+	return &perUnit
 }
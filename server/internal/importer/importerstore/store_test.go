@@ -0,0 +1,482 @@
+package importerstore
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/money"
+)
+
+// itemColumns are the columns itemRows answers item queries with. The rest
+// are left unset.
+var itemColumns = []string{"id", "name", "brand", "price_amount", "currency", "external_id", "available"}
+
+// itemRows answers an item query with the given items.
+func itemRows(items ...*ent.Item) fakeRows {
+	rows := fakeRows{columns: itemColumns}
+	for _, it := range items {
+		var externalID any
+		if it.ExternalID != nil {
+			externalID = *it.ExternalID
+		}
+		rows.values = append(rows.values, []any{int64(it.ID), it.Name, it.Brand, it.PriceAmount, it.Currency, externalID, it.Available})
+	}
+	return rows
+}
+
+func stored(id int, name string, price int64, externalID string, available bool) *ent.Item {
+	it := &ent.Item{ID: id, Name: name, Brand: "Natrel", PriceAmount: price, Currency: "CAD", Available: available}
+	if externalID != "" {
+		it.ExternalID = &externalID
+	}
+	return it
+}
+
+func TestUpsertQuery(t *testing.T) {
+	now := time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC)
+	run := Run{ID: 9, StartedAt: now.Add(-time.Minute)}
+	milk := ItemParams{
+		Name:       "2% Milk",
+		Brand:      "Natrel",
+		Price:      money.New(529, "CAD"),
+		ExternalID: "B-1",
+		Aisle:      "Dairy",
+		CategoryID: 4,
+		Size:       measure.Size{Quantity: 2000, Unit: measure.Millilitre},
+	}
+	bread := ItemParams{Name: "Bread", Brand: "Dempster's", Price: money.New(349, "CAD"), ExternalID: "B-2"}
+
+	query, args := upsertQuery(3, run, []ItemParams{milk, bread}, now)
+
+	row := "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	wantQuery := "INSERT INTO `items` (`name`, `brand`, `price_amount`, `currency`, `external_id`, `aisle`, `category_id`, `unit`, " +
+		"`size_quantity`, `size_unit`, `unit_price_micros`, `product_pinned`, `attributes_pinned`, `available`, `last_seen_at`, " +
+		"`store_items`, `create_time`, `update_time`) VALUES " + row + ", " + row + " AS `new` ON DUPLICATE KEY UPDATE " +
+		"`name` = `new`.`name`, `brand` = `new`.`brand`, `price_amount` = `new`.`price_amount`, `currency` = `new`.`currency`, " +
+		"`size_quantity` = `new`.`size_quantity`, `size_unit` = `new`.`size_unit`, `unit_price_micros` = `new`.`unit_price_micros`, " +
+		"`available` = `new`.`available`, `last_seen_at` = `new`.`last_seen_at`, `update_time` = `new`.`update_time`, " +
+		"`aisle` = COALESCE(`new`.`aisle`, `items`.`aisle`), `category_id` = COALESCE(`new`.`category_id`, `items`.`category_id`), " +
+		"`unit` = COALESCE(`new`.`unit`, `items`.`unit`), `delisted_at` = NULL"
+	if query != wantQuery {
+		t.Errorf("query =\n%s\nwant\n%s", query, wantQuery)
+	}
+
+	aisle, categoryID := "Dairy", 4
+	quantity, unit, unitPrice := 2000.0, item.SizeUnitMl, int64(264500)
+	wantArgs := []any{
+		"2% Milk", "Natrel", int64(529), "CAD", "B-1", &aisle, &categoryID, (*string)(nil),
+		&quantity, &unit, &unitPrice, false, false, true, run.StartedAt, 3, now, now,
+		"Bread", "Dempster's", int64(349), "CAD", "B-2", (*string)(nil), (*int)(nil), (*string)(nil),
+		(*float64)(nil), (*item.SizeUnit)(nil), (*int64)(nil), false, false, true, run.StartedAt, 3, now, now,
+	}
+	if !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("args = %v, want %v", args, wantArgs)
+	}
+}
+
+func TestLegacyAdoptions(t *testing.T) {
+	legacy := []*ent.Item{stored(1, "Milk", 499, "", true), stored(2, "Cream", 399, "", true)}
+	params := func(name, externalID string) ItemParams {
+		return ItemParams{Name: name, Brand: "Natrel", Price: money.New(499, "CAD"), ExternalID: externalID}
+	}
+
+	tests := []struct {
+		name  string
+		items []ItemParams
+		taken map[string]*ent.Item
+		want  map[int]string
+	}{
+		{"matches name and brand", []ItemParams{params("Milk", "B-1"), params("Cream", "B-2")}, nil, map[int]string{1: "B-1", 2: "B-2"}},
+		{"other brand", []ItemParams{{Name: "Milk", Brand: "Lactantia", ExternalID: "B-1"}}, nil, map[int]string{}},
+		{"no match", []ItemParams{params("Butter", "B-3")}, nil, map[int]string{}},
+		{"first of repeated name", []ItemParams{params("Milk", "B-1"), params("Milk", "B-9")}, nil, map[int]string{1: "B-1"}},
+		{"external id taken", []ItemParams{params("Milk", "B-1")}, map[string]*ent.Item{"B-1": stored(7, "Milk", 499, "B-1", true)}, map[int]string{}},
+		{"external id adopted once", []ItemParams{params("Milk", "B-1"), params("Cream", "B-1")}, nil, map[int]string{1: "B-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := legacyAdoptions(legacy, tt.items, tt.taken); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("legacyAdoptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemChanged(t *testing.T) {
+	aisle, unitPrice := "Dairy", int64(264500)
+	quantity, unit := 2000.0, item.SizeUnitMl
+	categoryID := 4
+	old := &ent.Item{
+		Name:            "2% Milk",
+		Brand:           "Natrel",
+		PriceAmount:     529,
+		Currency:        "CAD",
+		Aisle:           aisle,
+		CategoryID:      &categoryID,
+		SizeQuantity:    &quantity,
+		SizeUnit:        &unit,
+		UnitPriceMicros: &unitPrice,
+		Available:       true,
+	}
+	same := ItemParams{
+		Name:       "2% Milk",
+		Brand:      "Natrel",
+		Price:      money.New(529, "CAD"),
+		Aisle:      "Dairy",
+		CategoryID: 4,
+		Size:       measure.Size{Quantity: 2000, Unit: measure.Millilitre},
+	}
+
+	tests := []struct {
+		name   string
+		change func(p *ItemParams)
+		old    func(it ent.Item) ent.Item
+		want   bool
+	}{
+		{"unchanged", func(p *ItemParams) {}, nil, false},
+		{"omitted aisle is kept", func(p *ItemParams) { p.Aisle = "" }, nil, false},
+		{"omitted category is kept", func(p *ItemParams) { p.CategoryID = 0 }, nil, false},
+		{"renamed", func(p *ItemParams) { p.Name = "Milk 2%" }, nil, true},
+		{"rebranded", func(p *ItemParams) { p.Brand = "Lactantia" }, nil, true},
+		{"new price", func(p *ItemParams) { p.Price = money.New(549, "CAD") }, nil, true},
+		{"new currency", func(p *ItemParams) { p.Price = money.New(529, "USD") }, nil, true},
+		{"new aisle", func(p *ItemParams) { p.Aisle = "Milk" }, nil, true},
+		{"new category", func(p *ItemParams) { p.CategoryID = 5 }, nil, true},
+		{"new unit", func(p *ItemParams) { p.Unit = "each" }, nil, true},
+		{"new size", func(p *ItemParams) { p.Size = measure.Size{Quantity: 1000, Unit: measure.Millilitre} }, nil, true},
+		{"size dropped", func(p *ItemParams) { p.Size = measure.Size{} }, nil, true},
+		{"relisted", func(p *ItemParams) {}, func(it ent.Item) ent.Item { it.Available = false; return it }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := same
+			tt.change(&p)
+			before := *old
+			if tt.old != nil {
+				before = tt.old(before)
+			}
+			if got := ItemChanged(&before, p); got != tt.want {
+				t.Errorf("ItemChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpsertByName(t *testing.T) {
+	run := Run{ID: 9, StartedAt: time.Now()}
+	milk := ItemParams{Name: "Milk", Brand: "Natrel", Price: money.New(529, "CAD")}
+
+	tests := []struct {
+		name        string
+		script      []fakeRows
+		wantCreated bool
+		wantUpdated bool
+		wantWrites  []string
+	}{
+		{
+			name:        "new item",
+			wantCreated: true,
+			wantWrites:  []string{"INSERT INTO `items`", "INSERT INTO `price_observations`"},
+		},
+		{
+			name:        "price change",
+			script:      []fakeRows{itemRows(stored(7, "Milk", 499, "", true)), itemRows(stored(7, "Milk", 529, "", true))},
+			wantUpdated: true,
+			wantWrites:  []string{"UPDATE `items`", "DELETE FROM `item_attributes`", "INSERT INTO `price_observations`"},
+		},
+		{
+			name:       "unchanged",
+			script:     []fakeRows{itemRows(stored(7, "Milk", 529, "", true)), itemRows(stored(7, "Milk", 529, "", true))},
+			wantWrites: []string{"UPDATE `items`", "DELETE FROM `item_attributes`"},
+		},
+		{
+			name:        "relisted",
+			script:      []fakeRows{itemRows(stored(7, "Milk", 529, "", false)), itemRows(stored(7, "Milk", 529, "", true))},
+			wantUpdated: true,
+			wantWrites:  []string{"UPDATE `items`", "DELETE FROM `item_attributes`"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, d := newFakeClient(tt.script...)
+			created, updated, err := New(client).upsertByName(context.Background(), 3, run, milk, nil)
+			if err != nil {
+				t.Fatalf("upsertByName() error = %v", err)
+			}
+			if created != tt.wantCreated || updated != tt.wantUpdated {
+				t.Errorf("upsertByName() = %v, %v, want %v, %v", created, updated, tt.wantCreated, tt.wantUpdated)
+			}
+			if got := d.writes(); !reflect.DeepEqual(got, tt.wantWrites) {
+				t.Errorf("writes = %v, want %v", got, tt.wantWrites)
+			}
+		})
+	}
+}
+
+func TestUpsertItems(t *testing.T) {
+	run := Run{ID: 9, StartedAt: time.Now()}
+	milk := ItemParams{Name: "Milk", Brand: "Natrel", Price: money.New(529, "CAD"), ExternalID: "B-1"}
+	cream := ItemParams{Name: "Cream", Brand: "Natrel", Price: money.New(399, "CAD"), ExternalID: "B-2"}
+
+	tests := []struct {
+		name       string
+		items      []ItemParams
+		script     []fakeRows
+		want       UpsertResult
+		wantWrites []string
+	}{
+		{
+			name:  "new items",
+			items: []ItemParams{milk, cream},
+			script: []fakeRows{
+				itemRows(),
+				itemRows(),
+				itemRows(stored(1, "Milk", 529, "B-1", true), stored(2, "Cream", 399, "B-2", true)),
+			},
+			want:       UpsertResult{Created: 2},
+			wantWrites: []string{"INSERT INTO `items`", "INSERT INTO `price_observations`"},
+		},
+		{
+			name:  "repeated external id counts once",
+			items: []ItemParams{milk, milk},
+			script: []fakeRows{
+				itemRows(),
+				itemRows(),
+				itemRows(stored(1, "Milk", 529, "B-1", true)),
+			},
+			want:       UpsertResult{Created: 1},
+			wantWrites: []string{"INSERT INTO `items`", "INSERT INTO `price_observations`"},
+		},
+		{
+			name:  "changed and unchanged items",
+			items: []ItemParams{milk, cream},
+			script: []fakeRows{
+				itemRows(),
+				itemRows(stored(1, "Milk", 499, "B-1", true), stored(2, "Cream", 399, "B-2", true)),
+				itemRows(stored(1, "Milk", 529, "B-1", true)),
+			},
+			want:       UpsertResult{Updated: 1},
+			wantWrites: []string{"INSERT INTO `items`", "INSERT INTO `price_observations`"},
+		},
+		{
+			name:  "unchanged items",
+			items: []ItemParams{milk},
+			script: []fakeRows{
+				itemRows(),
+				itemRows(stored(1, "Milk", 529, "B-1", true)),
+			},
+			wantWrites: []string{"INSERT INTO `items`"},
+		},
+		{
+			name:  "legacy item adopted",
+			items: []ItemParams{milk},
+			script: []fakeRows{
+				itemRows(stored(5, "Milk", 529, "", true)),
+				itemRows(),
+				itemRows(stored(5, "Milk", 529, "B-1", true)),
+				itemRows(stored(5, "Milk", 529, "B-1", true)),
+			},
+			wantWrites: []string{"UPDATE `items`", "INSERT INTO `items`"},
+		},
+		{
+			name:  "item without external id",
+			items: []ItemParams{{Name: "Bananas", Brand: "Dole", Price: money.New(99, "CAD")}},
+			script: []fakeRows{
+				itemRows(),
+			},
+			want:       UpsertResult{Created: 1},
+			wantWrites: []string{"INSERT INTO `items`", "INSERT INTO `price_observations`"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, d := newFakeClient(tt.script...)
+			got, err := New(client).UpsertItems(context.Background(), 3, run, tt.items)
+			if err != nil {
+				t.Fatalf("UpsertItems() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UpsertItems() = %+v, want %+v", got, tt.want)
+			}
+			if got := d.writes(); !reflect.DeepEqual(got, tt.wantWrites) {
+				t.Errorf("writes = %v, want %v", got, tt.wantWrites)
+			}
+			for _, s := range d.find("INSERT INTO `items`") {
+				if strings.Contains(s.query, "VALUES(") {
+					t.Errorf("upsert uses the deprecated VALUES() function: %s", s.query)
+				}
+			}
+		})
+	}
+}
+
+// jobRows answers an import job query with running jobs of the given ids.
+func jobRows(owner string, ids ...int) fakeRows {
+	rows := fakeRows{columns: []string{"id", "status", "owner"}}
+	for _, id := range ids {
+		rows.values = append(rows.values, []any{int64(id), "running", owner})
+	}
+	return rows
+}
+
+func TestClaimNextJob(t *testing.T) {
+	tests := []struct {
+		name       string
+		script     []fakeRows
+		affected   []int64
+		wantID     int
+		wantClaims int
+	}{
+		{
+			name:       "claims the oldest job",
+			script:     []fakeRows{jobRows("", 5), jobRows("queue-a", 5)},
+			wantID:     5,
+			wantClaims: 1,
+		},
+		{
+			name:       "moves on when another queue claims first",
+			script:     []fakeRows{jobRows("", 5), jobRows("", 6), jobRows("queue-a", 6)},
+			affected:   []int64{0, 1},
+			wantID:     6,
+			wantClaims: 2,
+		},
+		{
+			name: "nothing claimable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, d := newFakeClient(tt.script...)
+			d.affected = tt.affected
+			job, err := New(client).ClaimNextJob(context.Background(), "queue-a", time.Minute)
+			if tt.wantID == 0 {
+				if !ent.IsNotFound(err) {
+					t.Fatalf("ClaimNextJob() error = %v, want not found", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ClaimNextJob() error = %v", err)
+			}
+			if job.ID != tt.wantID || job.Owner != "queue-a" {
+				t.Errorf("ClaimNextJob() = job %d owned by %q, want job %d owned by queue-a", job.ID, job.Owner, tt.wantID)
+			}
+
+			claims := d.find("UPDATE `import_jobs`")
+			if len(claims) != tt.wantClaims {
+				t.Fatalf("sent %d claims, want %d", len(claims), tt.wantClaims)
+			}
+			// A claim only lands on a job that is still claimable, so two
+			// queues cannot both take it.
+			for _, claim := range claims {
+				if !strings.Contains(claim.query, "`status` = ?") || !strings.Contains(claim.query, "`lease_expires_at` < ?") {
+					t.Errorf("claim %q does not check the job is claimable", claim.query)
+				}
+			}
+		})
+	}
+}
+
+func TestJobLease(t *testing.T) {
+	ctx := context.Background()
+	outcome := JobOutcome{Status: "succeeded", RunID: 9}
+
+	tests := []struct {
+		name     string
+		affected int64
+		call     func(s *importerStore) error
+	}{
+		{"renew", 1, func(s *importerStore) error {
+			return s.RenewJobLease(ctx, 5, "queue-a", time.Minute)
+		}},
+		{"finish", 1, func(s *importerStore) error {
+			_, err := s.FinishJob(ctx, 5, "queue-a", outcome)
+			return err
+		}},
+		{"release", 1, func(s *importerStore) error {
+			return s.ReleaseJob(ctx, 5, "queue-a", JobOutcome{Error: "import interrupted", RunID: 9})
+		}},
+		{"renew lost lease", 0, func(s *importerStore) error {
+			return s.RenewJobLease(ctx, 5, "queue-a", time.Minute)
+		}},
+		{"finish lost lease", 0, func(s *importerStore) error {
+			_, err := s.FinishJob(ctx, 5, "queue-a", outcome)
+			return err
+		}},
+		{"release lost lease", 0, func(s *importerStore) error {
+			return s.ReleaseJob(ctx, 5, "queue-a", JobOutcome{Error: "import interrupted"})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The job is read back after it is updated. When no row was
+			// updated, the store asks whether the job is still held, and
+			// it is not.
+			rows := jobRows("queue-a", 5)
+			if tt.affected == 0 {
+				rows = fakeRows{columns: []string{"exists"}, values: [][]any{{false}}}
+			}
+			client, d := newFakeClient(rows)
+			d.affected = []int64{tt.affected}
+
+			err := tt.call(New(client))
+			if lost := tt.affected == 0; lost != ent.IsNotFound(err) || (!lost && err != nil) {
+				t.Fatalf("error = %v, want not found %v", err, lost)
+			}
+
+			// Only the queue holding the job may touch it.
+			updates := d.find("UPDATE `import_jobs`")
+			if len(updates) == 0 {
+				t.Fatal("sent no update")
+			}
+			update := updates[0]
+			if !strings.Contains(update.query, "`owner` = ?") || !strings.Contains(update.query, "`status` = ?") {
+				t.Errorf("update %q does not check the job's owner and status", update.query)
+			}
+		})
+	}
+}
+
+func TestDelistMissingItems(t *testing.T) {
+	run := Run{ID: 9, StartedAt: time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)}
+	client, d := newFakeClient()
+	d.affected = []int64{2}
+
+	n, err := New(client).DelistMissingItems(context.Background(), 3, run)
+	if err != nil {
+		t.Fatalf("DelistMissingItems() error = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("DelistMissingItems() = %d, want 2", n)
+	}
+
+	// Only the store's available items the run did not see are delisted,
+	// and they are kept with the time they were delisted.
+	updates := d.find("UPDATE `items`")
+	if len(updates) != 1 {
+		t.Fatalf("sent %d updates, want 1", len(updates))
+	}
+	wantQuery := "UPDATE `items` SET `update_time` = ?, `available` = ?, `delisted_at` = ? " +
+		"WHERE (EXISTS (SELECT `stores`.`id` FROM `stores` WHERE `items`.`store_items` = `stores`.`id` AND `stores`.`id` = ?) " +
+		"AND `items`.`available`) AND (`items`.`last_seen_at` IS NULL OR `items`.`last_seen_at` < ?)"
+	if update := updates[0]; update.query != wantQuery {
+		t.Errorf("query =\n%s\nwant\n%s", update.query, wantQuery)
+	} else if args := update.args; args[1] != false || args[3] != 3 || args[4] != run.StartedAt {
+		t.Errorf("args = %v, want available false, store 3 and the run's start", args)
+	}
+	if writes := d.writes(); len(writes) != 1 {
+		t.Errorf("writes = %v, want only the update", writes)
+	}
+}
@@ -0,0 +1,65 @@
+// Package dietary names the dietary and sourcing attributes items can carry,
+// such as organic or gluten-free, and recognizes them in product names.
+package dietary
+
+import (
+	"regexp"
+
+	"offgrocery-assessment/internal/category/taxonomy"
+)
+
+// Attribute slugs the importers assign.
+const (
+	Organic    = "organic"
+	Vegan      = "vegan"
+	Vegetarian = "vegetarian"
+	GlutenFree = "gluten-free"
+	DairyFree  = "dairy-free"
+)
+
+var names = map[string]string{
+	Organic:    "Organic",
+	Vegan:      "Vegan",
+	Vegetarian: "Vegetarian",
+	GlutenFree: "Gluten-free",
+	DairyFree:  "Dairy-free",
+}
+
+// claims match the wording product names use to claim an attribute.
+var claims = []struct {
+	slug    string
+	pattern *regexp.Regexp
+}{
+	{Organic, regexp.MustCompile(`(?i)\borganic\b`)},
+	{Vegan, regexp.MustCompile(`(?i)\bvegan\b`)},
+	{Vegetarian, regexp.MustCompile(`(?i)\bvegetarian\b`)},
+	{GlutenFree, regexp.MustCompile(`(?i)\bgluten[- ]?free\b`)},
+	{DairyFree, regexp.MustCompile(`(?i)\bdairy[- ]?free\b`)},
+}
+
+// Slug derives an attribute slug from a label, e.g. "Gluten Free" becomes
+// "gluten-free".
+func Slug(label string) string {
+	return taxonomy.Slug(label)
+}
+
+// Name returns the display name of the attribute with the given slug.
+// Attributes admins invent are named after their slug.
+func Name(slug string) string {
+	if name, ok := names[slug]; ok {
+		return name
+	}
+	return taxonomy.Name(slug)
+}
+
+// Detect returns the attributes a product name claims, e.g. "Organic Gluten
+// Free Oats" is organic and gluten-free.
+func Detect(name string) []string {
+	var slugs []string
+	for _, c := range claims {
+		if c.pattern.MatchString(name) {
+			slugs = append(slugs, c.slug)
+		}
+	}
+	return slugs
+}
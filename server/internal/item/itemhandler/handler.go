@@ -1,36 +1,107 @@
 package itemhandler
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"offgrocery-assessment/internal/ent"
+	entstore "offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
 	"offgrocery-assessment/internal/httputil"
 	"offgrocery-assessment/internal/item/itemservice"
+	"offgrocery-assessment/internal/item/itemstore"
+	"offgrocery-assessment/internal/money"
 )
 
 type Handler interface {
 	Routes() chi.Router
 	GetItem(w http.ResponseWriter, r *http.Request)
+	GetItemByExternalID(w http.ResponseWriter, r *http.Request)
 	SearchWithLimit(w http.ResponseWriter, r *http.Request)
+	GetPriceHistory(w http.ResponseWriter, r *http.Request)
+	SetAttributes(w http.ResponseWriter, r *http.Request)
 }
 
 type handler struct {
-	service itemservice.Service
+	service  itemservice.Service
+	exchange exchangeservice.Service
+	// admin guards the routes that change items by hand.
+	admin func(http.Handler) http.Handler
 }
 
-func New(service itemservice.Service) *handler {
-	return &handler{service: service}
+func New(service itemservice.Service, exchange exchangeservice.Service, admin func(http.Handler) http.Handler) *handler {
+	return &handler{service: service, exchange: exchange, admin: admin}
 }
 
 func (h *handler) Routes() chi.Router {
 	r := chi.NewRouter()
 	r.Get("/search", h.SearchWithLimit)
+	r.Get("/external/{externalID}", h.GetItemByExternalID)
 	r.Get("/{id}", h.GetItem)
+	r.Get("/{id}/prices", h.GetPriceHistory)
+	r.With(h.admin).Put("/{id}/attributes", h.SetAttributes)
 	return r
 }
 
+// Search page sizes, when the request gives none and at most.
+const (
+	defaultSearchCount = 20
+	maxSearchCount     = 100
+)
+
+// searchResponse is a page of search results. Next and Prev link to the
+// pages after and before it, and are null at either end of the results.
+type searchResponse struct {
+	Items []itemResponse `json:"items"`
+	Total int            `json:"total"`
+	Next  *string        `json:"next"`
+	Prev  *string        `json:"prev"`
+}
+
+type setAttributesRequest struct {
+	Attributes []string `json:"attributes"`
+}
+
+// itemResponse is an item with its prices converted to the display currency,
+// when one was requested. The original price stays in price_amount and
+// currency. UnitPrice is unit_price_micros rounded to the currency's minor
+// unit.
+type itemResponse struct {
+	*ent.Item
+	UnitPrice        *money.Money `json:"unit_price,omitempty"`
+	DisplayPrice     *money.Money `json:"display_price,omitempty"`
+	DisplayUnitPrice *money.Money `json:"display_unit_price,omitempty"`
+	// Score is the relevance of a search result to the search's q.
+	Score *float64 `json:"score,omitempty"`
+}
+
+func newItemResponse(it *ent.Item, converter *exchangeservice.Converter) itemResponse {
+	resp := itemResponse{Item: it}
+	if display, ok := converter.Convert(money.New(it.PriceAmount, it.Currency)); ok {
+		resp.DisplayPrice = &display
+	}
+	if it.UnitPriceMicros != nil {
+		unitPrice := money.FromMicros(*it.UnitPriceMicros, it.Currency)
+		resp.UnitPrice = &unitPrice
+		if display, ok := converter.Convert(unitPrice); ok {
+			resp.DisplayUnitPrice = &display
+		}
+	}
+	return resp
+}
+
+// priceResponse is a price observation with its price converted to the
+// display currency, when one was requested.
+type priceResponse struct {
+	*ent.PriceObservation
+	DisplayPrice *money.Money `json:"display_price,omitempty"`
+}
+
 func (h *handler) GetItem(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.Atoi(idStr)
@@ -39,6 +110,11 @@ func (h *handler) GetItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	converter, ok := httputil.DisplayConverter(w, r, h.exchange)
+	if !ok {
+		return
+	}
+
 	item, err := h.service.GetItemByID(r.Context(), id)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -49,31 +125,304 @@ func (h *handler) GetItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httputil.WriteJSON(w, http.StatusOK, item)
+	httputil.WriteJSON(w, http.StatusOK, newItemResponse(item, converter))
 }
 
-func (h *handler) SearchWithLimit(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query().Get("q")
-	if query == "" {
-		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "q query param is required"})
+func (h *handler) GetItemByExternalID(w http.ResponseWriter, r *http.Request) {
+	externalID := chi.URLParam(r, "externalID")
+
+	storeIDStr := r.URL.Query().Get("store_id")
+	if storeIDStr == "" {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "store_id query param is required"})
 		return
 	}
 
-	limit := 20
+	storeID, err := strconv.Atoi(storeIDStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid store_id"})
+		return
+	}
+
+	converter, ok := httputil.DisplayConverter(w, r, h.exchange)
+	if !ok {
+		return
+	}
+
+	item, err := h.service.GetItemByExternalID(r.Context(), storeID, externalID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "item not found"})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to get item"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, newItemResponse(item, converter))
+}
+
+// itemFilter reads an item filter from the query params of a request:
+// store_id, grocer, brand, min_price and max_price in minor units (499 for
+// 4.99) of the currency chosen by priceScale, available as true, false or
+// any, category, and attr, repeated or comma-separated.
+// Only available items match unless available or include_unavailable says
+// otherwise. It writes an error response and returns false if a param is
+// invalid.
+func itemFilter(w http.ResponseWriter, r *http.Request) (itemstore.Filter, bool) {
+	params := r.URL.Query()
+	filter := itemstore.Filter{
+		Brand:    strings.TrimSpace(params.Get("brand")),
+		Category: params.Get("category"),
+	}
+
+	if storeIDStr := params.Get("store_id"); storeIDStr != "" {
+		storeID, err := strconv.Atoi(storeIDStr)
+		if err != nil || storeID < 1 {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid store_id"})
+			return itemstore.Filter{}, false
+		}
+		filter.StoreID = storeID
+	}
+
+	if grocerStr := params.Get("grocer"); grocerStr != "" {
+		grocer := entstore.Grocer(grocerStr)
+		if err := entstore.GrocerValidator(grocer); err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid grocer"})
+			return itemstore.Filter{}, false
+		}
+		filter.Grocer = grocer
+	}
+
+	bounds := []struct {
+		param string
+		price *int64
+	}{
+		{"min_price", &filter.MinPrice},
+		{"max_price", &filter.MaxPrice},
+	}
+	for _, bound := range bounds {
+		if priceStr := params.Get(bound.param); priceStr != "" {
+			price, err := strconv.ParseInt(priceStr, 10, 64)
+			if err != nil || price < 1 {
+				httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid " + bound.param})
+				return itemstore.Filter{}, false
+			}
+			*bound.price = price
+		}
+	}
+	if filter.MinPrice != 0 && filter.MaxPrice != 0 && filter.MinPrice > filter.MaxPrice {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "min_price must not exceed max_price"})
+		return itemstore.Filter{}, false
+	}
+
+	available := params.Get("available")
+	if available == "" && params.Get("include_unavailable") == "true" {
+		available = "any"
+	}
+	switch available {
+	case "", "true", "false":
+		listed := available != "false"
+		filter.Available = &listed
+	case "any":
+	default:
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid available"})
+		return itemstore.Filter{}, false
+	}
+
+	for _, attr := range params["attr"] {
+		for _, slug := range strings.Split(attr, ",") {
+			if slug = strings.TrimSpace(slug); slug != "" {
+				filter.Attributes = append(filter.Attributes, slug)
+			}
+		}
+	}
+	return filter, true
+}
+
+// priceScale returns how price bounds and price sorts compare prices: in the
+// display currency, or the base currency of the rates when there is none.
+func priceScale(converter *exchangeservice.Converter, rates *money.Rates) itemstore.PriceScale {
+	if converter == nil {
+		return itemstore.PriceScale{Currency: rates.Base(), Rates: rates}
+	}
+	return itemstore.PriceScale{Currency: converter.Currency, Rates: rates}
+}
+
+// SearchWithLimit returns a page of items matching the q query param and the
+// filter read by itemFilter. Without q it browses every item matching the
+// filter. sort orders results by relevance, price, unit_price, name or
+// newest, and order as asc or desc. Price bounds and price sorts compare
+// prices converted to the currency chosen by priceScale. count sets the page
+// size, up to maxSearchCount, and cursor, taken from the next or prev link of a previous
+// page, selects the page.
+func (h *handler) SearchWithLimit(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+
+	limit := defaultSearchCount
 	if countStr := r.URL.Query().Get("count"); countStr != "" {
 		parsed, err := strconv.Atoi(countStr)
 		if err != nil || parsed < 1 {
 			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid count"})
 			return
 		}
-		limit = parsed
+		limit = min(parsed, maxSearchCount)
 	}
 
-	items, err := h.service.SearchWithLimit(r.Context(), query, limit)
+	filter, ok := itemFilter(w, r)
+	if !ok {
+		return
+	}
+	opts := itemstore.SearchOptions{Filter: filter}
+	if sortStr := r.URL.Query().Get("sort"); sortStr != "" {
+		sort, err := itemstore.ParseSearchSort(sortStr)
+		if err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid sort"})
+			return
+		}
+		opts.Sort = sort
+	}
+	if orderStr := r.URL.Query().Get("order"); orderStr != "" {
+		order, err := itemstore.ParseSortOrder(orderStr)
+		if err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid order"})
+			return
+		}
+		opts.Order = order
+	}
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		cursor, err := itemstore.DecodeSearchCursor(cursorStr)
+		if err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid cursor"})
+			return
+		}
+		opts.Cursor = cursor
+	}
+
+	converter, ok := httputil.DisplayConverter(w, r, h.exchange)
+	if !ok {
+		return
+	}
+	if filter.MinPrice != 0 || filter.MaxPrice != 0 || opts.Sort == itemstore.SortPrice || opts.Sort == itemstore.SortUnitPrice {
+		rates, err := h.exchange.Rates(r.Context())
+		if err != nil {
+			httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to get exchange rates"})
+			return
+		}
+		opts.Prices = priceScale(converter, rates)
+	}
+
+	page, err := h.service.SearchWithLimit(r.Context(), query, limit, opts)
 	if err != nil {
+		if errors.Is(err, itemstore.ErrInvalidCursor) {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "cursor does not match sort"})
+			return
+		}
 		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to search items"})
 		return
 	}
 
-	httputil.WriteJSON(w, http.StatusOK, items)
+	resp := searchResponse{
+		Items: make([]itemResponse, len(page.Hits)),
+		Total: page.Total,
+		Next:  pageLink(r, page.Next),
+		Prev:  pageLink(r, page.Prev),
+	}
+	for i, hit := range page.Hits {
+		resp.Items[i] = newItemResponse(hit.Item, converter)
+		resp.Items[i].Score = hit.Score
+	}
+	httputil.WriteJSON(w, http.StatusOK, resp)
+}
+
+// pageLink returns the URL of the request with its cursor query param set to
+// cursor, or nil if there is no cursor.
+func pageLink(r *http.Request, cursor *itemstore.SearchCursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	query := r.URL.Query()
+	query.Set("cursor", cursor.Encode())
+	link := r.URL.Path + "?" + query.Encode()
+	return &link
+}
+
+// GetPriceHistory returns an item's price observations. The optional from and
+// to query params bound the range and take RFC 3339 timestamps.
+func (h *handler) GetPriceHistory(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid item id"})
+		return
+	}
+
+	var from, to time.Time
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		if from, err = time.Parse(time.RFC3339, fromStr); err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid from, expected RFC 3339"})
+			return
+		}
+	}
+	if toStr := r.URL.Query().Get("to"); toStr != "" {
+		if to, err = time.Parse(time.RFC3339, toStr); err != nil {
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid to, expected RFC 3339"})
+			return
+		}
+	}
+
+	converter, ok := httputil.DisplayConverter(w, r, h.exchange)
+	if !ok {
+		return
+	}
+
+	prices, err := h.service.GetPriceHistory(r.Context(), id, from, to)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "item not found"})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to get price history"})
+		return
+	}
+
+	resp := make([]priceResponse, len(prices))
+	for i, p := range prices {
+		resp[i] = priceResponse{PriceObservation: p}
+		if display, ok := converter.Convert(money.New(p.PriceAmount, p.Currency)); ok {
+			resp[i].DisplayPrice = &display
+		}
+	}
+	httputil.WriteJSON(w, http.StatusOK, resp)
+}
+
+// SetAttributes replaces an item's attributes. Imports leave the item's
+// attributes alone from then on. The route requires the admin token.
+func (h *handler) SetAttributes(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid item id"})
+		return
+	}
+
+	var req setAttributesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	item, err := h.service.SetAttributes(r.Context(), id, req.Attributes)
+	if err != nil {
+		switch {
+		case errors.Is(err, itemservice.ErrInvalidAttribute):
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid attribute"})
+		case ent.IsNotFound(err):
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "item not found"})
+		default:
+			httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to set item attributes"})
+		}
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, newItemResponse(item, nil))
 }
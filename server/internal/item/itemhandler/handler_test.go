@@ -0,0 +1,189 @@
+package itemhandler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
+	"offgrocery-assessment/internal/httputil"
+	"offgrocery-assessment/internal/item/itemservice"
+	"offgrocery-assessment/internal/item/itemstore"
+	"offgrocery-assessment/internal/money"
+)
+
+// fakeService serves items by store and external id, and price history for
+// item 1, recording the arguments it was called with.
+type fakeService struct {
+	itemservice.Service
+	externalIDs map[int]string
+
+	gotStoreID    int
+	gotExternalID string
+	gotFrom       time.Time
+	gotTo         time.Time
+}
+
+func (f *fakeService) GetItemByExternalID(_ context.Context, storeID int, externalID string) (*ent.Item, error) {
+	f.gotStoreID, f.gotExternalID = storeID, externalID
+	if f.externalIDs[storeID] != externalID {
+		return nil, &ent.NotFoundError{}
+	}
+	return &ent.Item{ID: 1, ExternalID: &externalID, PriceAmount: 499, Currency: "CAD"}, nil
+}
+
+func (f *fakeService) GetPriceHistory(_ context.Context, itemID int, from, to time.Time) ([]*ent.PriceObservation, error) {
+	f.gotFrom, f.gotTo = from, to
+	if itemID != 1 {
+		return nil, &ent.NotFoundError{}
+	}
+	return []*ent.PriceObservation{{ID: 1, PriceAmount: 499, Currency: "CAD"}}, nil
+}
+
+// newTestRoutes returns the item routes over svc. Display prices are never
+// requested, so the exchange service needs no store.
+func newTestRoutes(svc itemservice.Service) http.Handler {
+	return New(svc, exchangeservice.New(nil), httputil.RequireAdmin("secret")).Routes()
+}
+
+func TestItemFilter(t *testing.T) {
+	listed, delisted := true, false
+
+	tests := []struct {
+		name       string
+		query      string
+		want       itemstore.Filter
+		wantStatus int
+	}{
+		{"defaults to available", "", itemstore.Filter{Available: &listed}, 0},
+		{"store and brand", "store_id=3&brand=+Neilson+", itemstore.Filter{StoreID: 3, Brand: "Neilson", Available: &listed}, 0},
+		{"grocer", "grocer=store_b", itemstore.Filter{Grocer: "store_b", Available: &listed}, 0},
+		{"price range", "min_price=199&max_price=499", itemstore.Filter{MinPrice: 199, MaxPrice: 499, Available: &listed}, 0},
+		{"equal price bounds", "min_price=499&max_price=499", itemstore.Filter{MinPrice: 499, MaxPrice: 499, Available: &listed}, 0},
+		{"unavailable", "available=false", itemstore.Filter{Available: &delisted}, 0},
+		{"any availability", "available=any", itemstore.Filter{}, 0},
+		{"include unavailable", "include_unavailable=true", itemstore.Filter{}, 0},
+		{"available wins over include unavailable", "available=true&include_unavailable=true", itemstore.Filter{Available: &listed}, 0},
+		{"category and attributes", "category=dairy&attr=organic,+vegan&attr=local", itemstore.Filter{Category: "dairy", Attributes: []string{"organic", "vegan", "local"}, Available: &listed}, 0},
+		{"invalid store id", "store_id=0", itemstore.Filter{}, http.StatusBadRequest},
+		{"invalid grocer", "grocer=store_z", itemstore.Filter{}, http.StatusBadRequest},
+		{"decimal price", "min_price=4.99", itemstore.Filter{}, http.StatusBadRequest},
+		{"zero price", "max_price=0", itemstore.Filter{}, http.StatusBadRequest},
+		{"inverted price range", "min_price=500&max_price=499", itemstore.Filter{}, http.StatusBadRequest},
+		{"invalid availability", "available=maybe", itemstore.Filter{}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/search?"+tt.query, nil)
+
+			got, ok := itemFilter(w, r)
+			if tt.wantStatus != 0 {
+				if ok || w.Code != tt.wantStatus {
+					t.Errorf("itemFilter() ok = %v, status %d, want status %d", ok, w.Code, tt.wantStatus)
+				}
+				return
+			}
+			if !ok || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("itemFilter() = %+v, %v, want %+v", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceScale(t *testing.T) {
+	rates := money.NewRates("CAD", map[string]float64{"USD": 0.75})
+
+	tests := []struct {
+		name      string
+		converter *exchangeservice.Converter
+		rates     *money.Rates
+		want      string
+	}{
+		{"display currency", &exchangeservice.Converter{Currency: "USD"}, rates, "USD"},
+		{"base without display currency", nil, rates, "CAD"},
+		{"base of other table", nil, money.NewRates("EUR", nil), "EUR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := priceScale(tt.converter, tt.rates)
+			if got.Currency != tt.want || got.Rates != tt.rates {
+				t.Errorf("priceScale() = %+v, want currency %s with the given rates", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetItemByExternalID(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		wantStatus     int
+		wantStoreID    int
+		wantExternalID string
+	}{
+		{"found", "/external/SKU-1?store_id=2", http.StatusOK, 2, "SKU-1"},
+		{"escaped id", "/external/A%20B?store_id=2", http.StatusNotFound, 2, "A B"},
+		{"other store", "/external/SKU-1?store_id=3", http.StatusNotFound, 3, "SKU-1"},
+		{"missing store", "/external/SKU-1", http.StatusBadRequest, 0, ""},
+		{"invalid store", "/external/SKU-1?store_id=two", http.StatusBadRequest, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{externalIDs: map[int]string{2: "SKU-1"}}
+			w := httptest.NewRecorder()
+			newTestRoutes(svc).ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, tt.wantStatus)
+			}
+			if svc.gotStoreID != tt.wantStoreID || svc.gotExternalID != tt.wantExternalID {
+				t.Errorf("GET %s looked up store %d id %q, want store %d id %q",
+					tt.path, svc.gotStoreID, svc.gotExternalID, tt.wantStoreID, tt.wantExternalID)
+			}
+		})
+	}
+}
+
+func TestGetPriceHistory(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 12, 30, 0, 0, time.FixedZone("", -5*60*60))
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantFrom   time.Time
+		wantTo     time.Time
+	}{
+		{"whole history", "/1/prices", http.StatusOK, time.Time{}, time.Time{}},
+		{"from only", "/1/prices?from=2026-01-01T00:00:00Z", http.StatusOK, from, time.Time{}},
+		{"range with offset", "/1/prices?from=2026-01-01T00:00:00Z&to=2026-02-01T12:30:00-05:00", http.StatusOK, from, to},
+		{"unknown item", "/2/prices", http.StatusNotFound, time.Time{}, time.Time{}},
+		{"invalid item", "/one/prices", http.StatusBadRequest, time.Time{}, time.Time{}},
+		{"date without time", "/1/prices?from=2026-01-01", http.StatusBadRequest, time.Time{}, time.Time{}},
+		{"invalid to", "/1/prices?to=tomorrow", http.StatusBadRequest, time.Time{}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{}
+			w := httptest.NewRecorder()
+			newTestRoutes(svc).ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("GET %s status = %d, want %d", tt.path, w.Code, tt.wantStatus)
+			}
+			if !svc.gotFrom.Equal(tt.wantFrom) || !svc.gotTo.Equal(tt.wantTo) {
+				t.Errorf("GET %s asked for %v to %v, want %v to %v", tt.path, svc.gotFrom, svc.gotTo, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
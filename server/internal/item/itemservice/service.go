@@ -2,14 +2,25 @@ package itemservice
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/item/dietary"
 	"offgrocery-assessment/internal/item/itemstore"
 )
 
+// ErrInvalidAttribute is returned by SetAttributes for a label that has no
+// letters or digits to derive a slug from.
+var ErrInvalidAttribute = errors.New("invalid attribute")
+
 type Service interface {
 	GetItemByID(ctx context.Context, id int) (*ent.Item, error)
-	SearchWithLimit(ctx context.Context, query string, limit int) ([]*ent.Item, error)
+	GetItemByExternalID(ctx context.Context, storeID int, externalID string) (*ent.Item, error)
+	SearchWithLimit(ctx context.Context, query string, limit int, opts itemstore.SearchOptions) (*itemstore.SearchPage, error)
+	GetPriceHistory(ctx context.Context, itemID int, from, to time.Time) ([]*ent.PriceObservation, error)
+	SetAttributes(ctx context.Context, itemID int, labels []string) (*ent.Item, error)
 }
 
 type service struct {
@@ -24,6 +35,57 @@ func (s *service) GetItemByID(ctx context.Context, id int) (*ent.Item, error) {
 	return s.store.GetItemByID(ctx, id)
 }
 
-func (s *service) SearchWithLimit(ctx context.Context, query string, limit int) ([]*ent.Item, error) {
-	return s.store.SearchWithLimit(ctx, query, limit)
+func (s *service) GetItemByExternalID(ctx context.Context, storeID int, externalID string) (*ent.Item, error) {
+	return s.store.GetItemByExternalID(ctx, storeID, externalID)
+}
+
+func (s *service) SearchWithLimit(ctx context.Context, query string, limit int, opts itemstore.SearchOptions) (*itemstore.SearchPage, error) {
+	return s.store.SearchWithLimit(ctx, query, limit, opts)
+}
+
+// GetPriceHistory returns the price observations of an item within the given
+// range. It returns a not found error if the item does not exist.
+func (s *service) GetPriceHistory(ctx context.Context, itemID int, from, to time.Time) ([]*ent.PriceObservation, error) {
+	if _, err := s.store.GetItemByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+	return s.store.GetPriceHistory(ctx, itemID, from, to)
+}
+
+// SetAttributes replaces an item's attributes with the ones labelled, e.g.
+// "organic" or "Gluten Free", creating attributes that do not exist yet. The
+// item's attributes are pinned, so imports no longer change them. It returns
+// a not found error if the item does not exist.
+func (s *service) SetAttributes(ctx context.Context, itemID int, labels []string) (*ent.Item, error) {
+	if _, err := s.store.GetItemByID(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	var slugs []string
+	for _, label := range labels {
+		slug := dietary.Slug(label)
+		if slug == "" {
+			return nil, ErrInvalidAttribute
+		}
+		if !slices.Contains(slugs, slug) {
+			slugs = append(slugs, slug)
+		}
+	}
+
+	ids := make([]int, len(slugs))
+	for i, slug := range slugs {
+		attributeRecord, err := s.store.GetAttributeBySlug(ctx, slug)
+		if ent.IsNotFound(err) {
+			attributeRecord, err = s.store.CreateAttribute(ctx, slug, dietary.Name(slug))
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = attributeRecord.ID
+	}
+
+	if err := s.store.SetItemAttributes(ctx, itemID, ids); err != nil {
+		return nil, err
+	}
+	return s.store.GetItemByID(ctx, itemID)
 }
@@ -0,0 +1,104 @@
+package itemstore
+
+import (
+	"offgrocery-assessment/internal/ent/attribute"
+	"offgrocery-assessment/internal/ent/category"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/predicate"
+	entstore "offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Filter narrows an item query by the item's own fields and edges. Zero
+// fields leave the query unfiltered, so the zero Filter matches every item.
+type Filter struct {
+	// StoreID restricts results to the store with this id.
+	StoreID int
+	// Grocer restricts results to items from this grocer's stores.
+	Grocer entstore.Grocer
+	// Brand restricts results to this brand, ignoring case.
+	Brand string
+	// MinPrice and MaxPrice bound the price, in minor units of the
+	// Prices currency, so 499 is 4.99. Zero leaves that end of the range
+	// open. Items priced in a currency without a rate are out of any range.
+	MinPrice int64
+	MaxPrice int64
+	// Prices converts item prices for MinPrice and MaxPrice, and for price
+	// sorts.
+	Prices PriceScale
+	// Available restricts results to items that are, or are not, still
+	// listed by their grocer.
+	Available *bool
+	// Category restricts results to the category with this slug.
+	Category string
+	// Attributes restricts results to items carrying every attribute with
+	// these slugs.
+	Attributes []string
+}
+
+// Predicates returns the filter as predicates, for the Where clause of any
+// item query.
+func (f Filter) Predicates() []predicate.Item {
+	var preds []predicate.Item
+	if f.StoreID != 0 {
+		preds = append(preds, InStore(f.StoreID))
+	}
+	if f.Grocer != "" {
+		preds = append(preds, FromGrocer(f.Grocer))
+	}
+	if f.Brand != "" {
+		preds = append(preds, OfBrand(f.Brand))
+	}
+	if f.MinPrice != 0 {
+		preds = append(preds, f.Prices.priceBound(">=", f.MinPrice))
+	}
+	if f.MaxPrice != 0 {
+		preds = append(preds, f.Prices.priceBound("<=", f.MaxPrice))
+	}
+	if f.Available != nil {
+		preds = append(preds, item.AvailableEQ(*f.Available))
+	}
+	if f.Category != "" {
+		preds = append(preds, InCategory(f.Category))
+	}
+	for _, slug := range f.Attributes {
+		preds = append(preds, HasAttribute(slug))
+	}
+	return preds
+}
+
+// priceBound matches items whose converted price compares to amount, in
+// minor units of the scale's currency.
+func (s PriceScale) priceBound(op string, amount int64) predicate.Item {
+	micros := money.New(amount, s.currency()).Micros()
+	return func(sel *sql.Selector) {
+		sel.Where(s.priceKey().compare(op, micros))
+	}
+}
+
+// InStore matches items sold by the store with the given id.
+func InStore(storeID int) predicate.Item {
+	return item.HasStoreWith(entstore.IDEQ(storeID))
+}
+
+// FromGrocer matches items sold by any of a grocer's stores.
+func FromGrocer(grocer entstore.Grocer) predicate.Item {
+	return item.HasStoreWith(entstore.GrocerEQ(grocer))
+}
+
+// OfBrand matches items of a brand, ignoring case.
+func OfBrand(brand string) predicate.Item {
+	return item.BrandEqualFold(brand)
+}
+
+// InCategory matches items in the category with the given slug.
+func InCategory(slug string) predicate.Item {
+	return item.HasCategoryWith(category.SlugEQ(slug))
+}
+
+// HasAttribute matches items carrying the attribute with the given slug.
+func HasAttribute(slug string) predicate.Item {
+	return item.HasAttributesWith(attribute.SlugEQ(slug))
+}
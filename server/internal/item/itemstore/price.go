@@ -0,0 +1,66 @@
+package itemstore
+
+import (
+	"fmt"
+	"strings"
+
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/money"
+)
+
+// PriceScale converts prices in different currencies to one, so they
+// compare. Without rates, or a rate for Currency, only prices already in
+// Currency convert, and the zero PriceScale compares prices in the default
+// currency.
+type PriceScale struct {
+	// Currency is the currency prices are compared in. Empty is the default
+	// currency.
+	Currency string
+	// Rates converts prices to Currency.
+	Rates *money.Rates `json:"-"`
+}
+
+// currency returns the currency the scale compares prices in.
+func (s PriceScale) currency() string {
+	if s.Currency == "" {
+		return money.DefaultCurrency
+	}
+	return s.Currency
+}
+
+// priceKey returns the expression of an item's price in micros of the
+// scale's currency.
+func (s PriceScale) priceKey() searchKey {
+	return s.key(item.FieldPriceAmount, func(currency string) float64 {
+		return float64(money.New(1, currency).Micros())
+	})
+}
+
+// unitPriceKey returns the expression of an item's unit price in micros of
+// the scale's currency.
+func (s PriceScale) unitPriceKey() searchKey {
+	return s.key(item.FieldUnitPriceMicros, func(string) float64 { return 1 })
+}
+
+// key returns the expression converting column, in units of the item's
+// currency worth micros(currency) micros, to whole micros of the scale's
+// currency. It is NULL for items in a currency without a rate.
+func (s PriceScale) key(column string, micros func(currency string) float64) searchKey {
+	to := s.currency()
+	rates := s.Rates
+	if rates == nil || !rates.Has(to) {
+		rates = money.NewRates(to, nil)
+	}
+
+	var cases strings.Builder
+	var args []any
+	for _, currency := range rates.Currencies() {
+		rate, _ := rates.Rate(currency, to)
+		cases.WriteString(" WHEN ? THEN ?")
+		args = append(args, currency, micros(currency)*rate)
+	}
+	return searchKey{
+		expr: fmt.Sprintf("ROUND(%s * CASE %s%s END)", column, item.FieldCurrency, cases.String()),
+		args: args,
+	}
+}
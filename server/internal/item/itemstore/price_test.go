@@ -0,0 +1,99 @@
+package itemstore
+
+import (
+	"reflect"
+	"testing"
+
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/money"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestPriceScaleKey(t *testing.T) {
+	// One CAD buys 0.5 USD or 100 JPY, so 1000 JPY, 5.00 USD and 10.00 CAD
+	// are all the same price.
+	rates := money.NewRates("CAD", map[string]float64{"USD": 0.5, "JPY": 100})
+
+	tests := []struct {
+		name     string
+		scale    PriceScale
+		unit     bool
+		wantExpr string
+		wantArgs []any
+	}{
+		{"price in base", PriceScale{Currency: "CAD", Rates: rates}, false,
+			"ROUND(price_amount * CASE currency WHEN ? THEN ? WHEN ? THEN ? WHEN ? THEN ? END)",
+			[]any{"CAD", 10000.0, "JPY", 10000.0, "USD", 20000.0}},
+		{"price in quoted currency", PriceScale{Currency: "USD", Rates: rates}, false,
+			"ROUND(price_amount * CASE currency WHEN ? THEN ? WHEN ? THEN ? WHEN ? THEN ? END)",
+			[]any{"CAD", 5000.0, "JPY", 5000.0, "USD", 10000.0}},
+		{"unit price", PriceScale{Currency: "CAD", Rates: rates}, true,
+			"ROUND(unit_price_micros * CASE currency WHEN ? THEN ? WHEN ? THEN ? WHEN ? THEN ? END)",
+			[]any{"CAD", 1.0, "JPY", 0.01, "USD", 2.0}},
+		{"currency without rate", PriceScale{Currency: "EUR", Rates: rates}, false,
+			"ROUND(price_amount * CASE currency WHEN ? THEN ? END)",
+			[]any{"EUR", 10000.0}},
+		{"no rates", PriceScale{Currency: "USD"}, false,
+			"ROUND(price_amount * CASE currency WHEN ? THEN ? END)",
+			[]any{"USD", 10000.0}},
+		{"zero scale", PriceScale{}, false,
+			"ROUND(price_amount * CASE currency WHEN ? THEN ? END)",
+			[]any{"CAD", 10000.0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.scale.priceKey()
+			if tt.unit {
+				got = tt.scale.unitPriceKey()
+			}
+			if got.expr != tt.wantExpr || !reflect.DeepEqual(got.args, tt.wantArgs) {
+				t.Errorf("key = %q %v, want %q %v", got.expr, got.args, tt.wantExpr, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPriceBounds(t *testing.T) {
+	rates := money.NewRates("CAD", map[string]float64{"USD": 0.5, "JPY": 100})
+	key := "ROUND(price_amount * CASE currency WHEN ? THEN ? WHEN ? THEN ? WHEN ? THEN ? END)"
+	cadArgs := []any{"CAD", 10000.0, "JPY", 10000.0, "USD", 20000.0}
+
+	tests := []struct {
+		name      string
+		filter    Filter
+		wantWhere string
+		wantArgs  []any
+	}{
+		{"no bounds", Filter{Prices: PriceScale{Currency: "CAD", Rates: rates}}, "", nil},
+		{"min price", Filter{MinPrice: 500, Prices: PriceScale{Currency: "CAD", Rates: rates}},
+			key + " >= ?", append(append([]any{}, cadArgs...), int64(5000000))},
+		{"max price", Filter{MaxPrice: 1000, Prices: PriceScale{Currency: "CAD", Rates: rates}},
+			key + " <= ?", append(append([]any{}, cadArgs...), int64(10000000))},
+		{"range", Filter{MinPrice: 500, MaxPrice: 1000, Prices: PriceScale{Currency: "CAD", Rates: rates}},
+			key + " >= ? AND " + key + " <= ?",
+			append(append(append(append([]any{}, cadArgs...), int64(5000000)), cadArgs...), int64(10000000))},
+		{"zero decimal currency", Filter{MaxPrice: 1000, Prices: PriceScale{Currency: "JPY", Rates: rates}},
+			key + " <= ?", []any{"CAD", 1000000.0, "JPY", 1000000.0, "USD", 2000000.0, int64(1000000000)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel := sql.Dialect(dialect.MySQL).Select("*").From(sql.Table(item.Table))
+			for _, p := range tt.filter.Predicates() {
+				p(sel)
+			}
+			query, args := sel.Query()
+
+			want := "SELECT * FROM `items`"
+			if tt.wantWhere != "" {
+				want += " WHERE " + tt.wantWhere
+			}
+			if query != want || !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("query = %q %v, want %q %v", query, args, want, tt.wantArgs)
+			}
+		})
+	}
+}
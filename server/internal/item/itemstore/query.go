@@ -0,0 +1,111 @@
+package itemstore
+
+import (
+	"strings"
+	"unicode"
+)
+
+// searchTerm is a word or quoted phrase of a search query.
+type searchTerm struct {
+	words   []string
+	phrase  bool
+	exclude bool
+}
+
+// booleanQuery turns free-text search input into a MySQL FULLTEXT boolean
+// mode expression. Every word is required and matches as a prefix, so
+// "whole whe" finds "Whole Wheat Bread". Double-quoted phrases must appear as
+// written, and a leading minus excludes a word or phrase. Only letters,
+// digits, underscores and inner apostrophes are kept from the input, so it
+// cannot inject FULLTEXT operators. It returns an empty string when the input
+// requires nothing, as MySQL matches no rows for an expression that only
+// excludes.
+func booleanQuery(input string) string {
+	var parts []string
+	required := false
+	for _, t := range parseSearch(input) {
+		switch {
+		case t.exclude && len(t.words) == 1 && !t.phrase:
+			parts = append(parts, "-"+t.words[0])
+		case t.exclude:
+			parts = append(parts, `-"`+strings.Join(t.words, " ")+`"`)
+		case t.phrase:
+			parts = append(parts, `+"`+strings.Join(t.words, " ")+`"`)
+			required = true
+		default:
+			for _, w := range t.words {
+				parts = append(parts, "+"+w+"*")
+			}
+			required = true
+		}
+	}
+
+	if !required {
+		return ""
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseSearch splits search input into words and quoted phrases, noting
+// which ones are excluded. A word holding punctuation, such as "whole-wheat",
+// becomes several words, and an unterminated quote runs to the end of the
+// input.
+func parseSearch(input string) []searchTerm {
+	var terms []searchTerm
+	rs := []rune(input)
+	for i := 0; i < len(rs); {
+		if unicode.IsSpace(rs[i]) {
+			i++
+			continue
+		}
+
+		exclude := false
+		for i < len(rs) && (rs[i] == '-' || rs[i] == '+') {
+			if rs[i] == '-' {
+				exclude = true
+			}
+			i++
+		}
+		if i == len(rs) || unicode.IsSpace(rs[i]) {
+			continue
+		}
+
+		t := searchTerm{exclude: exclude}
+		if rs[i] == '"' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '"' {
+				end++
+			}
+			t.phrase = true
+			t.words = searchWords(string(rs[i+1 : end]))
+			i = end + 1
+		} else {
+			start := i
+			for i < len(rs) && !unicode.IsSpace(rs[i]) && rs[i] != '"' {
+				i++
+			}
+			t.words = searchWords(string(rs[start:i]))
+		}
+
+		if len(t.words) > 0 {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// searchWords splits text into lower-case words of letters, digits,
+// underscores and the apostrophes inside them.
+func searchWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '\''
+	})
+
+	words := fields[:0]
+	for _, f := range fields {
+		if f = strings.Trim(f, "'"); f != "" {
+			words = append(words, f)
+		}
+	}
+	return words
+}
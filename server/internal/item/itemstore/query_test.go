@@ -0,0 +1,47 @@
+package itemstore
+
+import "testing"
+
+func TestBooleanQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"single word", "milk", "+milk*"},
+		{"every word required", "whole wheat", "+whole* +wheat*"},
+		{"lower-cased", "Whole WHEAT", "+whole* +wheat*"},
+		{"extra whitespace", "  whole \t wheat  ", "+whole* +wheat*"},
+		{"empty", "", ""},
+		{"only whitespace", "   ", ""},
+		{"phrase", `"whole wheat" bread`, `+"whole wheat" +bread*`},
+		{"single word phrase", `"milk"`, `+"milk"`},
+		{"unterminated phrase", `bread "whole wheat`, `+bread* +"whole wheat"`},
+		{"empty phrase", `"" milk`, "+milk*"},
+		{"exclusion", "milk -chocolate", "+milk* -chocolate"},
+		{"excluded phrase", `milk -"skim milk"`, `+milk* -"skim milk"`},
+		{"excluded punctuated word", "bread -whole-wheat", `+bread* -"whole wheat"`},
+		{"only exclusions", "-chocolate", ""},
+		{"lone minus", "milk - chocolate", "+milk* +chocolate*"},
+		{"leading plus", "+milk", "+milk*"},
+		{"mixed signs", "+-chocolate milk", "-chocolate +milk*"},
+		{"hyphenated word", "whole-wheat", "+whole* +wheat*"},
+		{"percent", "2% milk", "+2* +milk*"},
+		{"apostrophe kept inside word", "president's choice", "+president's* +choice*"},
+		{"apostrophes trimmed", "'milk'", "+milk*"},
+		{"operators stripped", "milk* (bread) >eggs <butter ~jam", "+milk* +bread* +eggs* +butter* +jam*"},
+		{"at sign stripped", `"whole wheat"@3`, `+"whole wheat" +3*`},
+		{"quote inside word", `whole"wheat"`, `+whole* +"wheat"`},
+		{"only operators", `+-*()<>~@"`, ""},
+		{"unicode letters", "Crème Fraîche", "+crème* +fraîche*"},
+		{"underscore kept", "foo_bar", "+foo_bar*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := booleanQuery(tt.input); got != tt.want {
+				t.Errorf("booleanQuery(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package itemstore
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/item"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ErrInvalidCursor is returned by SearchWithLimit for a cursor that was not
+// issued for the search's sort order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// SearchSort is the key search results are sorted by. Ties are broken by
+// item id.
+type SearchSort string
+
+const (
+	// SortRelevance sorts by full-text relevance score, highest first by
+	// default. Browsed results all score zero, so they are in id order.
+	SortRelevance SearchSort = "relevance"
+	// SortPrice sorts by price converted by Filter.Prices, cheapest
+	// first by default. Items in a currency without a rate come last.
+	SortPrice SearchSort = "price"
+	// SortUnitPrice sorts by unit price like SortPrice. Items without a unit
+	// price are left out.
+	SortUnitPrice SearchSort = "unit_price"
+	// SortName sorts by name, A to Z by default.
+	SortName SearchSort = "name"
+	// SortNewest sorts by when the item was first imported, newest first by
+	// default.
+	SortNewest SearchSort = "newest"
+)
+
+// ParseSearchSort returns the sort named s.
+func ParseSearchSort(s string) (SearchSort, error) {
+	switch sort := SearchSort(s); sort {
+	case SortRelevance, SortPrice, SortUnitPrice, SortName, SortNewest:
+		return sort, nil
+	default:
+		return "", fmt.Errorf("unknown sort %q", s)
+	}
+}
+
+// SortOrder is the direction of a sort.
+type SortOrder string
+
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// ParseSortOrder returns the sort order named s.
+func ParseSortOrder(s string) (SortOrder, error) {
+	switch order := SortOrder(s); order {
+	case Ascending, Descending:
+		return order, nil
+	default:
+		return "", fmt.Errorf("unknown sort order %q", s)
+	}
+}
+
+// SearchOptions narrows and orders a search beyond its query text.
+type SearchOptions struct {
+	Filter
+	// Sort orders the results. Empty sorts by relevance.
+	Sort SearchSort
+	// Order is the direction of the sort. Empty uses the sort's default.
+	Order SortOrder
+	// Cursor selects the page of results. Nil selects the first page.
+	Cursor *SearchCursor
+}
+
+// sort returns the options' sort key and whether it runs descending.
+func (o SearchOptions) sort() (SearchSort, bool) {
+	sort := o.Sort
+	if sort == "" {
+		sort = SortRelevance
+	}
+	if o.Order != "" {
+		return sort, o.Order == Descending
+	}
+	return sort, sort == SortRelevance || sort == SortNewest
+}
+
+// SearchCursor marks a position in search results: the sort key and id of
+// the result it follows or precedes.
+type SearchCursor struct {
+	Sort SearchSort `json:"o"`
+	Desc bool       `json:"d,omitempty"`
+	Key  any        `json:"k"`
+	ID   int        `json:"i"`
+	// Search identifies the search the cursor was issued for, as returned by
+	// searchHash.
+	Search string `json:"s"`
+	// Before selects the page before the position rather than the one after.
+	Before bool `json:"b,omitempty"`
+}
+
+// Encode returns the cursor as an opaque URL-safe token.
+func (c SearchCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeSearchCursor parses a token returned by SearchCursor.Encode.
+func DecodeSearchCursor(token string) (*SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("decoding cursor: %w", err)
+	}
+	var c SearchCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("decoding cursor: %w", err)
+	}
+	return &c, nil
+}
+
+// searchHash identifies a search by its query text and filter, including
+// the price currency, so a cursor is only used with the search it was issued
+// for.
+func searchHash(query string, filter Filter) string {
+	data, _ := json.Marshal(struct {
+		Query  string
+		Filter Filter
+	}{query, filter})
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// keyArg returns the cursor's sort key as an SQL argument. It returns
+// ErrInvalidCursor if the cursor was issued for another sort or another
+// search.
+func (c *SearchCursor) keyArg(sort SearchSort, desc bool, search string) (any, error) {
+	if c.Sort != sort || c.Desc != desc || c.Search != search {
+		return nil, ErrInvalidCursor
+	}
+
+	switch sort {
+	case SortRelevance:
+		if score, ok := c.Key.(float64); ok {
+			return score, nil
+		}
+	case SortPrice, SortUnitPrice:
+		if price, ok := c.Key.(float64); ok && price == math.Trunc(price) {
+			return int64(price), nil
+		}
+	case SortName:
+		if name, ok := c.Key.(string); ok {
+			return name, nil
+		}
+	case SortNewest:
+		if s, ok := c.Key.(string); ok {
+			if created, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return created, nil
+			}
+		}
+	}
+	return nil, ErrInvalidCursor
+}
+
+// SearchHit is an item found by a search.
+type SearchHit struct {
+	Item *ent.Item
+	// Score is the item's full-text relevance score. It is nil for browsed
+	// results.
+	Score *float64
+	// price is the converted price or unit price the item was sorted by.
+	price int64
+}
+
+// SearchPage is a page of search results.
+type SearchPage struct {
+	Hits []SearchHit
+	// Total is the number of results across every page.
+	Total int
+	// Next and Prev select the pages after and before this one. They are nil
+	// at either end of the results.
+	Next *SearchCursor
+	Prev *SearchCursor
+}
+
+// matchExpr scores an item's name and brand against a boolean mode
+// expression.
+const matchExpr = "MATCH(name, brand) AGAINST(? IN BOOLEAN MODE)"
+
+// scoreExpr is the relevance score results are sorted by: matchExpr rounded
+// to fixed precision, so cursors hold the score exactly.
+const scoreExpr = "ROUND(" + matchExpr + ", 6)"
+
+// unpricedKey is the converted price of items in a currency without a rate
+// when sorting cheapest first, past any real price, so they come last.
+const unpricedKey = 1 << 53
+
+// searchKey is an SQL expression search results are sorted by, with its
+// arguments.
+type searchKey struct {
+	expr string
+	args []any
+}
+
+// sortKey returns the expression of a sort's key. score is the relevance
+// score expression, and prices converts price keys.
+func sortKey(sort SearchSort, desc bool, score searchKey, prices PriceScale) searchKey {
+	switch sort {
+	case SortPrice:
+		return prices.priceKey().orLast(desc)
+	case SortUnitPrice:
+		return prices.unitPriceKey().orLast(desc)
+	case SortName:
+		return searchKey{expr: item.FieldName}
+	case SortNewest:
+		return searchKey{expr: item.FieldCreateTime}
+	default:
+		return score
+	}
+}
+
+// orLast replaces a NULL price key with one that sorts after every price.
+func (k searchKey) orLast(desc bool) searchKey {
+	last := unpricedKey
+	if desc {
+		last = -1
+	}
+	return searchKey{expr: "COALESCE(" + k.expr + ", ?)", args: append(append([]any{}, k.args...), last)}
+}
+
+// selector returns the key as a selectable expression.
+func (k searchKey) selector() sql.Querier {
+	return sql.ExprP(k.expr, k.args...)
+}
+
+// compare returns the predicate comparing the key to a value.
+func (k searchKey) compare(op string, value any) *sql.Predicate {
+	args := append(append([]any{}, k.args...), value)
+	return sql.ExprP(k.expr+" "+op+" ?", args...)
+}
+
+// hitCursor returns the cursor marking a result's position in the sort.
+func hitCursor(hit SearchHit, sort SearchSort, desc, before bool, search string) *SearchCursor {
+	c := &SearchCursor{Sort: sort, Desc: desc, ID: hit.Item.ID, Search: search, Before: before}
+	switch sort {
+	case SortPrice, SortUnitPrice:
+		c.Key = hit.price
+	case SortName:
+		c.Key = hit.Item.Name
+	case SortNewest:
+		c.Key = hit.Item.CreateTime.UTC().Format(time.RFC3339Nano)
+	default:
+		var score float64
+		if hit.Score != nil {
+			score = *hit.Score
+		}
+		c.Key = score
+	}
+	return c
+}
+
+// selectedNumber returns a number SearchWithLimit selected for an item under
+// the name column.
+func selectedNumber(it *ent.Item, column string) (float64, error) {
+	v, err := it.Value(column)
+	if err != nil {
+		return 0, err
+	}
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case int64:
+		return float64(n), nil
+	case []byte:
+		return strconv.ParseFloat(string(n), 64)
+	case string:
+		return strconv.ParseFloat(n, 64)
+	default:
+		return 0, fmt.Errorf("unexpected %s type %T", column, v)
+	}
+}
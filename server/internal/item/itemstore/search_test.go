@@ -0,0 +1,178 @@
+package itemstore
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/money"
+)
+
+func TestKeyArg(t *testing.T) {
+	created := time.Date(2026, 3, 14, 15, 9, 26, 535897000, time.UTC)
+
+	tests := []struct {
+		name    string
+		cursor  SearchCursor
+		sort    SearchSort
+		desc    bool
+		search  string
+		want    any
+		wantErr bool
+	}{
+		{"relevance", SearchCursor{Sort: SortRelevance, Desc: true, Key: 1.5, Search: "milk"}, SortRelevance, true, "milk", 1.5, false},
+		{"price", SearchCursor{Sort: SortPrice, Key: float64(5290000), Search: "milk"}, SortPrice, false, "milk", int64(5290000), false},
+		{"unit price", SearchCursor{Sort: SortUnitPrice, Key: float64(1165198), Search: "milk"}, SortUnitPrice, false, "milk", int64(1165198), false},
+		{"name", SearchCursor{Sort: SortName, Key: "Milk", Search: "milk"}, SortName, false, "milk", "Milk", false},
+		{"newest", SearchCursor{Sort: SortNewest, Desc: true, Key: created.Format(time.RFC3339Nano), Search: "milk"}, SortNewest, true, "milk", created, false},
+		{"other sort", SearchCursor{Sort: SortName, Key: "Milk", Search: "milk"}, SortPrice, false, "milk", nil, true},
+		{"other order", SearchCursor{Sort: SortName, Desc: true, Key: "Milk", Search: "milk"}, SortName, false, "milk", nil, true},
+		{"other search", SearchCursor{Sort: SortPrice, Key: float64(5290000), Search: "bread"}, SortPrice, false, "milk", nil, true},
+		{"no search", SearchCursor{Sort: SortName, Key: "Milk"}, SortName, false, "milk", nil, true},
+		{"fractional price", SearchCursor{Sort: SortPrice, Key: 5.29, Search: "milk"}, SortPrice, false, "milk", nil, true},
+		{"price of wrong type", SearchCursor{Sort: SortPrice, Key: "529", Search: "milk"}, SortPrice, false, "milk", nil, true},
+		{"name of wrong type", SearchCursor{Sort: SortName, Key: float64(1), Search: "milk"}, SortName, false, "milk", nil, true},
+		{"bad time", SearchCursor{Sort: SortNewest, Desc: true, Key: "yesterday", Search: "milk"}, SortNewest, true, "milk", nil, true},
+		{"missing key", SearchCursor{Sort: SortRelevance, Desc: true, Search: "milk"}, SortRelevance, true, "milk", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cursor.keyArg(tt.sort, tt.desc, tt.search)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCursor) {
+					t.Errorf("keyArg() error = %v, want %v", err, ErrInvalidCursor)
+				}
+				return
+			}
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keyArg() = %#v, %v, want %#v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHitCursor(t *testing.T) {
+	created := time.Date(2026, 3, 14, 15, 9, 26, 535897000, time.FixedZone("EDT", -4*60*60))
+	unitPrice := int64(1165198)
+	score := 2.25
+	it := &ent.Item{
+		ID:              42,
+		Name:            "Cheddar",
+		PriceAmount:     529,
+		Currency:        "CAD",
+		UnitPriceMicros: &unitPrice,
+		CreateTime:      created,
+	}
+
+	tests := []struct {
+		name   string
+		hit    SearchHit
+		sort   SearchSort
+		desc   bool
+		before bool
+		want   any
+	}{
+		{"relevance", SearchHit{Item: it, Score: &score}, SortRelevance, true, false, 2.25},
+		{"relevance without score", SearchHit{Item: it}, SortRelevance, true, false, 0.0},
+		{"price", SearchHit{Item: it, price: 3967500}, SortPrice, false, false, int64(3967500)},
+		{"unit price", SearchHit{Item: it, price: 873899}, SortUnitPrice, true, true, int64(873899)},
+		{"unpriced", SearchHit{Item: it, price: unpricedKey}, SortPrice, false, false, int64(unpricedKey)},
+		{"name", SearchHit{Item: it}, SortName, false, true, "Cheddar"},
+		{"newest", SearchHit{Item: it}, SortNewest, true, false, created},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := hitCursor(tt.hit, tt.sort, tt.desc, tt.before, "milk")
+			if c.ID != it.ID || c.Sort != tt.sort || c.Desc != tt.desc || c.Before != tt.before || c.Search != "milk" {
+				t.Errorf("hitCursor() = %+v, want id %d, sort %s, desc %v, before %v, search milk", c, it.ID, tt.sort, tt.desc, tt.before)
+			}
+
+			// The cursor's key survives the trip through its token back to
+			// the value the search compares against.
+			decoded, err := DecodeSearchCursor(c.Encode())
+			if err != nil {
+				t.Fatalf("DecodeSearchCursor: %v", err)
+			}
+			got, err := decoded.keyArg(tt.sort, tt.desc, "milk")
+			if err != nil {
+				t.Fatalf("keyArg: %v", err)
+			}
+			if want, ok := tt.want.(time.Time); ok {
+				if gotTime, ok := got.(time.Time); !ok || !gotTime.Equal(want) {
+					t.Errorf("key = %#v, want %v", got, want)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("key = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchHash(t *testing.T) {
+	listed := true
+	base := Filter{Brand: "Neilson", Available: &listed, Prices: PriceScale{Currency: "CAD"}}
+
+	tests := []struct {
+		name   string
+		query  string
+		filter func(f Filter) Filter
+		same   bool
+	}{
+		{"same search", "milk", func(f Filter) Filter { return f }, true},
+		{"same rates", "milk", func(f Filter) Filter {
+			f.Prices.Rates = money.NewRates("CAD", map[string]float64{"USD": 0.75})
+			return f
+		}, true},
+		{"other query", "bread", func(f Filter) Filter { return f }, false},
+		{"other brand", "milk", func(f Filter) Filter { f.Brand = "Lactantia"; return f }, false},
+		{"other availability", "milk", func(f Filter) Filter { f.Available = nil; return f }, false},
+		{"other price bound", "milk", func(f Filter) Filter { f.MaxPrice = 500; return f }, false},
+		{"other price currency", "milk", func(f Filter) Filter { f.Prices.Currency = "USD"; return f }, false},
+		{"other attributes", "milk", func(f Filter) Filter { f.Attributes = []string{"organic"}; return f }, false},
+	}
+
+	want := searchHash("milk", base)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := searchHash(tt.query, tt.filter(base))
+			if (got == want) != tt.same {
+				t.Errorf("searchHash() = %q, base search %q, want same = %v", got, want, tt.same)
+			}
+		})
+	}
+}
+
+func TestSortKey(t *testing.T) {
+	score := searchKey{expr: scoreExpr, args: []any{"+milk*"}}
+	prices := PriceScale{Currency: "CAD"}
+
+	tests := []struct {
+		name     string
+		sort     SearchSort
+		desc     bool
+		wantExpr string
+		wantArgs []any
+	}{
+		{"relevance", SortRelevance, true, "ROUND(MATCH(name, brand) AGAINST(? IN BOOLEAN MODE), 6)", []any{"+milk*"}},
+		{"cheapest first", SortPrice, false, "COALESCE(ROUND(price_amount * CASE currency WHEN ? THEN ? END), ?)", []any{"CAD", 10000.0, unpricedKey}},
+		{"dearest first", SortPrice, true, "COALESCE(ROUND(price_amount * CASE currency WHEN ? THEN ? END), ?)", []any{"CAD", 10000.0, -1}},
+		{"unit price", SortUnitPrice, false, "COALESCE(ROUND(unit_price_micros * CASE currency WHEN ? THEN ? END), ?)", []any{"CAD", 1.0, unpricedKey}},
+		{"name", SortName, false, "name", nil},
+		{"newest", SortNewest, true, "create_time", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortKey(tt.sort, tt.desc, score, prices)
+			if got.expr != tt.wantExpr || !reflect.DeepEqual(got.args, tt.wantArgs) {
+				t.Errorf("sortKey() = %q %v, want %q %v", got.expr, got.args, tt.wantExpr, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -2,16 +2,28 @@ package itemstore
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/attribute"
 	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/priceobservation"
+	entstore "offgrocery-assessment/internal/ent/store"
 
 	"entgo.io/ent/dialect/sql"
 )
 
 type Store interface {
 	GetItemByID(ctx context.Context, id int) (*ent.Item, error)
-	SearchWithLimit(ctx context.Context, query string, limit int) ([]*ent.Item, error)
+	GetItemByExternalID(ctx context.Context, storeID int, externalID string) (*ent.Item, error)
+	SearchWithLimit(ctx context.Context, query string, limit int, opts SearchOptions) (*SearchPage, error)
+	GetPriceHistory(ctx context.Context, itemID int, from, to time.Time) ([]*ent.PriceObservation, error)
+	GetAttributeBySlug(ctx context.Context, slug string) (*ent.Attribute, error)
+	CreateAttribute(ctx context.Context, slug, name string) (*ent.Attribute, error)
+	SetItemAttributes(ctx context.Context, itemID int, attributeIDs []int) error
 }
 
 type store struct {
@@ -26,20 +38,208 @@ func (s *store) GetItemByID(ctx context.Context, id int) (*ent.Item, error) {
 	return s.client.Item.Query().
 		Where(item.IDEQ(id)).
 		WithStore().
+		WithAttributes().
 		First(ctx)
 }
 
-func (s *store) SearchWithLimit(ctx context.Context, query string, limit int) ([]*ent.Item, error) {
+func (s *store) GetItemByExternalID(ctx context.Context, storeID int, externalID string) (*ent.Item, error) {
 	return s.client.Item.Query().
-		Where(func(sel *sql.Selector) {
-			sel.Where(
-				sql.ExprP(
-					"MATCH(name, brand) AGAINST(? IN BOOLEAN MODE)",
-					"+"+query+"*",
-				),
-			)
-		}).
+		Where(
+			item.ExternalIDEQ(externalID),
+			item.HasStoreWith(entstore.IDEQ(storeID)),
+		).
 		WithStore().
-		Limit(limit).
+		WithAttributes().
+		Only(ctx)
+}
+
+// SearchWithLimit runs a full-text search over item names and brands, with
+// the query parsed by booleanQuery, and returns a page of at most limit
+// results matching opts.Filter. A blank query browses every item matching
+// the filter instead, while input that requires no words matches nothing.
+// Results are sorted as opts says, then by id, and opts.Cursor selects the
+// page.
+func (s *store) SearchWithLimit(ctx context.Context, query string, limit int, opts SearchOptions) (*SearchPage, error) {
+	var expr string
+	score := searchKey{expr: "0"}
+	if strings.TrimSpace(query) != "" {
+		if expr = booleanQuery(query); expr == "" {
+			return &SearchPage{Hits: []SearchHit{}}, nil
+		}
+		score = searchKey{expr: scoreExpr, args: []any{expr}}
+	}
+
+	sort, desc := opts.sort()
+	key := sortKey(sort, desc, score, opts.Prices)
+	search := searchHash(query, opts.Filter)
+	cursor := opts.Cursor
+	before := cursor != nil && cursor.Before
+	var cursorKey any
+	if cursor != nil {
+		var err error
+		if cursorKey, err = cursor.keyArg(sort, desc, search); err != nil {
+			return nil, err
+		}
+	}
+
+	total, err := s.searchQuery(expr, sort, opts).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting results: %w", err)
+	}
+
+	// Paging backwards runs the sort in reverse and flips the page after.
+	keyDesc, idDesc := desc, false
+	if before {
+		keyDesc, idDesc = !keyDesc, true
+	}
+
+	q := s.searchQuery(expr, sort, opts)
+	if cursor != nil {
+		// Keyset condition on (key, id): results after the cursor come later
+		// in the sort, or tie with a higher id.
+		q = q.Where(func(sel *sql.Selector) {
+			keyOp, idOp := ">", sql.GT(sel.C(item.FieldID), cursor.ID)
+			if keyDesc {
+				keyOp = "<"
+			}
+			if idDesc {
+				idOp = sql.LT(sel.C(item.FieldID), cursor.ID)
+			}
+			sel.Where(sql.Or(
+				key.compare(keyOp, cursorKey),
+				sql.And(key.compare("=", cursorKey), idOp),
+			))
+		})
+	}
+	q = q.Order(func(sel *sql.Selector) {
+		sel.AppendSelectExprAs(score.selector(), "score")
+		if sort == SortPrice || sort == SortUnitPrice {
+			sel.AppendSelectExprAs(key.selector(), "price_key")
+		}
+		keyOrder, idOrder := "ASC", sql.Asc(sel.C(item.FieldID))
+		if keyDesc {
+			keyOrder = "DESC"
+		}
+		if idDesc {
+			idOrder = sql.Desc(sel.C(item.FieldID))
+		}
+		// Selected keys are sorted by their alias, so they are computed once.
+		orderBy := key.expr
+		switch sort {
+		case SortRelevance:
+			orderBy = "score"
+		case SortPrice, SortUnitPrice:
+			orderBy = "price_key"
+		}
+		sel.OrderExpr(sql.Expr(orderBy + " " + keyOrder))
+		sel.OrderBy(idOrder)
+	})
+
+	// One extra result tells whether there is another page in the direction
+	// of travel.
+	items, err := q.
+		WithStore().
+		WithCategory().
+		WithAttributes().
+		Limit(limit + 1).
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	more := len(items) > limit
+	if more {
+		items = items[:limit]
+	}
+	if before {
+		slices.Reverse(items)
+	}
+
+	page := &SearchPage{Hits: make([]SearchHit, len(items)), Total: total}
+	for i, it := range items {
+		page.Hits[i].Item = it
+		if sort == SortPrice || sort == SortUnitPrice {
+			price, err := selectedNumber(it, "price_key")
+			if err != nil {
+				return nil, err
+			}
+			page.Hits[i].price = int64(price)
+		}
+		if expr == "" {
+			continue
+		}
+		itemScore, err := selectedNumber(it, "score")
+		if err != nil {
+			return nil, err
+		}
+		page.Hits[i].Score = &itemScore
+	}
+	if len(items) == 0 {
+		return page, nil
+	}
+
+	// The page the cursor came from lies behind it, so there is always a page
+	// back the way we came.
+	if more || before {
+		page.Next = hitCursor(page.Hits[len(page.Hits)-1], sort, desc, false, search)
+	}
+	if (more && before) || (cursor != nil && !before) {
+		page.Prev = hitCursor(page.Hits[0], sort, desc, true, search)
+	}
+	return page, nil
+}
+
+// searchQuery returns the items matching a boolean mode expression, or every
+// item if it is empty, and the search's filter. Sorting by unit price leaves
+// out items without one.
+func (s *store) searchQuery(expr string, sort SearchSort, opts SearchOptions) *ent.ItemQuery {
+	q := s.client.Item.Query().
+		Where(opts.Filter.Predicates()...)
+	if expr != "" {
+		q = q.Where(func(sel *sql.Selector) {
+			sel.Where(sql.ExprP(matchExpr, expr))
+		})
+	}
+	if sort == SortUnitPrice {
+		q = q.Where(item.UnitPriceMicrosNotNil())
+	}
+	return q
+}
+
+// GetPriceHistory returns the item's price observations, oldest first. A zero
+// from or to leaves that end of the range open.
+func (s *store) GetPriceHistory(ctx context.Context, itemID int, from, to time.Time) ([]*ent.PriceObservation, error) {
+	query := s.client.PriceObservation.Query().
+		Where(priceobservation.HasItemWith(item.IDEQ(itemID)))
+	if !from.IsZero() {
+		query = query.Where(priceobservation.ObservedAtGTE(from))
+	}
+	if !to.IsZero() {
+		query = query.Where(priceobservation.ObservedAtLTE(to))
+	}
+	return query.
+		Order(ent.Asc(priceobservation.FieldObservedAt), ent.Asc(priceobservation.FieldID)).
+		All(ctx)
+}
+
+func (s *store) GetAttributeBySlug(ctx context.Context, slug string) (*ent.Attribute, error) {
+	return s.client.Attribute.Query().
+		Where(attribute.SlugEQ(slug)).
+		Only(ctx)
+}
+
+func (s *store) CreateAttribute(ctx context.Context, slug, name string) (*ent.Attribute, error) {
+	return s.client.Attribute.Create().
+		SetSlug(slug).
+		SetName(name).
+		Save(ctx)
+}
+
+// SetItemAttributes replaces an item's attributes and pins them, so imports
+// no longer change them.
+func (s *store) SetItemAttributes(ctx context.Context, itemID int, attributeIDs []int) error {
+	return s.client.Item.UpdateOneID(itemID).
+		ClearAttributes().
+		AddAttributeIDs(attributeIDs...).
+		SetAttributesPinned(true).
+		Exec(ctx)
 }
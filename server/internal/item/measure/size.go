@@ -0,0 +1,158 @@
+// Package measure parses the package sizes grocers describe in their feeds
+// into canonical quantities, and derives unit prices from them.
+package measure
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"offgrocery-assessment/internal/money"
+)
+
+// Unit is the canonical unit a package size is expressed in.
+type Unit string
+
+const (
+	Gram       Unit = "g"
+	Millilitre Unit = "ml"
+	Count      Unit = "count"
+)
+
+// Size is a package size in canonical units. The zero Size means the size is
+// unknown.
+type Size struct {
+	Quantity float64
+	Unit     Unit
+}
+
+// IsZero reports whether the size is unknown.
+func (s Size) IsZero() bool {
+	return s.Quantity <= 0 || s.Unit == ""
+}
+
+// Grams returns a size of g grams.
+func Grams(g float64) Size {
+	return Size{Quantity: g, Unit: Gram}
+}
+
+// conversions maps each recognized unit spelling to its canonical unit and
+// the number of canonical units in one of it.
+var conversions = map[string]struct {
+	unit   Unit
+	factor float64
+}{
+	"mg":     {Gram, 0.001},
+	"g":      {Gram, 1},
+	"gr":     {Gram, 1},
+	"kg":     {Gram, 1000},
+	"oz":     {Gram, 28.349523125},
+	"lb":     {Gram, 453.59237},
+	"lbs":    {Gram, 453.59237},
+	"ml":     {Millilitre, 1},
+	"cl":     {Millilitre, 10},
+	"l":      {Millilitre, 1000},
+	"litre":  {Millilitre, 1000},
+	"liter":  {Millilitre, 1000},
+	"pack":   {Count, 1},
+	"pk":     {Count, 1},
+	"ct":     {Count, 1},
+	"count":  {Count, 1},
+	"dozen":  {Count, 12},
+	"each":   {Count, 1},
+	"ea":     {Count, 1},
+	"loaf":   {Count, 1},
+	"bunch":  {Count, 1},
+	"unit":   {Count, 1},
+	"piece":  {Count, 1},
+	"pieces": {Count, 1},
+}
+
+var (
+	// multipackPattern matches sizes like "6 x 355ml".
+	multipackPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*x\s*(\d+(?:\.\d+)?)\s*([a-z]+)\b`)
+	// quantityPattern matches sizes like "454g", "1.75 L", "12 pack" or
+	// "12-pack".
+	quantityPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)(?:\s*-\s*|\s*)([a-z]+)\b`)
+	// wordPattern matches bare unit words like "per kg" or "per dozen".
+	wordPattern = regexp.MustCompile(`[a-z]+`)
+)
+
+// Parse reads a package size with a quantity out of free text such as a
+// product name, e.g. "Large Eggs (12 pack)", "Milk 2L" or "Cola 6 x 355ml".
+// Unit words without a quantity are ignored, as names use them for other
+// things, e.g. "Pack of Gum". It reports false when the text describes no
+// size it recognizes.
+func Parse(text string) (Size, bool) {
+	text = strings.ToLower(text)
+
+	if m := multipackPattern.FindStringSubmatch(text); m != nil {
+		if size, ok := convert(m[2], m[3]); ok {
+			count, _ := strconv.ParseFloat(m[1], 64)
+			size.Quantity *= count
+			return known(size)
+		}
+	}
+
+	for _, m := range quantityPattern.FindAllStringSubmatch(text, -1) {
+		if size, ok := convert(m[1], m[2]); ok {
+			return known(size)
+		}
+	}
+
+	return Size{}, false
+}
+
+// ParseUnit reads a package size out of a feed's description of how a
+// product is sold, such as "per 2L carton", "per 454g block", "per 12-pack"
+// or "per dozen". Unlike Parse, a bare unit like "per kg" or "each" is read
+// as one of it when the text has no quantity. It reports false when the text
+// describes no size it recognizes.
+func ParseUnit(text string) (Size, bool) {
+	if size, ok := Parse(text); ok || strings.ContainsAny(text, "0123456789") {
+		return size, ok
+	}
+
+	for _, word := range wordPattern.FindAllString(strings.ToLower(text), -1) {
+		if size, ok := convert("1", word); ok {
+			return size, true
+		}
+	}
+	return Size{}, false
+}
+
+// known returns size, or the zero Size and false if size is unknown.
+func known(size Size) (Size, bool) {
+	if size.IsZero() {
+		return Size{}, false
+	}
+	return size, true
+}
+
+func convert(quantity, unit string) (Size, bool) {
+	c, ok := conversions[unit]
+	if !ok {
+		return Size{}, false
+	}
+	q, err := strconv.ParseFloat(quantity, 64)
+	if err != nil {
+		return Size{}, false
+	}
+	return Size{Quantity: q * c.factor, Unit: c.unit}, true
+}
+
+// UnitPrice returns the price per 100 g, per 100 ml or each, depending on the
+// size's unit, in micros, millionths of the price's major unit, so close unit
+// prices do not round to the same minor unit. It reports false when the size
+// is unknown.
+func UnitPrice(price money.Money, size Size) (int64, bool) {
+	if size.IsZero() {
+		return 0, false
+	}
+	per := size.Quantity
+	if size.Unit != Count {
+		per /= 100
+	}
+	return int64(math.Round(float64(price.Micros()) / per)), true
+}
@@ -0,0 +1,109 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"offgrocery-assessment/internal/money"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		want   Size
+		wantOK bool
+	}{
+		{"grams", "Cheddar 454g", Size{454, Gram}, true},
+		{"kilograms", "Flour 2.5 kg", Size{2500, Gram}, true},
+		{"pounds", "per 3 lb bag", Size{3 * 453.59237, Gram}, true},
+		{"millilitres", "Cream 473ML", Size{473, Millilitre}, true},
+		{"litres", "Milk 2L", Size{2000, Millilitre}, true},
+		{"multipack", "Cola 6 x 355ml", Size{2130, Millilitre}, true},
+		{"multipack without spaces", "Cola 12x355ml", Size{4260, Millilitre}, true},
+		{"count", "Large Eggs (12 pack)", Size{12, Count}, true},
+		{"hyphenated count", "Yogurt 12-pack", Size{12, Count}, true},
+		{"dozen", "Eggs 2 dozen", Size{24, Count}, true},
+		{"first size wins", "Juice 1L (4 pack)", Size{1000, Millilitre}, true},
+		{"unknown unit skipped", "Vitamin C 500 IU 60 ct", Size{60, Count}, true},
+		{"bare unit word", "Pack of Gum", Size{}, false},
+		{"bare loaf", "Sourdough Loaf", Size{}, false},
+		{"bare each", "Avocado each", Size{}, false},
+		{"unit inside word", "Galaxy Bar", Size{}, false},
+		{"no size", "Bananas", Size{}, false},
+		{"zero quantity", "Water 0ml", Size{}, false},
+		{"empty", "", Size{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Parse(tt.text)
+			if ok != tt.wantOK || !sameSize(got, tt.want) {
+				t.Errorf("Parse(%q) = %v, %v, want %v, %v", tt.text, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		want   Size
+		wantOK bool
+	}{
+		{"quantity", "per 2L carton", Size{2000, Millilitre}, true},
+		{"block", "per 454g block", Size{454, Gram}, true},
+		{"hyphenated count", "per 12-pack", Size{12, Count}, true},
+		{"bare kilogram", "per kg", Size{1000, Gram}, true},
+		{"bare dozen", "per dozen", Size{12, Count}, true},
+		{"bare each", "each", Size{1, Count}, true},
+		{"bare loaf", "per loaf", Size{1, Count}, true},
+		{"case ignored", "Per KG", Size{1000, Gram}, true},
+		{"zero quantity", "per 0 kg", Size{}, false},
+		{"unknown quantity unit", "per 6 cans", Size{}, false},
+		{"no unit", "per bag", Size{}, false},
+		{"empty", "", Size{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseUnit(tt.text)
+			if ok != tt.wantOK || !sameSize(got, tt.want) {
+				t.Errorf("ParseUnit(%q) = %v, %v, want %v, %v", tt.text, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+// sameSize compares sizes, allowing for float rounding in conversions.
+func sameSize(a, b Size) bool {
+	return a.Unit == b.Unit && math.Abs(a.Quantity-b.Quantity) < 1e-9
+}
+
+func TestUnitPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		price  money.Money
+		size   Size
+		want   int64
+		wantOK bool
+	}{
+		{"per 100 g", money.New(529, "CAD"), Grams(454), 1165198, true},
+		{"per 100 ml", money.New(499, "CAD"), Size{2000, Millilitre}, 249500, true},
+		{"each", money.New(529, "CAD"), Size{12, Count}, 440833, true},
+		{"finer than a cent", money.New(500, "CAD"), Grams(453), 1103753, true},
+		{"close prices differ", money.New(500, "CAD"), Grams(454), 1101322, true},
+		{"zero decimal currency", money.New(398, "JPY"), Grams(200), 199000000, true},
+		{"unknown size", money.New(529, "CAD"), Size{}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := UnitPrice(tt.price, tt.size)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("UnitPrice(%v, %v) = %d, %v, want %d, %v", tt.price, tt.size, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
 	"offgrocery-assessment/internal/httputil"
 	"offgrocery-assessment/internal/list/listservice"
+	"offgrocery-assessment/internal/money"
 )
 
 type Handler interface {
@@ -22,11 +24,12 @@ type Handler interface {
 }
 
 type handler struct {
-	service listservice.Service
+	service  listservice.Service
+	exchange exchangeservice.Service
 }
 
-func New(service listservice.Service) *handler {
-	return &handler{service: service}
+func New(service listservice.Service, exchange exchangeservice.Service) *handler {
+	return &handler{service: service, exchange: exchange}
 }
 
 func (h *handler) Routes() chi.Router {
@@ -53,6 +56,70 @@ type removeItemsRequest struct {
 	ItemIDs []int `json:"item_ids"`
 }
 
+// listResponse is a list with its items, plus the ids of the items their
+// grocer no longer stocks so clients can flag them, and the total price of
+// the items still stocked, one per currency. With a display currency, each
+// item's converted price is keyed by item id, and DisplayTotal sums the
+// items still stocked whose price could be converted.
+type listResponse struct {
+	*ent.List
+	UnavailableItemIDs []int               `json:"unavailable_item_ids"`
+	Totals             []money.Money       `json:"totals"`
+	DisplayPrices      map[int]money.Money `json:"display_prices,omitempty"`
+	DisplayTotal       *money.Money        `json:"display_total,omitempty"`
+}
+
+func newListResponse(l *ent.List, converter *exchangeservice.Converter) listResponse {
+	resp := listResponse{List: l, UnavailableItemIDs: []int{}, Totals: []money.Money{}}
+	if converter != nil {
+		resp.DisplayPrices = make(map[int]money.Money)
+		resp.DisplayTotal = &money.Money{Currency: converter.Currency}
+	}
+	for _, it := range l.Edges.Items {
+		price := money.New(it.PriceAmount, it.Currency)
+		display, converted := converter.Convert(price)
+		if converted {
+			resp.DisplayPrices[it.ID] = display
+		}
+
+		if !it.Available {
+			resp.UnavailableItemIDs = append(resp.UnavailableItemIDs, it.ID)
+			continue
+		}
+		resp.addToTotals(price)
+		if converted {
+			*resp.DisplayTotal, _ = resp.DisplayTotal.Add(display)
+		}
+	}
+	return resp
+}
+
+func (resp *listResponse) addToTotals(price money.Money) {
+	for i, total := range resp.Totals {
+		if total.Currency == price.Currency {
+			resp.Totals[i], _ = total.Add(price)
+			return
+		}
+	}
+	resp.Totals = append(resp.Totals, price)
+}
+
+// writeList writes a list, converting its prices to the currency named by
+// the currency query param, or else to the list owner's display currency.
+func (h *handler) writeList(w http.ResponseWriter, r *http.Request, l *ent.List) {
+	var ownerID int
+	if l.Edges.User != nil {
+		ownerID = l.Edges.User.ID
+	}
+
+	converter, ok := httputil.DisplayConverterFor(w, r, h.exchange, ownerID)
+	if !ok {
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, newListResponse(l, converter))
+}
+
 func (h *handler) CreateList(w http.ResponseWriter, r *http.Request) {
 	var req createListRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -114,7 +181,7 @@ func (h *handler) GetList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httputil.WriteJSON(w, http.StatusOK, list)
+	h.writeList(w, r, list)
 }
 
 func (h *handler) DeleteList(w http.ResponseWriter, r *http.Request) {
@@ -162,7 +229,7 @@ func (h *handler) AddItems(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httputil.WriteJSON(w, http.StatusOK, list)
+	h.writeList(w, r, list)
 }
 
 func (h *handler) RemoveItems(w http.ResponseWriter, r *http.Request) {
@@ -190,5 +257,5 @@ func (h *handler) RemoveItems(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httputil.WriteJSON(w, http.StatusOK, list)
+	h.writeList(w, r, list)
 }
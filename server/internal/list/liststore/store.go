@@ -42,6 +42,7 @@ func (s *store) GetListByID(ctx context.Context, id int) (*ent.List, error) {
 	return s.client.List.Query().
 		Where(list.IDEQ(id)).
 		WithItems().
+		WithUser().
 		First(ctx)
 }
 
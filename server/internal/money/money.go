@@ -0,0 +1,142 @@
+// Package money represents prices exactly, as integer amounts of a
+// currency's minor unit.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DefaultCurrency is assumed for feeds that do not state a currency.
+const DefaultCurrency = "CAD"
+
+// ErrCurrencyMismatch is returned when combining amounts in different
+// currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money is an amount in the minor unit of its currency, e.g. 529 CAD is
+// $5.29.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// zeroDecimalCurrencies have no minor unit. Every other currency is assumed
+// to have two decimal places.
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true,
+	"KRW": true,
+	"VND": true,
+	"CLP": true,
+	"ISK": true,
+}
+
+// New returns amount minor units of currency.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Exponent returns the number of decimal places of the currency's minor
+// unit.
+func Exponent(currency string) int {
+	if zeroDecimalCurrencies[strings.ToUpper(currency)] {
+		return 0
+	}
+	return 2
+}
+
+// Parse reads a decimal amount in major units, such as "5.29", exactly.
+// Digits beyond the currency's minor unit are rounded half away from zero.
+func Parse(s string, currency string) (Money, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Money{}, fmt.Errorf("invalid amount %q", s)
+	}
+
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Exponent(currency))), nil)))
+
+	// Round half away from zero: add or subtract one half, then truncate.
+	half := big.NewRat(1, 2)
+	if r.Sign() < 0 {
+		r.Sub(r, half)
+	} else {
+		r.Add(r, half)
+	}
+	amount := new(big.Int).Quo(r.Num(), r.Denom())
+	if !amount.IsInt64() {
+		return Money{}, fmt.Errorf("amount %q out of range", s)
+	}
+
+	return Money{Amount: amount.Int64(), Currency: currency}, nil
+}
+
+// ValidCurrency reports whether code looks like an ISO 4217 currency code,
+// three upper-case letters.
+func ValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns m + o. Both must be in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+// Micros returns the amount in millionths of a major unit, e.g. 5.29 CAD is
+// 5290000. Micros are finer than the minor unit of any currency, for amounts
+// such as unit prices that must not be rounded to it.
+func (m Money) Micros() int64 {
+	return m.Amount * microsPerMinor(m.Currency)
+}
+
+// FromMicros returns micros millionths of a major unit of currency, rounded
+// half away from zero to the nearest minor unit.
+func FromMicros(micros int64, currency string) Money {
+	amount := math.Round(float64(micros) / float64(microsPerMinor(currency)))
+	return Money{Amount: int64(amount), Currency: currency}
+}
+
+// microsPerMinor returns the number of micros in the currency's minor unit.
+func microsPerMinor(currency string) int64 {
+	return int64(math.Pow10(6 - Exponent(currency)))
+}
+
+// IsPositive reports whether the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// Decimal formats the amount in major units, e.g. "5.29".
+func (m Money) Decimal() string {
+	exp := Exponent(m.Currency)
+	if exp == 0 {
+		return fmt.Sprintf("%d", m.Amount)
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	scale := int64(math.Pow10(exp))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, exp, amount%scale)
+}
+
+// String formats the amount with its currency, e.g. "5.29 CAD".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
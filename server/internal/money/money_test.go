@@ -0,0 +1,109 @@
+package money
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{"two decimals", "5.29", "CAD", New(529, "CAD"), false},
+		{"whole amount", "5", "CAD", New(500, "CAD"), false},
+		{"one decimal", "7.5", "USD", New(750, "USD"), false},
+		{"surrounding whitespace", " 5.29 ", "CAD", New(529, "CAD"), false},
+		{"exact where floats are not", "0.29", "CAD", New(29, "CAD"), false},
+		{"half rounds up", "1.005", "CAD", New(101, "CAD"), false},
+		{"below half rounds down", "1.0049", "CAD", New(100, "CAD"), false},
+		{"negative half rounds away from zero", "-1.005", "CAD", New(-101, "CAD"), false},
+		{"zero decimal currency", "398", "JPY", New(398, "JPY"), false},
+		{"zero decimal currency rounds", "398.5", "JPY", New(399, "JPY"), false},
+		{"fraction", "1/4", "CAD", New(25, "CAD"), false},
+		{"zero", "0", "CAD", New(0, "CAD"), false},
+		{"not a number", "free", "CAD", Money{}, true},
+		{"empty", "", "CAD", Money{}, true},
+		{"out of range", "1e30", "CAD", Money{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input, tt.currency)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Parse(%q, %q) = %v, %v, want %v, error %v", tt.input, tt.currency, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  string
+	}{
+		{"two decimals", New(529, "CAD"), "5.29"},
+		{"leading zero cents", New(505, "CAD"), "5.05"},
+		{"under one", New(7, "CAD"), "0.07"},
+		{"zero", New(0, "CAD"), "0.00"},
+		{"negative", New(-529, "CAD"), "-5.29"},
+		{"negative under one", New(-7, "CAD"), "-0.07"},
+		{"zero decimal currency", New(398, "JPY"), "398"},
+		{"lower-case zero decimal currency", New(398, "jpy"), "398"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Decimal(); got != tt.want {
+				t.Errorf("%#v.Decimal() = %q, want %q", tt.money, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMicros(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		want  int64
+	}{
+		{"two decimals", New(529, "CAD"), 5290000},
+		{"zero decimal currency", New(398, "JPY"), 398000000},
+		{"negative", New(-1, "USD"), -10000},
+		{"zero", New(0, "CAD"), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Micros(); got != tt.want {
+				t.Errorf("%v.Micros() = %d, want %d", tt.money, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromMicros(t *testing.T) {
+	tests := []struct {
+		name     string
+		micros   int64
+		currency string
+		want     Money
+	}{
+		{"exact", 5290000, "CAD", New(529, "CAD")},
+		{"rounds to nearest", 1165198, "CAD", New(117, "CAD")},
+		{"half rounds up", 1105000, "CAD", New(111, "CAD")},
+		{"just below half", 1104999, "CAD", New(110, "CAD")},
+		{"negative half rounds away from zero", -1105000, "CAD", New(-111, "CAD")},
+		{"zero decimal currency", 199500000, "JPY", New(200, "JPY")},
+		{"zero", 0, "CAD", New(0, "CAD")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromMicros(tt.micros, tt.currency); got != tt.want {
+				t.Errorf("FromMicros(%d, %q) = %v, want %v", tt.micros, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ErrNoRate is returned when converting to or from a currency the rate table
+// does not know.
+var ErrNoRate = errors.New("no exchange rate")
+
+// Rates converts between currencies using exchange rates quoted against a
+// single base currency.
+type Rates struct {
+	base    string
+	perBase map[string]float64
+}
+
+// NewRates returns a rate table where one unit of base buys perBase[c] units
+// of currency c.
+func NewRates(base string, perBase map[string]float64) *Rates {
+	r := &Rates{base: base, perBase: make(map[string]float64, len(perBase)+1)}
+	for currency, rate := range perBase {
+		r.perBase[currency] = rate
+	}
+	r.perBase[base] = 1
+	return r
+}
+
+// Base returns the currency the rates are quoted against.
+func (r *Rates) Base() string {
+	return r.base
+}
+
+// Has reports whether the table can convert to and from currency.
+func (r *Rates) Has(currency string) bool {
+	_, ok := r.perBase[currency]
+	return ok
+}
+
+// Currencies returns the currencies the table converts between, sorted.
+func (r *Rates) Currencies() []string {
+	currencies := make([]string, 0, len(r.perBase))
+	for currency := range r.perBase {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+	return currencies
+}
+
+// Rate returns how many major units of to one major unit of from buys. It
+// reports false when the table has no rate for either currency.
+func (r *Rates) Rate(from, to string) (float64, bool) {
+	if from == to {
+		return 1, true
+	}
+	fromRate, ok := r.perBase[from]
+	if !ok {
+		return 0, false
+	}
+	toRate, ok := r.perBase[to]
+	if !ok {
+		return 0, false
+	}
+	return toRate / fromRate, true
+}
+
+// Convert returns m in the to currency, rounded half away from zero to the
+// nearest minor unit.
+func (r *Rates) Convert(m Money, to string) (Money, error) {
+	if m.Currency == to {
+		return m, nil
+	}
+	for _, currency := range []string{m.Currency, to} {
+		if !r.Has(currency) {
+			return Money{}, fmt.Errorf("%w for %s", ErrNoRate, currency)
+		}
+	}
+	rate, _ := r.Rate(m.Currency, to)
+
+	scale := math.Pow10(Exponent(to) - Exponent(m.Currency))
+	return Money{Amount: int64(math.Round(float64(m.Amount) * rate * scale)), Currency: to}, nil
+}
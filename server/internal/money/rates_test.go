@@ -0,0 +1,96 @@
+package money
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	rates := NewRates("CAD", map[string]float64{
+		"USD": 0.75,
+		"EUR": 0.68,
+		"JPY": 110,
+	})
+
+	tests := []struct {
+		name    string
+		money   Money
+		to      string
+		want    Money
+		wantErr error
+	}{
+		{"same currency", New(529, "CAD"), "CAD", New(529, "CAD"), nil},
+		{"from base", New(1000, "CAD"), "USD", New(750, "USD"), nil},
+		{"to base", New(750, "USD"), "CAD", New(1000, "CAD"), nil},
+		{"between quoted currencies", New(750, "USD"), "EUR", New(680, "EUR"), nil},
+		{"rounds half away from zero", New(2, "CAD"), "USD", New(2, "USD"), nil},
+		{"rounds negative half away from zero", New(-2, "CAD"), "USD", New(-2, "USD"), nil},
+		{"to zero decimal currency", New(529, "CAD"), "JPY", New(582, "JPY"), nil},
+		{"from zero decimal currency", New(582, "JPY"), "CAD", New(529, "CAD"), nil},
+		{"unknown source", New(100, "GBP"), "CAD", Money{}, ErrNoRate},
+		{"unknown target", New(100, "CAD"), "GBP", Money{}, ErrNoRate},
+		{"unknown same currency", New(100, "GBP"), "GBP", New(100, "GBP"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rates.Convert(tt.money, tt.to)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("Convert(%v, %q) = %v, %v, want %v, %v", tt.money, tt.to, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRate(t *testing.T) {
+	rates := NewRates("CAD", map[string]float64{
+		"USD": 0.5,
+		"EUR": 0.25,
+	})
+
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		want   float64
+		wantOK bool
+	}{
+		{"same currency", "USD", "USD", 1, true},
+		{"from base", "CAD", "USD", 0.5, true},
+		{"to base", "EUR", "CAD", 4, true},
+		{"between quoted currencies", "USD", "EUR", 0.5, true},
+		{"unknown source", "GBP", "CAD", 0, false},
+		{"unknown target", "CAD", "GBP", 0, false},
+		{"unknown same currency", "GBP", "GBP", 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := rates.Rate(tt.from, tt.to)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Rate(%q, %q) = %v, %v, want %v, %v", tt.from, tt.to, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCurrencies(t *testing.T) {
+	tests := []struct {
+		name  string
+		rates *Rates
+		want  []string
+	}{
+		{"base only", NewRates("CAD", nil), []string{"CAD"}},
+		{"sorted with base", NewRates("USD", map[string]float64{"JPY": 150, "CAD": 1.35}), []string{"CAD", "JPY", "USD"}},
+		{"base quoted against itself", NewRates("CAD", map[string]float64{"CAD": 1, "EUR": 0.68}), []string{"CAD", "EUR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rates.Currencies(); !slices.Equal(got, tt.want) {
+				t.Errorf("Currencies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
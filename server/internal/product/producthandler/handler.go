@@ -0,0 +1,209 @@
+package producthandler
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/exchange/exchangeservice"
+	"offgrocery-assessment/internal/httputil"
+	"offgrocery-assessment/internal/money"
+	"offgrocery-assessment/internal/product/productservice"
+)
+
+type Handler interface {
+	Routes() chi.Router
+	GetProduct(w http.ResponseWriter, r *http.Request)
+	Merge(w http.ResponseWriter, r *http.Request)
+	Split(w http.ResponseWriter, r *http.Request)
+}
+
+type handler struct {
+	service  productservice.Service
+	exchange exchangeservice.Service
+	// admin guards the routes that change products by hand.
+	admin func(http.Handler) http.Handler
+}
+
+func New(service productservice.Service, exchange exchangeservice.Service, admin func(http.Handler) http.Handler) *handler {
+	return &handler{service: service, exchange: exchange, admin: admin}
+}
+
+func (h *handler) Routes() chi.Router {
+	r := chi.NewRouter()
+	r.Get("/{id}", h.GetProduct)
+	r.With(h.admin).Post("/{id}/merge", h.Merge)
+	r.With(h.admin).Post("/{id}/split", h.Split)
+	return r
+}
+
+type mergeRequest struct {
+	ProductIDs []int `json:"product_ids"`
+}
+
+type splitRequest struct {
+	ItemIDs []int `json:"item_ids"`
+}
+
+// productResponse is a product with its offers' prices converted to the
+// display currency, keyed by item id, when one was requested.
+type productResponse struct {
+	*ent.Product
+	DisplayPrices map[int]money.Money `json:"display_prices,omitempty"`
+}
+
+func newProductResponse(p *ent.Product, converter *exchangeservice.Converter) productResponse {
+	sortOffers(p.Edges.Items, converter)
+	resp := productResponse{Product: p}
+	for _, it := range p.Edges.Items {
+		display, ok := converter.Convert(money.New(it.PriceAmount, it.Currency))
+		if !ok {
+			continue
+		}
+		if resp.DisplayPrices == nil {
+			resp.DisplayPrices = make(map[int]money.Money)
+		}
+		resp.DisplayPrices[it.ID] = display
+	}
+	return resp
+}
+
+// sortOffers orders a product's offers by their price in the converter's
+// currency, cheapest first. Offers it cannot convert keep their order, after
+// the rest. A nil converter leaves the offers as they are.
+func sortOffers(items []*ent.Item, converter *exchangeservice.Converter) {
+	if converter == nil {
+		return
+	}
+	type offer struct {
+		it        *ent.Item
+		price     int64
+		converted bool
+	}
+	offers := make([]offer, len(items))
+	for i, it := range items {
+		price, ok := converter.Convert(money.New(it.PriceAmount, it.Currency))
+		offers[i] = offer{it: it, price: price.Amount, converted: ok}
+	}
+	slices.SortStableFunc(offers, func(a, b offer) int {
+		if a.converted != b.converted {
+			if a.converted {
+				return -1
+			}
+			return 1
+		}
+		if !a.converted {
+			return 0
+		}
+		return cmp.Compare(a.price, b.price)
+	})
+	for i, o := range offers {
+		items[i] = o.it
+	}
+}
+
+// GetProduct returns a product with every store's current offer for it,
+// cheapest first in the display currency. Without one, offers are grouped by
+// currency, cheapest first within each.
+func (h *handler) GetProduct(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid product id"})
+		return
+	}
+
+	converter, ok := httputil.DisplayConverter(w, r, h.exchange)
+	if !ok {
+		return
+	}
+
+	product, err := h.service.GetProduct(r.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "product not found"})
+			return
+		}
+		httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to get product"})
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, newProductResponse(product, converter))
+}
+
+// Merge moves the items of the products in the request body into this one.
+func (h *handler) Merge(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid product id"})
+		return
+	}
+
+	var req mergeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	if len(req.ProductIDs) == 0 {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "product_ids are required"})
+		return
+	}
+
+	product, err := h.service.Merge(r.Context(), id, req.ProductIDs)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "product not found"})
+		case errors.Is(err, productservice.ErrInvalidMerge):
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: err.Error()})
+		default:
+			httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to merge products"})
+		}
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, newProductResponse(product, nil))
+}
+
+// Split moves the items in the request body off this product into a new one.
+func (h *handler) Split(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid product id"})
+		return
+	}
+
+	var req splitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+
+	if len(req.ItemIDs) == 0 {
+		httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: "item_ids are required"})
+		return
+	}
+
+	product, err := h.service.Split(r.Context(), id, req.ItemIDs)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			httputil.WriteJSON(w, http.StatusNotFound, httputil.ErrorResponse{Error: "product not found"})
+		case errors.Is(err, productservice.ErrItemNotInProduct):
+			httputil.WriteJSON(w, http.StatusBadRequest, httputil.ErrorResponse{Error: err.Error()})
+		default:
+			httputil.WriteJSON(w, http.StatusInternalServerError, httputil.ErrorResponse{Error: "failed to split product"})
+		}
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusCreated, newProductResponse(product, nil))
+}
@@ -0,0 +1,41 @@
+package producthandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"offgrocery-assessment/internal/httputil"
+)
+
+func TestEditsRequireAdmin(t *testing.T) {
+	// The service is never reached, so a nil one is enough: the admin
+	// middleware turns the request away first.
+	routes := New(nil, nil, httputil.RequireAdmin("secret")).Routes()
+
+	tests := []struct {
+		name string
+		path string
+		auth string
+	}{
+		{"merge without token", "/1/merge", ""},
+		{"merge with wrong token", "/1/merge", "Bearer guess"},
+		{"split without token", "/1/split", ""},
+		{"split with wrong token", "/1/split", "Bearer guess"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{}`))
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			routes.ServeHTTP(w, r)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("POST %s status = %d, want %d", tt.path, w.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package productservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"unicode"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/product"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/product/productstore"
+)
+
+var (
+	// ErrInvalidMerge is returned by Merge when a product is merged into itself.
+	ErrInvalidMerge = errors.New("cannot merge a product into itself")
+	// ErrItemNotInProduct is returned by Split when an item does not belong to
+	// the product being split.
+	ErrItemNotInProduct = errors.New("item does not belong to the product")
+)
+
+type Service interface {
+	GetProduct(ctx context.Context, id int) (*ent.Product, error)
+	MatchStore(ctx context.Context, storeID int) error
+	Merge(ctx context.Context, targetID int, sourceIDs []int) (*ent.Product, error)
+	Split(ctx context.Context, productID int, itemIDs []int) (*ent.Product, error)
+}
+
+type service struct {
+	store productstore.Store
+}
+
+func New(store productstore.Store) *service {
+	return &service{store: store}
+}
+
+func (s *service) GetProduct(ctx context.Context, id int) (*ent.Product, error) {
+	return s.store.GetProduct(ctx, id)
+}
+
+// MatchStore assigns each of a store's items to the product sharing its
+// normalized name and brand, creating products as needed. Items only match a
+// product of a compatible size, see sizesCompatible. Items pinned to a
+// product by hand are left alone.
+func (s *service) MatchStore(ctx context.Context, storeID int) error {
+	return s.store.WithTx(ctx, func(tx productstore.Store) error {
+		items, err := tx.ListMatchableItems(ctx, storeID)
+		if err != nil {
+			return fmt.Errorf("listing items: %w", err)
+		}
+
+		keys := make([]string, 0, len(items))
+		for _, it := range items {
+			keys = append(keys, matchKey(it.Name, it.Brand))
+		}
+
+		existing, err := tx.ListProductsByMatchKeys(ctx, keys)
+		if err != nil {
+			return fmt.Errorf("listing products: %w", err)
+		}
+		byKey := make(map[string][]*ent.Product)
+		for _, p := range existing {
+			byKey[*p.MatchKey] = append(byKey[*p.MatchKey], p)
+		}
+
+		assignments := make(map[int][]int)
+		for i, it := range items {
+			key := keys[i]
+			size := itemSize(it)
+
+			current := it.Edges.Product
+			if current != nil && current.MatchKey != nil && *current.MatchKey == key && sizesCompatible(productSize(current), size) {
+				continue
+			}
+
+			var match *ent.Product
+			for _, p := range byKey[key] {
+				if sizesCompatible(productSize(p), size) {
+					match = p
+					break
+				}
+			}
+
+			if match == nil {
+				match, err = tx.CreateProduct(ctx, productstore.ProductParams{
+					Name:     it.Name,
+					Brand:    it.Brand,
+					Size:     size,
+					MatchKey: key,
+				})
+				if err != nil {
+					return fmt.Errorf("creating product for item %d: %w", it.ID, err)
+				}
+				byKey[key] = append(byKey[key], match)
+			} else if productSize(match).IsZero() && !size.IsZero() {
+				if err := tx.SetProductSize(ctx, match.ID, size); err != nil {
+					return fmt.Errorf("setting size of product %d: %w", match.ID, err)
+				}
+				match.SizeQuantity = &size.Quantity
+				unit := product.SizeUnit(size.Unit)
+				match.SizeUnit = &unit
+			}
+
+			assignments[match.ID] = append(assignments[match.ID], it.ID)
+		}
+
+		for productID, itemIDs := range assignments {
+			if err := tx.AssignItems(ctx, productID, itemIDs, false); err != nil {
+				return fmt.Errorf("assigning items to product %d: %w", productID, err)
+			}
+		}
+
+		deleted, err := tx.DeleteEmptyProducts(ctx)
+		if err != nil {
+			return fmt.Errorf("deleting empty products: %w", err)
+		}
+
+		slog.Info("products: matched store items", "store", storeID, "items", len(items), "reassigned", countItems(assignments), "deleted_products", deleted)
+		return nil
+	})
+}
+
+// Merge moves every item of the source products to the target product and
+// deletes the sources. Moved items are pinned so the matcher does not split
+// them off again.
+func (s *service) Merge(ctx context.Context, targetID int, sourceIDs []int) (*ent.Product, error) {
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, ErrInvalidMerge
+		}
+	}
+
+	err := s.store.WithTx(ctx, func(tx productstore.Store) error {
+		if _, err := tx.GetProduct(ctx, targetID); err != nil {
+			return err
+		}
+
+		for _, sourceID := range sourceIDs {
+			if _, err := tx.GetProduct(ctx, sourceID); err != nil {
+				return err
+			}
+			items, err := tx.ListProductItems(ctx, sourceID)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
+				continue
+			}
+			if err := tx.AssignItems(ctx, targetID, itemIDs(items), true); err != nil {
+				return err
+			}
+		}
+
+		return tx.DeleteProducts(ctx, sourceIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.GetProduct(ctx, targetID)
+}
+
+// Split moves the given items of a product to a new product of their own.
+// The new product has no match key and its items are pinned, so the matcher
+// neither adds items to it nor moves them back.
+func (s *service) Split(ctx context.Context, productID int, splitItemIDs []int) (*ent.Product, error) {
+	if len(splitItemIDs) == 0 {
+		return nil, fmt.Errorf("no items to split off")
+	}
+
+	var created *ent.Product
+	err := s.store.WithTx(ctx, func(tx productstore.Store) error {
+		items, err := tx.ListProductItems(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			if _, err := tx.GetProduct(ctx, productID); err != nil {
+				return err
+			}
+		}
+
+		byID := make(map[int]*ent.Item, len(items))
+		for _, it := range items {
+			byID[it.ID] = it
+		}
+		for _, id := range splitItemIDs {
+			if _, ok := byID[id]; !ok {
+				return fmt.Errorf("item %d: %w", id, ErrItemNotInProduct)
+			}
+		}
+
+		first := byID[splitItemIDs[0]]
+		created, err = tx.CreateProduct(ctx, productstore.ProductParams{
+			Name:  first.Name,
+			Brand: first.Brand,
+			Size:  itemSize(first),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := tx.AssignItems(ctx, created.ID, splitItemIDs, true); err != nil {
+			return err
+		}
+
+		_, err = tx.DeleteEmptyProducts(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.GetProduct(ctx, created.ID)
+}
+
+// matchKey normalizes an item's brand and name into the key equivalent
+// items share, e.g. "natrel|2% milk".
+func matchKey(name, brand string) string {
+	return normalize(brand) + "|" + normalize(name)
+}
+
+func normalize(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '%'
+	}), " ")
+}
+
+// sizesCompatible reports whether two package sizes may describe the same
+// product. Sizes in the same unit must agree to within 2%. An unknown size,
+// or sizes in different units, such as a grocer that reports milk by weight
+// and another by volume, cannot rule a match out.
+func sizesCompatible(a, b measure.Size) bool {
+	if a.IsZero() || b.IsZero() || a.Unit != b.Unit {
+		return true
+	}
+	return math.Abs(a.Quantity-b.Quantity) <= 0.02*math.Max(a.Quantity, b.Quantity)
+}
+
+func itemSize(it *ent.Item) measure.Size {
+	if it.SizeQuantity == nil || it.SizeUnit == nil {
+		return measure.Size{}
+	}
+	return measure.Size{Quantity: *it.SizeQuantity, Unit: measure.Unit(*it.SizeUnit)}
+}
+
+func productSize(p *ent.Product) measure.Size {
+	if p.SizeQuantity == nil || p.SizeUnit == nil {
+		return measure.Size{}
+	}
+	return measure.Size{Quantity: *p.SizeQuantity, Unit: measure.Unit(*p.SizeUnit)}
+}
+
+func itemIDs(items []*ent.Item) []int {
+	ids := make([]int, len(items))
+	for i, it := range items {
+		ids[i] = it.ID
+	}
+	return ids
+}
+
+func countItems(assignments map[int][]int) int {
+	n := 0
+	for _, ids := range assignments {
+		n += len(ids)
+	}
+	return n
+}
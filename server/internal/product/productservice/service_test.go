@@ -0,0 +1,218 @@
+package productservice
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/product"
+	"offgrocery-assessment/internal/item/measure"
+	"offgrocery-assessment/internal/product/productstore"
+)
+
+func TestMatchKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		brand string
+		item  string
+		want  string
+	}{
+		{"lower-cased", "Natrel", "2% Milk", "natrel|2% milk"},
+		{"punctuation dropped", "President's Choice", "Whole-Wheat Bread, Sliced", "president s choice|whole wheat bread sliced"},
+		{"whitespace collapsed", "  Natrel ", "2%   Milk\t", "natrel|2% milk"},
+		{"accents kept", "Liberté", "Crème Fraîche", "liberté|crème fraîche"},
+		{"no brand", "", "Bananas", "|bananas"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchKey(tt.item, tt.brand); got != tt.want {
+				t.Errorf("matchKey(%q, %q) = %q, want %q", tt.item, tt.brand, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSizesCompatible(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b measure.Size
+		want bool
+	}{
+		{"equal", measure.Grams(500), measure.Grams(500), true},
+		{"within two percent", measure.Grams(500), measure.Grams(490), true},
+		{"at two percent", measure.Grams(500), measure.Grams(510), true},
+		{"beyond two percent", measure.Grams(500), measure.Grams(454), false},
+		{"different units", measure.Grams(1000), measure.Size{Quantity: 1000, Unit: measure.Millilitre}, true},
+		{"unknown size", measure.Size{}, measure.Grams(500), true},
+		{"both unknown", measure.Size{}, measure.Size{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sizesCompatible(tt.a, tt.b); got != tt.want {
+				t.Errorf("sizesCompatible(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := sizesCompatible(tt.b, tt.a); got != tt.want {
+				t.Errorf("sizesCompatible(%v, %v) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeStore keeps products in memory and records the matcher's writes.
+type fakeStore struct {
+	productstore.Store
+	items    []*ent.Item
+	products []*ent.Product
+	assigned map[int][]int
+	sized    map[int]measure.Size
+}
+
+func (s *fakeStore) WithTx(ctx context.Context, fn func(tx productstore.Store) error) error {
+	return fn(s)
+}
+
+func (s *fakeStore) ListMatchableItems(ctx context.Context, storeID int) ([]*ent.Item, error) {
+	return s.items, nil
+}
+
+func (s *fakeStore) ListProductsByMatchKeys(ctx context.Context, keys []string) ([]*ent.Product, error) {
+	var found []*ent.Product
+	for _, p := range s.products {
+		if p.MatchKey != nil && slices.Contains(keys, *p.MatchKey) {
+			found = append(found, p)
+		}
+	}
+	return found, nil
+}
+
+func (s *fakeStore) CreateProduct(ctx context.Context, params productstore.ProductParams) (*ent.Product, error) {
+	p := newProduct(100+len(s.products), params.MatchKey, params.Size)
+	s.products = append(s.products, p)
+	return p, nil
+}
+
+func (s *fakeStore) SetProductSize(ctx context.Context, productID int, size measure.Size) error {
+	s.sized[productID] = size
+	return nil
+}
+
+func (s *fakeStore) AssignItems(ctx context.Context, productID int, itemIDs []int, pinned bool) error {
+	s.assigned[productID] = append(s.assigned[productID], itemIDs...)
+	return nil
+}
+
+func (s *fakeStore) DeleteEmptyProducts(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+func newProduct(id int, key string, size measure.Size) *ent.Product {
+	p := &ent.Product{ID: id, MatchKey: &key}
+	if !size.IsZero() {
+		unit := product.SizeUnit(size.Unit)
+		p.SizeQuantity, p.SizeUnit = &size.Quantity, &unit
+	}
+	return p
+}
+
+func newItem(id int, name, brand string, size measure.Size, current *ent.Product) *ent.Item {
+	it := &ent.Item{ID: id, Name: name, Brand: brand}
+	if !size.IsZero() {
+		unit := item.SizeUnit(size.Unit)
+		it.SizeQuantity, it.SizeUnit = &size.Quantity, &unit
+	}
+	it.Edges.Product = current
+	return it
+}
+
+func TestMatchStore(t *testing.T) {
+	milk := newProduct(1, "natrel|2% milk", measure.Size{Quantity: 2000, Unit: measure.Millilitre})
+	unsized := newProduct(2, "neilson|butter", measure.Size{})
+	litre := measure.Size{Quantity: 1000, Unit: measure.Millilitre}
+	twoLitres := measure.Size{Quantity: 2000, Unit: measure.Millilitre}
+
+	tests := []struct {
+		name         string
+		products     []*ent.Product
+		items        []*ent.Item
+		wantAssigned map[int][]int
+		wantCreated  int
+		wantSized    map[int]measure.Size
+	}{
+		{
+			name:         "same key shares a new product",
+			items:        []*ent.Item{newItem(10, "Bananas", "", measure.Size{}, nil), newItem(11, "BANANAS", "", measure.Size{}, nil)},
+			wantAssigned: map[int][]int{100: {10, 11}},
+			wantCreated:  1,
+		},
+		{
+			name:         "joins existing product of compatible size",
+			products:     []*ent.Product{milk},
+			items:        []*ent.Item{newItem(10, "2% Milk", "Natrel", twoLitres, nil)},
+			wantAssigned: map[int][]int{1: {10}},
+		},
+		{
+			name:         "incompatible size gets its own product",
+			products:     []*ent.Product{milk},
+			items:        []*ent.Item{newItem(10, "2% Milk", "Natrel", litre, nil)},
+			wantAssigned: map[int][]int{101: {10}},
+			wantCreated:  1,
+		},
+		{
+			name:         "already matched item is left alone",
+			products:     []*ent.Product{milk},
+			items:        []*ent.Item{newItem(10, "2% Milk", "Natrel", twoLitres, milk)},
+			wantAssigned: map[int][]int{},
+		},
+		{
+			name:         "renamed item moves",
+			products:     []*ent.Product{milk, unsized},
+			items:        []*ent.Item{newItem(10, "Butter", "Neilson", measure.Size{}, milk)},
+			wantAssigned: map[int][]int{2: {10}},
+		},
+		{
+			name:         "sized item sizes an unsized product",
+			products:     []*ent.Product{unsized},
+			items:        []*ent.Item{newItem(10, "Butter", "Neilson", measure.Grams(454), nil)},
+			wantAssigned: map[int][]int{2: {10}},
+			wantSized:    map[int]measure.Size{2: measure.Grams(454)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The matcher updates the products it sizes, so each case gets
+			// its own copies. Created products are numbered from 100 plus
+			// the products already there.
+			store := &fakeStore{
+				items:    tt.items,
+				assigned: map[int][]int{},
+				sized:    map[int]measure.Size{},
+			}
+			for _, p := range tt.products {
+				copied := *p
+				store.products = append(store.products, &copied)
+			}
+			if err := New(store).MatchStore(context.Background(), 1); err != nil {
+				t.Fatalf("MatchStore() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(store.assigned, tt.wantAssigned) {
+				t.Errorf("assigned = %v, want %v", store.assigned, tt.wantAssigned)
+			}
+			if created := len(store.products) - len(tt.products); created != tt.wantCreated {
+				t.Errorf("created %d products, want %d", created, tt.wantCreated)
+			}
+			if tt.wantSized == nil {
+				tt.wantSized = map[int]measure.Size{}
+			}
+			if !reflect.DeepEqual(store.sized, tt.wantSized) {
+				t.Errorf("sized = %v, want %v", store.sized, tt.wantSized)
+			}
+		})
+	}
+}
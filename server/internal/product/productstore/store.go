@@ -0,0 +1,149 @@
+package productstore
+
+import (
+	"context"
+	"fmt"
+
+	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/ent/item"
+	"offgrocery-assessment/internal/ent/product"
+	"offgrocery-assessment/internal/ent/store"
+	"offgrocery-assessment/internal/item/measure"
+)
+
+// ProductParams describes a new product. MatchKey is empty for products the
+// matcher must not assign items to.
+type ProductParams struct {
+	Name     string
+	Brand    string
+	Size     measure.Size
+	MatchKey string
+}
+
+type Store interface {
+	// WithTx runs fn against a Store bound to a single transaction, committing
+	// if fn succeeds and rolling back otherwise.
+	WithTx(ctx context.Context, fn func(tx Store) error) error
+	GetProduct(ctx context.Context, id int) (*ent.Product, error)
+	ListMatchableItems(ctx context.Context, storeID int) ([]*ent.Item, error)
+	ListProductsByMatchKeys(ctx context.Context, keys []string) ([]*ent.Product, error)
+	ListProductItems(ctx context.Context, productID int) ([]*ent.Item, error)
+	CreateProduct(ctx context.Context, params ProductParams) (*ent.Product, error)
+	SetProductSize(ctx context.Context, productID int, size measure.Size) error
+	AssignItems(ctx context.Context, productID int, itemIDs []int, pinned bool) error
+	DeleteProducts(ctx context.Context, ids []int) error
+	DeleteEmptyProducts(ctx context.Context) (int, error)
+}
+
+type productStore struct {
+	client *ent.Client
+}
+
+func New(client *ent.Client) *productStore {
+	return &productStore{client: client}
+}
+
+func (s *productStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	if err := fn(&productStore{client: tx.Client()}); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// GetProduct returns a product with the items currently on offer for it and
+// their stores. Prices in different currencies cannot be compared here, so
+// the offers are grouped by currency, cheapest first within each.
+func (s *productStore) GetProduct(ctx context.Context, id int) (*ent.Product, error) {
+	return s.client.Product.Query().
+		Where(product.IDEQ(id)).
+		WithItems(func(q *ent.ItemQuery) {
+			q.Where(item.AvailableEQ(true)).
+				WithStore().
+				Order(ent.Asc(item.FieldCurrency), ent.Asc(item.FieldPriceAmount), ent.Asc(item.FieldID))
+		}).
+		Only(ctx)
+}
+
+// ListMatchableItems returns the store's items the matcher may assign, with
+// their current products.
+func (s *productStore) ListMatchableItems(ctx context.Context, storeID int) ([]*ent.Item, error) {
+	return s.client.Item.Query().
+		Where(
+			item.ProductPinnedEQ(false),
+			item.HasStoreWith(store.IDEQ(storeID)),
+		).
+		WithProduct().
+		All(ctx)
+}
+
+func (s *productStore) ListProductsByMatchKeys(ctx context.Context, keys []string) ([]*ent.Product, error) {
+	return s.client.Product.Query().
+		Where(product.MatchKeyIn(keys...)).
+		Order(ent.Asc(product.FieldID)).
+		All(ctx)
+}
+
+func (s *productStore) ListProductItems(ctx context.Context, productID int) ([]*ent.Item, error) {
+	return s.client.Item.Query().
+		Where(item.ProductIDEQ(productID)).
+		All(ctx)
+}
+
+func (s *productStore) CreateProduct(ctx context.Context, params ProductParams) (*ent.Product, error) {
+	create := s.client.Product.Create().
+		SetName(params.Name).
+		SetBrand(params.Brand)
+	if params.MatchKey != "" {
+		create.SetMatchKey(params.MatchKey)
+	}
+	if !params.Size.IsZero() {
+		create.
+			SetSizeQuantity(params.Size.Quantity).
+			SetSizeUnit(product.SizeUnit(params.Size.Unit))
+	}
+	return create.Save(ctx)
+}
+
+func (s *productStore) SetProductSize(ctx context.Context, productID int, size measure.Size) error {
+	return s.client.Product.UpdateOneID(productID).
+		SetSizeQuantity(size.Quantity).
+		SetSizeUnit(product.SizeUnit(size.Unit)).
+		Exec(ctx)
+}
+
+// AssignItems moves items to a product. Pinned items are left alone by the
+// matcher from then on.
+func (s *productStore) AssignItems(ctx context.Context, productID int, itemIDs []int, pinned bool) error {
+	return s.client.Item.Update().
+		Where(item.IDIn(itemIDs...)).
+		SetProductID(productID).
+		SetProductPinned(pinned).
+		Exec(ctx)
+}
+
+func (s *productStore) DeleteProducts(ctx context.Context, ids []int) error {
+	_, err := s.client.Product.Delete().
+		Where(product.IDIn(ids...)).
+		Exec(ctx)
+	return err
+}
+
+// DeleteEmptyProducts deletes products no item belongs to any more and
+// returns how many it deleted.
+func (s *productStore) DeleteEmptyProducts(ctx context.Context) (int, error) {
+	return s.client.Product.Delete().
+		Where(product.Not(product.HasItems())).
+		Exec(ctx)
+}
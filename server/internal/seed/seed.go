@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"offgrocery-assessment/internal/ent"
+	"offgrocery-assessment/internal/money"
 )
 
 // Seed populates the database with dev data. Assumes a clean database
@@ -51,7 +52,7 @@ func Seed(ctx context.Context, client *ent.Client) error {
 	itemIDs := make([]int, len(items))
 	for i, item := range items {
 		itemIDs[i] = item.ID
-		slog.Info("seed: adding item to ray's list", "id", item.ID, "name", item.Name, "brand", item.Brand, "price", item.Price)
+		slog.Info("seed: adding item to ray's list", "id", item.ID, "name", item.Name, "brand", item.Brand, "price", money.New(item.PriceAmount, item.Currency))
 	}
 
 	_, err = client.List.Create().